@@ -0,0 +1,241 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"runtime"
+	"syscall"
+	"time"
+
+	"github.com/nokka/d2-armory-api/internal/achievement"
+	"github.com/nokka/d2-armory-api/internal/backfill"
+	"github.com/nokka/d2-armory-api/internal/character"
+	"github.com/nokka/d2-armory-api/internal/charsave"
+	"github.com/nokka/d2-armory-api/internal/grail"
+	"github.com/nokka/d2-armory-api/internal/graveyard"
+	"github.com/nokka/d2-armory-api/internal/mgo"
+	"github.com/nokka/d2-armory-api/internal/parsing"
+	"github.com/nokka/d2-armory-api/internal/pvpgn"
+	"github.com/nokka/d2-armory-api/internal/visibility"
+	"github.com/nokka/d2-armory-api/internal/webhook"
+	"github.com/nokka/d2-armory-api/pkg/env"
+)
+
+const usage = `armoryctl performs administrative tasks against the armory.
+
+Usage:
+  armoryctl <command> [flags]
+
+Commands:
+  import    parse every character binary in D2S_PATH and store it
+  migrate   lowercase the names of characters stored before names were normalized
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	switch os.Args[1] {
+	case "import":
+		os.Exit(runImport(os.Args[2:]))
+	case "migrate":
+		os.Exit(runMigrate(os.Args[2:]))
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+}
+
+func runImport(args []string) int {
+	var (
+		mongoDBHost      = env.String("MONGO_HOST", "mongodb:27017")
+		databaseName     = env.String("MONGO_DB", "armory")
+		mongoUsername    = env.String("MONGO_USERNAME", "")
+		mongoPassword    = env.String("MONGO_PASSWORD", "")
+		d2sPath          = env.String("D2S_PATH", "")
+		charInfoPath     = env.String("CHARINFO_PATH", "")
+		achievementsPath = env.String("ACHIEVEMENTS_PATH", "")
+	)
+
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	workers := fs.Int("workers", runtime.NumCPU(), "number of characters to parse concurrently")
+	force := fs.Bool("force", false, "import all characters, even those not saved since they were last parsed")
+	interval := fs.Duration("progress", 5*time.Second, "interval between progress reports")
+	_ = fs.Parse(args)
+
+	if d2sPath == "" {
+		log.Println("d2s path missing")
+		return 1
+	}
+
+	// Cancel the import on interrupt, it can be resumed by running it again.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		c := make(chan os.Signal, 1)
+		signal.Notify(c, syscall.SIGINT, syscall.SIGTERM)
+		log.Printf("got signal %s, stopping import", <-c)
+		cancel()
+	}()
+
+	mgoCtx, mgoCancel := context.WithTimeout(ctx, 30*time.Second)
+	defer mgoCancel()
+
+	client, err := mgo.Connect(mgoCtx, mongoDBHost, databaseName, mongoUsername, mongoPassword)
+	if err != nil {
+		log.Println("failed to connect to mongodb", err)
+		return 1
+	}
+
+	if charInfoPath == "" {
+		charInfoPath = pvpgn.CharInfoPath(d2sPath)
+	}
+
+	characterRepository := mgo.NewCharacterRepository(databaseName, client)
+	statisticsRepository := mgo.NewStatisticsRepository(databaseName, client)
+
+	// Events of the imported characters are delivered while importing, the deliveries
+	// still queued when the import is done are put on the dead letter list.
+	webhookService := webhook.NewService(
+		mgo.NewWebhookRepository(databaseName, client),
+		mgo.NewDeadLetterRepository(databaseName, client),
+		&http.Client{},
+		visibility.NewService(mgo.NewVisibilityRepository(databaseName, client)),
+	)
+
+	webhookCtx, webhookCancel := context.WithCancel(context.Background())
+	webhooksDone := make(chan struct{})
+	go func() {
+		webhookService.Run(webhookCtx)
+		close(webhooksDone)
+	}()
+
+	defer func() {
+		webhookCancel()
+		<-webhooksDone
+	}()
+
+	// Imported characters notify the same listeners as characters parsed by the server,
+	// except live connections which there are none of.
+	listeners := []character.Listener{
+		grail.NewService(mgo.NewGrailRepository(databaseName, client)),
+		graveyard.NewService(mgo.NewGraveyardRepository(databaseName, client)),
+		webhookService,
+	}
+
+	if achievementsPath != "" {
+		rules, err := achievement.LoadRules(achievementsPath)
+		if err != nil {
+			log.Println("failed to load achievements", err)
+			return 1
+		}
+
+		listeners = append(listeners, achievement.NewService(rules, mgo.NewAchievementRepository(databaseName, client), characterRepository, statisticsRepository))
+	}
+
+	index := charsave.NewIndex(d2sPath)
+	parser := parsing.NewParser(index, pvpgn.NewDirectory(charInfoPath))
+
+	importer := backfill.NewImporter(
+		index,
+		character.NewService(parser, index, characterRepository, 0, listeners...),
+		characterRepository,
+		*workers,
+		*force,
+	)
+
+	// Report progress until the import is done.
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(*interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				logProgress(importer.Progress())
+			}
+		}
+	}()
+
+	log.Printf("importing characters from %s with %d workers", d2sPath, *workers)
+
+	failures, err := importer.Run(ctx)
+	close(done)
+
+	for _, f := range failures {
+		log.Printf("failed to import %s: %s", f.Name, f.Err)
+	}
+
+	logProgress(importer.Progress())
+
+	if err != nil {
+		log.Println("import stopped, run it again to resume:", err)
+		return 1
+	}
+
+	if len(failures) > 0 {
+		return 1
+	}
+
+	return 0
+}
+
+func runMigrate(args []string) int {
+	var (
+		mongoDBHost   = env.String("MONGO_HOST", "mongodb:27017")
+		databaseName  = env.String("MONGO_DB", "armory")
+		mongoUsername = env.String("MONGO_USERNAME", "")
+		mongoPassword = env.String("MONGO_PASSWORD", "")
+	)
+
+	fs := flag.NewFlagSet("migrate", flag.ExitOnError)
+	timeout := fs.Duration("timeout", 10*time.Minute, "time allowed for the migration")
+	_ = fs.Parse(args)
+
+	// Each name is migrated in a transaction, so an interrupted migration is resumed by running it again.
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	go func() {
+		c := make(chan os.Signal, 1)
+		signal.Notify(c, syscall.SIGINT, syscall.SIGTERM)
+		log.Printf("got signal %s, stopping migration", <-c)
+		cancel()
+	}()
+
+	client, err := mgo.Connect(ctx, mongoDBHost, databaseName, mongoUsername, mongoPassword)
+	if err != nil {
+		log.Println("failed to connect to mongodb", err)
+		return 1
+	}
+
+	normalized, err := mgo.NewCharacterRepository(databaseName, client).NormalizeIDs(ctx)
+
+	log.Printf("%d character names normalized", normalized)
+
+	if err != nil {
+		log.Println("migration stopped, run it again to resume:", err)
+		return 1
+	}
+
+	return 0
+}
+
+func logProgress(p backfill.Progress) {
+	done := p.Imported + p.Skipped + p.Failed
+
+	log.Printf("%d/%d characters processed, %d imported, %d skipped, %d failed, %.1f characters/s",
+		done, p.Total, p.Imported, p.Skipped, p.Failed, p.Rate(),
+	)
+}
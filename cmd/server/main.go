@@ -4,36 +4,76 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
+	"net/url"
 	"os"
 	"os/signal"
 	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
+	"github.com/nokka/d2-armory-api/internal/account"
+	"github.com/nokka/d2-armory-api/internal/achievement"
+	"github.com/nokka/d2-armory-api/internal/card"
 	"github.com/nokka/d2-armory-api/internal/character"
+	"github.com/nokka/d2-armory-api/internal/charsave"
+	"github.com/nokka/d2-armory-api/internal/compare"
+	"github.com/nokka/d2-armory-api/internal/craft"
+	"github.com/nokka/d2-armory-api/internal/export"
+	"github.com/nokka/d2-armory-api/internal/grail"
+	"github.com/nokka/d2-armory-api/internal/graph"
+	"github.com/nokka/d2-armory-api/internal/graveyard"
+	"github.com/nokka/d2-armory-api/internal/grpcserver"
 	"github.com/nokka/d2-armory-api/internal/httpserver"
+	"github.com/nokka/d2-armory-api/internal/ladder"
+	"github.com/nokka/d2-armory-api/internal/live"
+	"github.com/nokka/d2-armory-api/internal/locale"
+	"github.com/nokka/d2-armory-api/internal/mercenary"
 	"github.com/nokka/d2-armory-api/internal/mgo"
 	"github.com/nokka/d2-armory-api/internal/parsing"
+	"github.com/nokka/d2-armory-api/internal/progress"
+	"github.com/nokka/d2-armory-api/internal/pvpgn"
+	"github.com/nokka/d2-armory-api/internal/skill"
 	"github.com/nokka/d2-armory-api/internal/statistics"
+	"github.com/nokka/d2-armory-api/internal/visibility"
+	"github.com/nokka/d2-armory-api/internal/webhook"
 	"github.com/nokka/d2-armory-api/pkg/env"
-	"go.mongodb.org/mongo-driver/mongo"
-	"go.mongodb.org/mongo-driver/mongo/options"
-	"go.mongodb.org/mongo-driver/mongo/readpref"
 )
 
 func main() {
 	var (
 		httpAddress        = env.String("HTTP_ADDRESS", ":80")
+		publicURL          = env.String("PUBLIC_URL", "")
 		mongoDBHost        = env.String("MONGO_HOST", "mongodb:27017")
 		databaseName       = env.String("MONGO_DB", "armory")
 		mongoUsername      = env.String("MONGO_USERNAME", "")
 		mongoPassword      = env.String("MONGO_PASSWORD", "")
 		d2sPath            = env.String("D2S_PATH", "")
+		charInfoPath       = env.String("CHARINFO_PATH", "")
+		accountMapPath     = env.String("ACCOUNT_MAP_PATH", "")
+		ladderPath         = env.String("LADDER_PATH", "")
+		localePath         = env.String("LOCALE_PATH", "")
+		achievementsPath   = env.String("ACHIEVEMENTS_PATH", "")
+		ladderInterval     = env.String("LADDER_INTERVAL", "10m")
+		indexInterval      = env.String("D2S_INDEX_INTERVAL", "1m")
 		cacheDuration      = env.String("CACHE_DURATION", "3m")
 		statisticsUser     = env.String("STATISTICS_USER", "")
 		statisticsPassword = env.String("STATISTICS_PASSWORD", "")
+		adminUser          = env.String("ADMIN_USER", "")
+		adminPassword      = env.String("ADMIN_PASSWORD", "")
+		deletedGrace       = env.String("DELETED_GRACE_PERIOD", "720h")
+		sweepInterval      = env.String("SWEEP_INTERVAL", "1h")
 		corsEnabled        = env.String("CORS_ENABLED", "false")
 		logRequests        = env.String("LOG_REQUESTS", "false")
+		validateRequests   = env.String("VALIDATE_REQUESTS", "false")
+		liveMax            = env.String("LIVE_MAX_CONNECTIONS", "1000")
+		liveMaxPerChar     = env.String("LIVE_MAX_PER_CHARACTER", "100")
+		liveWatchInterval  = env.String("LIVE_WATCH_INTERVAL", "1s")
+		graphqlMaxCost     = env.String("GRAPHQL_MAX_COST", "2000")
+		graphqlMaxDepth    = env.String("GRAPHQL_MAX_DEPTH", "10")
+		grpcAddress        = env.String("GRPC_ADDRESS", ":9090")
+		grpcToken          = env.String("GRPC_TOKEN", "")
 	)
 
 	if d2sPath == "" {
@@ -51,12 +91,47 @@ func main() {
 		os.Exit(0)
 	}
 
+	// Links back to the armory are absolute, so the public URL has to be.
+	if publicURL != "" {
+		u, err := url.Parse(publicURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			log.Printf("public url must be an absolute http or https url, got %q", publicURL)
+			os.Exit(0)
+		}
+
+		publicURL = strings.TrimSuffix(publicURL, "/")
+	}
+
 	cd, err := time.ParseDuration(cacheDuration)
 	if err != nil {
 		log.Printf("failed to parse cache duration, %s", err)
 		os.Exit(0)
 	}
 
+	ii, err := time.ParseDuration(indexInterval)
+	if err != nil {
+		log.Printf("failed to parse index interval, %s", err)
+		os.Exit(0)
+	}
+
+	dg, err := time.ParseDuration(deletedGrace)
+	if err != nil {
+		log.Printf("failed to parse deleted grace period, %s", err)
+		os.Exit(0)
+	}
+
+	si, err := time.ParseDuration(sweepInterval)
+	if err != nil {
+		log.Printf("failed to parse sweep interval, %s", err)
+		os.Exit(0)
+	}
+
+	li, err := time.ParseDuration(ladderInterval)
+	if err != nil {
+		log.Printf("failed to parse ladder interval, %s", err)
+		os.Exit(0)
+	}
+
 	cors, err := strconv.ParseBool(corsEnabled)
 	if err != nil {
 		log.Printf("failed to parse cors enabled, %s", err)
@@ -69,48 +144,241 @@ func main() {
 		os.Exit(0)
 	}
 
-	clientOptions := options.Client().ApplyURI("mongodb://" + mongoDBHost)
+	validation, err := strconv.ParseBool(validateRequests)
+	if err != nil {
+		log.Printf("failed to parse validate requests, %s", err)
+		os.Exit(0)
+	}
 
-	// If a username is supplied, auth with it.
-	if mongoUsername != "" {
-		clientOptions.SetAuth(options.Credential{
-			AuthSource: databaseName,
-			Username:   mongoUsername,
-			Password:   mongoPassword,
-		})
+	lm, err := strconv.Atoi(liveMax)
+	if err != nil {
+		log.Printf("failed to parse live max connections, %s", err)
+		os.Exit(0)
 	}
 
-	// Context used for mongo operations, to time them out and cancel their context.
-	mgoCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
+	lmc, err := strconv.Atoi(liveMaxPerChar)
+	if err != nil {
+		log.Printf("failed to parse live max connections per character, %s", err)
+		os.Exit(0)
+	}
 
+	lwi, err := time.ParseDuration(liveWatchInterval)
 	if err != nil {
-		log.Println("failed to create context with timeout for mongodb connection", err)
+		log.Printf("failed to parse live watch interval, %s", err)
 		os.Exit(0)
 	}
 
-	client, err := mongo.Connect(mgoCtx, clientOptions)
+	gmc, err := strconv.Atoi(graphqlMaxCost)
 	if err != nil {
-		log.Println("failed to connect to mongodb", err)
+		log.Printf("failed to parse graphql max cost, %s", err)
 		os.Exit(0)
 	}
 
-	err = client.Ping(mgoCtx, readpref.Primary())
+	gmd, err := strconv.Atoi(graphqlMaxDepth)
 	if err != nil {
-		log.Println("failed to ping mongodb", err)
+		log.Printf("failed to parse graphql max depth, %s", err)
+		os.Exit(0)
+	}
+
+	// Context used for mongo operations, to time them out and cancel their context.
+	mgoCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	client, err := mgo.Connect(mgoCtx, mongoDBHost, databaseName, mongoUsername, mongoPassword)
+	if err != nil {
+		log.Println("failed to connect to mongodb", err)
 		os.Exit(0)
 	}
 
 	log.Println("connected to mongodb")
 
+	// Index of the character binaries on disk, keeps lookups case insensitive.
+	index := charsave.NewIndex(d2sPath)
+	if err := index.Refresh(); err != nil {
+		log.Println("failed to index d2s path", err)
+		os.Exit(0)
+	}
+
+	// Context for background work, cancelled when the process exits.
+	bgCtx, bgCancel := context.WithCancel(context.Background())
+	defer bgCancel()
+
+	go index.Watch(bgCtx, ii)
+
+	// The realm metadata of characters, when running next to a PvPGN realm.
+	if charInfoPath == "" {
+		charInfoPath = pvpgn.CharInfoPath(d2sPath)
+	}
+
+	charInfo := pvpgn.NewDirectory(charInfoPath)
+	if err := charInfo.Refresh(); err != nil {
+		log.Println("charinfo directory not available, characters are served without realm metadata", err)
+	}
+
 	// Repositories.
 	characterRepository := mgo.NewCharacterRepository(databaseName, client)
 	statisticsRepository := mgo.NewStatisticsRepository(databaseName, client)
+	visibilityRepository := mgo.NewVisibilityRepository(databaseName, client)
 
 	// Business logic services.
-	parser := parsing.NewParser(d2sPath)
-	characterService := character.NewService(parser, characterRepository, cd)
-	statisticsService := statistics.NewService(statisticsRepository)
+	visibilityService := visibility.NewService(visibilityRepository)
+
+	// Character events are delivered to webhooks in the background.
+	webhookService := webhook.NewService(
+		mgo.NewWebhookRepository(databaseName, client),
+		mgo.NewDeadLetterRepository(databaseName, client),
+		&http.Client{},
+		visibilityService,
+	)
+	webhooksDone := make(chan struct{})
+	go func() {
+		webhookService.Run(bgCtx)
+		close(webhooksDone)
+	}()
+
+	// Reparsed characters and posted statistics are pushed to live connections.
+	liveHub := live.NewHub(lm, lmc)
+
+	parser := parsing.NewParser(index, charInfo)
+	grailService := grail.NewService(mgo.NewGrailRepository(databaseName, client))
+	graveyardService := graveyard.NewService(mgo.NewGraveyardRepository(databaseName, client))
+
+	characterListeners := []character.Listener{grailService, graveyardService, webhookService, liveHub}
+	statisticsListeners := []statistics.Listener{graveyardService, webhookService, liveHub}
+
+	// Achievements are unlocked by the rules in the config file, when there is one.
+	var achievementService *achievement.Service
+	if achievementsPath != "" {
+		rules, err := achievement.LoadRules(achievementsPath)
+		if err != nil {
+			log.Println("failed to load achievements", err)
+			os.Exit(0)
+		}
+
+		achievementService = achievement.NewService(rules, mgo.NewAchievementRepository(databaseName, client), characterRepository, statisticsRepository)
+		characterListeners = append(characterListeners, achievementService)
+		statisticsListeners = append(statisticsListeners, achievementService)
+	}
+
+	characterService := character.NewService(parser, index, characterRepository, cd, characterListeners...)
+	statisticsService := statistics.NewService(statisticsRepository, statisticsListeners...)
+
+	// Characters with live connections are reparsed as soon as they're saved in game.
+	go live.NewWatcher(liveHub, index, characterService).Run(bgCtx, lwi)
+
+	// Accounts are resolved from statistics submissions, the realm, and optionally a mapping file.
+	resolvers := []account.Resolver{charInfo}
+	if accountMapPath != "" {
+		mapping := account.NewMapping(accountMapPath)
+		if err := mapping.Load(); err != nil {
+			log.Println("failed to load account mapping", err)
+			os.Exit(0)
+		}
+		resolvers = append(resolvers, mapping)
+	}
+	accountService := account.NewService(statisticsRepository, characterRepository, index, resolvers...)
+	mercenaryService := mercenary.NewService(characterService)
+	progressService := progress.NewService(characterService)
+	skillService := skill.NewService(characterService)
+	cardService := card.NewService(characterService)
+	exportService := export.NewService(characterService)
+	compareService := compare.NewService(characterService, statisticsService)
+	craftService := craft.NewService(characterService)
+
+	graphService, err := graph.NewService(characterService, statisticsService, accountService, gmc, gmd)
+	if err != nil {
+		log.Println("failed to build graphql schema", err)
+		os.Exit(0)
+	}
+
+	// Mark characters deleted in game and purge them after the grace period.
+	go func() {
+		ticker := time.NewTicker(si)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-bgCtx.Done():
+				return
+			case <-ticker.C:
+				marked, purged, err := characterService.SweepDeleted(bgCtx, dg)
+				if err != nil {
+					log.Println("failed to sweep deleted characters", err)
+				}
+
+				if marked > 0 || purged > 0 {
+					log.Printf("marked %d characters as deleted, purged %d", marked, purged)
+				}
+			}
+		}
+	}()
+
+	// Credentials for administrative routes, they're all disabled without them.
+	adminCredentials := map[string]string{}
+	if adminUser != "" {
+		adminCredentials[adminUser] = adminPassword
+	}
+
+	// Optional parts of the HTTP server.
+	serverOptions := []httpserver.Option{
+		httpserver.WithAdminCredentials(adminCredentials),
+		httpserver.WithPublicURL(publicURL),
+		httpserver.WithVisibilityService(visibilityService),
+		httpserver.WithAccountService(accountService),
+		httpserver.WithMercenaryService(mercenaryService),
+		httpserver.WithProgressService(progressService),
+		httpserver.WithSkillService(skillService),
+		httpserver.WithCardService(cardService),
+		httpserver.WithExportService(exportService),
+		httpserver.WithCompareService(compareService),
+		httpserver.WithGrailService(grailService),
+		httpserver.WithCraftService(craftService),
+		httpserver.WithWebhookService(webhookService),
+		httpserver.WithLiveHub(liveHub),
+		httpserver.WithGraveyardService(graveyardService),
+		httpserver.WithGraphService(graphService),
+	}
+
+	if validation {
+		serverOptions = append(serverOptions, httpserver.WithRequestValidation())
+	}
+
+	if achievementService != nil {
+		serverOptions = append(serverOptions, httpserver.WithAchievementService(achievementService))
+	}
+
+	// Localized display names, from the string tables of each language.
+	if localePath != "" {
+		catalog := locale.NewCatalog(localePath)
+		if err := catalog.Load(); err != nil {
+			log.Println("failed to load string tables", err)
+			os.Exit(0)
+		}
+		serverOptions = append(serverOptions, httpserver.WithTranslator(catalog))
+	}
+
+	// Import the realm ladder written by PvPGN, when there is one.
+	if ladderPath != "" {
+		ladderService := ladder.NewService(ladderPath, mgo.NewLadderRepository(databaseName, client), characterRepository)
+		serverOptions = append(serverOptions, httpserver.WithLadderService(ladderService))
+
+		go func() {
+			ticker := time.NewTicker(li)
+			defer ticker.Stop()
+
+			for {
+				if _, err := ladderService.Import(bgCtx); err != nil {
+					log.Println("failed to import realm ladder", err)
+				}
+
+				select {
+				case <-bgCtx.Done():
+					return
+				case <-ticker.C:
+				}
+			}
+		}()
+	}
 
 	// Channel to receive errors on.
 	errorChannel := make(chan error)
@@ -119,18 +387,38 @@ func main() {
 	credentials := map[string]string{
 		statisticsUser: statisticsPassword,
 	}
+
 	// HTTP server.
+	httpServer := httpserver.NewServer(
+		httpAddress,
+		characterService,
+		statisticsService,
+		credentials,
+		cors,
+		logging,
+		serverOptions...,
+	)
+
 	go func() {
-		httpServer := httpserver.NewServer(
-			httpAddress,
+		errorChannel <- httpServer.Open()
+	}()
+
+	// gRPC server for internal consumers, only served when there is a token to authenticate them with.
+	var grpcServer *grpcserver.Server
+	if grpcToken != "" {
+		grpcServer = grpcserver.NewServer(
+			grpcAddress,
 			characterService,
 			statisticsService,
-			credentials,
-			cors,
-			logging,
+			grpcToken,
+			grpcserver.WithVisibilityService(visibilityService),
+			grpcserver.WithLiveHub(liveHub),
 		)
-		errorChannel <- httpServer.Open()
-	}()
+
+		go func() {
+			errorChannel <- grpcServer.Open()
+		}()
+	}
 
 	// Capture interupts.
 	go func() {
@@ -141,6 +429,30 @@ func main() {
 
 	// Listen for errors indefinitely.
 	if err := <-errorChannel; err != nil {
+		log.Println(err)
+
+		// Close the live connections and let the requests in flight complete.
+		shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 10*time.Second)
+		if err := httpServer.Shutdown(shutdownCtx); err != nil {
+			log.Println("failed to shut down HTTP server", err)
+		}
+		if grpcServer != nil {
+			if err := grpcServer.Shutdown(shutdownCtx); err != nil {
+				log.Println("failed to shut down gRPC server", err)
+			}
+		}
+		shutdownCancel()
+
+		// Stop the background work, os.Exit skips the deferred cancel, and give the
+		// queued webhook deliveries time to be put on the dead letter list.
+		bgCancel()
+
+		select {
+		case <-webhooksDone:
+		case <-time.After(10 * time.Second):
+			log.Println("timed out waiting for webhook deliveries to be buried")
+		}
+
 		os.Exit(1)
 	}
 }
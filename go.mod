@@ -5,6 +5,11 @@ go 1.15
 require (
 	github.com/go-chi/chi v1.5.3
 	github.com/go-chi/cors v1.1.1
+	github.com/gorilla/websocket v1.4.2
+	github.com/graphql-go/graphql v0.8.1
 	github.com/nokka/d2s v1.2.0
 	go.mongodb.org/mongo-driver v1.5.1
+	golang.org/x/image v0.0.0-20210220032944-ac19c3e999fb
+	google.golang.org/grpc v1.43.0
+	google.golang.org/protobuf v1.27.1
 )
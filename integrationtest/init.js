@@ -10,9 +10,53 @@ db.createUser({
 
 db.createCollection("character");
 db.createCollection("statistics");
+db.createCollection("visibility");
+db.createCollection("ladder");
+db.createCollection("grail");
+db.createCollection("webhook");
+db.createCollection("dead_letter");
+db.createCollection("graveyard");
+db.createCollection("last_area");
+db.createCollection("achievement");
+db.createCollection("takedown");
 
 // Index characters for name in ascending order.
 db.character.createIndex({ id: 1 });
 
+// Index characters taken down by name, each character is only taken down once.
+db.takedown.createIndex({ character: 1 }, { unique: true });
+
 // Index statistics for character name in ascending order.
 db.statistics.createIndex({ character: 1 });
+
+// Index statistics for account name, used to look up the characters on an account.
+db.statistics.createIndex({ account: 1 });
+
+// Index visibility settings for character name in ascending order.
+db.visibility.createIndex({ character: 1 }, { unique: true });
+
+// Index the realm ladder by split and rank.
+db.ladder.createIndex({ mode: 1, ladder: 1, rank: 1 });
+
+// Index the Holy Grail by account, realm and item, each item is only recorded once.
+db.grail.createIndex({ account: 1, realm: 1, kind: 1, name: 1 }, { unique: true });
+
+// Index webhooks by id and by the events they're subscribed to.
+db.webhook.createIndex({ id: 1 }, { unique: true });
+db.webhook.createIndex({ events: 1 });
+
+// Index dead letters by id.
+db.dead_letter.createIndex({ id: 1 }, { unique: true });
+
+// Index graves by character and when it was born, each character is only buried once
+// while the name may be used again, and by the orders of the graveyard.
+db.graveyard.createIndex({ character: 1, born: 1 }, { unique: true });
+db.graveyard.createIndex({ died_at: -1 });
+db.graveyard.createIndex({ level: -1, experience: -1, died_at: -1 });
+
+// Index the last known areas by character.
+db.last_area.createIndex({ character: 1 }, { unique: true });
+
+// Index unlocked achievements by character, each achievement is only unlocked once, and by when they were unlocked.
+db.achievement.createIndex({ character: 1, achievement: 1 }, { unique: true });
+db.achievement.createIndex({ unlocked_at: 1 });
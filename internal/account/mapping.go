@@ -0,0 +1,90 @@
+package account
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+)
+
+// Mapping resolves the characters of an account from a JSON file mapping
+// account names to character names, e.g. {"nokka": ["nokka", "sorc"]}.
+// The file is read again whenever it changes.
+type Mapping struct {
+	path string
+
+	mu       sync.Mutex
+	modTime  time.Time
+	accounts map[string][]string
+}
+
+// Characters returns the characters mapped to the account, the last successfully
+// read mapping is used if the file can't be read.
+func (m *Mapping) Characters(account string) []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if info, err := os.Stat(m.path); err == nil && !info.ModTime().Equal(m.modTime) {
+		if err := m.load(); err == nil {
+			m.modTime = info.ModTime()
+		}
+	}
+
+	return m.accounts[domain.NormalizeName(account)]
+}
+
+// Load reads the mapping file, it must be called before the mapping is used
+// to make sure the file is valid.
+func (m *Mapping) Load() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	info, err := os.Stat(m.path)
+	if err != nil {
+		return err
+	}
+
+	if err := m.load(); err != nil {
+		return err
+	}
+
+	m.modTime = info.ModTime()
+
+	return nil
+}
+
+func (m *Mapping) load() error {
+	data, err := ioutil.ReadFile(m.path)
+	if err != nil {
+		return err
+	}
+
+	var raw map[string][]string
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("invalid account mapping %s: %w", m.path, err)
+	}
+
+	accounts := make(map[string][]string, len(raw))
+	for account, characters := range raw {
+		key := domain.NormalizeName(account)
+		for _, c := range characters {
+			accounts[key] = append(accounts[key], domain.NormalizeName(c))
+		}
+	}
+
+	m.accounts = accounts
+
+	return nil
+}
+
+// NewMapping returns a new mapping read from the file at path.
+func NewMapping(path string) *Mapping {
+	return &Mapping{
+		path:     path,
+		accounts: make(map[string][]string),
+	}
+}
@@ -0,0 +1,136 @@
+package account
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/nokka/d2-armory-api/internal/charsave"
+	"github.com/nokka/d2-armory-api/internal/domain"
+)
+
+//go:generate moq -out ./service_mocks.go . statisticsRepository characterRepository index Resolver
+
+// statisticsRepository is the interface representation of the statistics data layer
+// the service depend on.
+type statisticsRepository interface {
+	FindByAccount(ctx context.Context, account string, characters []string) ([]domain.CharacterStatistics, error)
+}
+
+// characterRepository is the interface representation of the character data layer
+// the service depend on.
+type characterRepository interface {
+	FindSummaries(ctx context.Context, ids []string) ([]domain.CharacterSummary, error)
+}
+
+// index is the interface representation of the character binaries on disk.
+type index interface {
+	Lookup(name string) (charsave.Entry, bool)
+}
+
+// Resolver resolves characters belonging to an account from other sources
+// than the statistics submissions, such as a mapping file.
+type Resolver interface {
+	Characters(account string) []string
+}
+
+// Service performs all operations on accounts.
+type Service struct {
+	statistics statisticsRepository
+	characters characterRepository
+	index      index
+	resolvers  []Resolver
+}
+
+// Get will get the account with all of its characters, the excluded characters
+// are left out of both the characters and the combined statistics.
+func (s Service) Get(ctx context.Context, name string, exclude map[string]struct{}) (*domain.Account, error) {
+	name = domain.NormalizeName(name)
+	if name == "" {
+		return nil, fmt.Errorf("account name is missing: %w", domain.ErrRequest)
+	}
+
+	// Characters known to belong to the account from other sources.
+	members := make(map[string]struct{})
+	for _, r := range s.resolvers {
+		for _, c := range r.Characters(name) {
+			members[domain.NormalizeName(c)] = struct{}{}
+		}
+	}
+
+	mapped := make([]string, 0, len(members))
+	for c := range members {
+		mapped = append(mapped, c)
+	}
+
+	stats, err := s.statistics.FindByAccount(ctx, name, mapped)
+	if err != nil {
+		return nil, err
+	}
+
+	account := &domain.Account{
+		Name:       name,
+		Characters: make([]domain.CharacterSummary, 0),
+	}
+
+	for _, st := range stats {
+		members[st.Character] = struct{}{}
+
+		if _, ok := exclude[st.Character]; ok {
+			continue
+		}
+
+		account.Statistics.Normal.Add(st.Normal)
+		account.Statistics.Nightmare.Add(st.Nightmare)
+		account.Statistics.Hell.Add(st.Hell)
+	}
+
+	ids := make([]string, 0, len(members))
+	for c := range members {
+		if _, ok := exclude[c]; !ok {
+			ids = append(ids, c)
+		}
+	}
+
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("account %s: %w", name, domain.ErrNotFound)
+	}
+
+	sort.Strings(ids)
+
+	cached, err := s.characters.FindSummaries(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	byName := make(map[string]domain.CharacterSummary, len(cached))
+	for _, c := range cached {
+		byName[c.Name] = c
+	}
+
+	for _, id := range ids {
+		summary, ok := byName[id]
+		if !ok {
+			summary = domain.CharacterSummary{Name: id}
+		}
+
+		if e, ok := s.index.Lookup(id); ok {
+			summary.LastSaved = e.ModTime
+		}
+
+		account.Characters = append(account.Characters, summary)
+	}
+
+	return account, nil
+}
+
+// NewService constructs a new account service with all the dependencies, resolvers
+// are optional sources of characters belonging to accounts.
+func NewService(statisticsRepository statisticsRepository, characterRepository characterRepository, index index, resolvers ...Resolver) *Service {
+	return &Service{
+		statistics: statisticsRepository,
+		characters: characterRepository,
+		index:      index,
+		resolvers:  resolvers,
+	}
+}
@@ -0,0 +1,159 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nokka/d2-armory-api/internal/charsave"
+	"github.com/nokka/d2-armory-api/internal/domain"
+)
+
+func TestGetAccount(t *testing.T) {
+	saved := time.Date(2021, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	type args struct {
+		name    string
+		exclude map[string]struct{}
+	}
+
+	type fields struct {
+		statisticsRepository *statisticsRepositoryMock
+		resolvers            []Resolver
+	}
+
+	tests := []struct {
+		name               string
+		args               args
+		fields             fields
+		expectedCharacters []string
+		expectedKills      int
+		expectedError      error
+	}{
+		{
+			name: "characters from statistics",
+			args: args{name: "Nokka"},
+			fields: fields{
+				statisticsRepository: &statisticsRepositoryMock{
+					FindByAccountFunc: func(ctx context.Context, account string, characters []string) ([]domain.CharacterStatistics, error) {
+						return []domain.CharacterStatistics{
+							{Account: "nokka", Character: "sorc", Normal: domain.Stats{TotalKills: 10}},
+							{Account: "nokka", Character: "amazon", Normal: domain.Stats{TotalKills: 5}},
+						}, nil
+					},
+				},
+			},
+			expectedCharacters: []string{"amazon", "sorc"},
+			expectedKills:      15,
+		},
+		{
+			name: "characters from mapping",
+			args: args{name: "nokka"},
+			fields: fields{
+				statisticsRepository: &statisticsRepositoryMock{
+					FindByAccountFunc: func(ctx context.Context, account string, characters []string) ([]domain.CharacterStatistics, error) {
+						return []domain.CharacterStatistics{
+							{Account: "nokka", Character: "sorc", Normal: domain.Stats{TotalKills: 10}},
+						}, nil
+					},
+				},
+				resolvers: []Resolver{
+					&ResolverMock{
+						CharactersFunc: func(account string) []string {
+							return []string{"Paladin", "sorc"}
+						},
+					},
+				},
+			},
+			expectedCharacters: []string{"paladin", "sorc"},
+			expectedKills:      10,
+		},
+		{
+			name: "excluded characters are left out",
+			args: args{
+				name:    "nokka",
+				exclude: map[string]struct{}{"sorc": {}},
+			},
+			fields: fields{
+				statisticsRepository: &statisticsRepositoryMock{
+					FindByAccountFunc: func(ctx context.Context, account string, characters []string) ([]domain.CharacterStatistics, error) {
+						return []domain.CharacterStatistics{
+							{Account: "nokka", Character: "sorc", Normal: domain.Stats{TotalKills: 10}},
+							{Account: "nokka", Character: "amazon", Normal: domain.Stats{TotalKills: 5}},
+						}, nil
+					},
+				},
+			},
+			expectedCharacters: []string{"amazon"},
+			expectedKills:      5,
+		},
+		{
+			name: "unknown account",
+			args: args{name: "nokka"},
+			fields: fields{
+				statisticsRepository: &statisticsRepositoryMock{
+					FindByAccountFunc: func(ctx context.Context, account string, characters []string) ([]domain.CharacterStatistics, error) {
+						return nil, nil
+					},
+				},
+			},
+			expectedError: domain.ErrNotFound,
+		},
+		{
+			name:          "missing name",
+			args:          args{name: " "},
+			fields:        fields{statisticsRepository: &statisticsRepositoryMock{}},
+			expectedError: domain.ErrRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			characterRepository := &characterRepositoryMock{
+				FindSummariesFunc: func(ctx context.Context, ids []string) ([]domain.CharacterSummary, error) {
+					summaries := make([]domain.CharacterSummary, 0, len(ids))
+					for _, id := range ids {
+						summaries = append(summaries, domain.CharacterSummary{Name: id, Level: 90})
+					}
+					return summaries, nil
+				},
+			}
+
+			index := &indexMock{
+				LookupFunc: func(name string) (charsave.Entry, bool) {
+					return charsave.Entry{Name: name, ModTime: saved}, true
+				},
+			}
+
+			s := NewService(tt.fields.statisticsRepository, characterRepository, index, tt.fields.resolvers...)
+
+			account, err := s.Get(context.Background(), tt.args.name, tt.args.exclude)
+			if !errors.Is(err, tt.expectedError) {
+				t.Fatalf("expected error %v, got %v", tt.expectedError, err)
+			}
+
+			if tt.expectedError != nil {
+				return
+			}
+
+			if len(account.Characters) != len(tt.expectedCharacters) {
+				t.Fatalf("expected %d characters, got %d", len(tt.expectedCharacters), len(account.Characters))
+			}
+
+			for i, c := range account.Characters {
+				if c.Name != tt.expectedCharacters[i] {
+					t.Errorf("expected character %s at %d, got %s", tt.expectedCharacters[i], i, c.Name)
+				}
+
+				if !c.LastSaved.Equal(saved) {
+					t.Errorf("expected last saved %s, got %s", saved, c.LastSaved)
+				}
+			}
+
+			if account.Statistics.Normal.TotalKills != tt.expectedKills {
+				t.Errorf("expected %d kills, got %d", tt.expectedKills, account.Statistics.Normal.TotalKills)
+			}
+		})
+	}
+}
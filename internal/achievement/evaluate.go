@@ -0,0 +1,126 @@
+package achievement
+
+import (
+	"github.com/nokka/d2-armory-api/internal/domain"
+	"github.com/nokka/d2-armory-api/internal/grail"
+	"github.com/nokka/d2-armory-api/internal/progress"
+	"github.com/nokka/d2s"
+)
+
+// subject is what the rules are evaluated against, the character or its
+// statistics are nil when they aren't known, failing the conditions on them.
+type subject struct {
+	character  *d2s.Character
+	statistics *domain.CharacterStatistics
+
+	found    map[string]struct{}
+	progress *domain.Progress
+}
+
+// satisfied reports if every condition of the rule holds.
+func (s *subject) satisfied(r Rule) bool {
+	for _, c := range r.Conditions {
+		if !s.holds(c) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s *subject) holds(c Condition) bool {
+	switch c.Type {
+	case ConditionLevel:
+		return s.character != nil && int(s.character.Header.Level) >= c.Min
+	case ConditionHardcore:
+		return s.character != nil && s.character.Header.Status.Readable().Hardcore
+	case ConditionKills:
+		return s.statistics != nil && s.kills(c.Difficulty, func(st domain.Stats) int {
+			switch c.Stat {
+			case StatUniqueKills:
+				return st.TotalUniqueKills
+			case StatChampionKills:
+				return st.TotalChampKills
+			}
+			return st.TotalKills
+		}) >= c.Min
+	case ConditionMonsterKills:
+		return s.statistics != nil && s.kills(c.Difficulty, func(st domain.Stats) int {
+			return st.Special[c.Monster]
+		}) >= c.Min
+	case ConditionQuests:
+		return s.character != nil && completed(s.difficulty(c.Difficulty).Quests)
+	case ConditionItems:
+		if s.character == nil {
+			return false
+		}
+
+		found := s.items()
+		for _, name := range c.Items {
+			if _, ok := found[name]; !ok {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return false
+}
+
+// kills sums the kills of the difficulty, or of every difficulty when it's empty.
+func (s *subject) kills(difficulty string, count func(domain.Stats) int) int {
+	switch difficulty {
+	case domain.DifficultyNormal:
+		return count(s.statistics.Normal)
+	case domain.DifficultyNightmare:
+		return count(s.statistics.Nightmare)
+	case domain.DifficultyHell:
+		return count(s.statistics.Hell)
+	}
+
+	return count(s.statistics.Normal) + count(s.statistics.Nightmare) + count(s.statistics.Hell)
+}
+
+// difficulty returns the progress of the character in the difficulty.
+func (s *subject) difficulty(difficulty string) domain.DifficultyProgress {
+	if s.progress == nil {
+		s.progress = progress.Of(s.character.Header)
+	}
+
+	switch difficulty {
+	case domain.DifficultyNightmare:
+		return s.progress.Nightmare
+	case domain.DifficultyHell:
+		return s.progress.Hell
+	}
+
+	return s.progress.Normal
+}
+
+// items returns the names of the notable items the character has.
+func (s *subject) items() map[string]struct{} {
+	if s.found == nil {
+		s.found = make(map[string]struct{})
+		for _, i := range grail.Found(s.character) {
+			s.found[i.Name] = struct{}{}
+		}
+	}
+
+	return s.found
+}
+
+// completed reports if every quest has been completed.
+func completed(quests []domain.QuestProgress) bool {
+	if len(quests) == 0 {
+		return false
+	}
+
+	for _, q := range quests {
+		if !q.Completed {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,161 @@
+package achievement
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+)
+
+// Condition types, every condition of a rule must hold for it to be unlocked.
+const (
+	// ConditionLevel holds when the character is at least level Min.
+	ConditionLevel = "level"
+
+	// ConditionHardcore holds for hardcore characters.
+	ConditionHardcore = "hardcore"
+
+	// ConditionKills holds when the character has killed at least Min monsters
+	// of the Stat in the Difficulty, or in every difficulty combined if it's empty.
+	ConditionKills = "kills"
+
+	// ConditionMonsterKills holds when the character has killed the special
+	// Monster at least Min times in the Difficulty, or in every difficulty combined.
+	ConditionMonsterKills = "monster_kills"
+
+	// ConditionQuests holds when the character has completed every quest of the Difficulty.
+	ConditionQuests = "quests"
+
+	// ConditionItems holds when the character has every one of the Items, the names of
+	// uniques, set items, runes or runewords, anywhere on it or its mercenary.
+	ConditionItems = "items"
+)
+
+// Kill statistics counted by kills conditions.
+const (
+	StatKills         = "kills"
+	StatUniqueKills   = "unique_kills"
+	StatChampionKills = "champion_kills"
+)
+
+// Rules are the achievements as declared in the config file.
+type Rules struct {
+	Achievements []Rule `json:"achievements"`
+}
+
+// Rule declares an achievement and the conditions to unlock it.
+type Rule struct {
+	ID          string      `json:"id"`
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	Conditions  []Condition `json:"conditions"`
+}
+
+// Condition is a single requirement of a rule, the fields used depend on the type.
+type Condition struct {
+	Type       string   `json:"type"`
+	Min        int      `json:"min,omitempty"`
+	Difficulty string   `json:"difficulty,omitempty"`
+	Stat       string   `json:"stat,omitempty"`
+	Monster    string   `json:"monster,omitempty"`
+	Items      []string `json:"items,omitempty"`
+}
+
+var difficulties = map[string]struct{}{
+	domain.DifficultyNormal:    {},
+	domain.DifficultyNightmare: {},
+	domain.DifficultyHell:      {},
+}
+
+var stats = map[string]struct{}{
+	StatKills:         {},
+	StatUniqueKills:   {},
+	StatChampionKills: {},
+}
+
+// LoadRules reads the rules from the JSON config file.
+func LoadRules(path string) ([]Rule, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var rules Rules
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, fmt.Errorf("invalid achievements file %s: %w", path, err)
+	}
+
+	seen := make(map[string]struct{}, len(rules.Achievements))
+	for _, r := range rules.Achievements {
+		if err := r.validate(); err != nil {
+			return nil, fmt.Errorf("invalid achievement %q: %w", r.ID, err)
+		}
+
+		if _, ok := seen[r.ID]; ok {
+			return nil, fmt.Errorf("achievement %s is declared twice", r.ID)
+		}
+		seen[r.ID] = struct{}{}
+	}
+
+	return rules.Achievements, nil
+}
+
+func (r Rule) validate() error {
+	if r.ID == "" || r.Name == "" {
+		return fmt.Errorf("id and name are required")
+	}
+
+	if len(r.Conditions) == 0 {
+		return fmt.Errorf("at least one condition is required")
+	}
+
+	for _, c := range r.Conditions {
+		if err := c.validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c Condition) validate() error {
+	if c.Difficulty != "" {
+		if _, ok := difficulties[c.Difficulty]; !ok {
+			return fmt.Errorf("unknown difficulty %s", c.Difficulty)
+		}
+	}
+
+	switch c.Type {
+	case ConditionLevel:
+		if c.Min < 1 || c.Min > 99 {
+			return fmt.Errorf("level must be between 1 and 99")
+		}
+	case ConditionHardcore:
+	case ConditionKills:
+		if c.Stat != "" {
+			if _, ok := stats[c.Stat]; !ok {
+				return fmt.Errorf("unknown stat %s", c.Stat)
+			}
+		}
+		if c.Min < 1 {
+			return fmt.Errorf("kills require a min")
+		}
+	case ConditionMonsterKills:
+		if c.Monster == "" || c.Min < 1 {
+			return fmt.Errorf("monster kills require a monster and a min")
+		}
+	case ConditionQuests:
+		if c.Difficulty == "" {
+			return fmt.Errorf("quests require a difficulty")
+		}
+	case ConditionItems:
+		if len(c.Items) == 0 {
+			return fmt.Errorf("items require at least one item")
+		}
+	default:
+		return fmt.Errorf("unknown condition %s", c.Type)
+	}
+
+	return nil
+}
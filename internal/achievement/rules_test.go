@@ -0,0 +1,89 @@
+package achievement
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadRules(t *testing.T) {
+	tests := []struct {
+		name          string
+		rules         string
+		expected      int
+		expectedError bool
+	}{
+		{
+			name:     "valid rules",
+			rules:    `{"achievements": [{"id": "level_99", "name": "Reached level 99", "conditions": [{"type": "level", "min": 99}]}]}`,
+			expected: 1,
+		},
+		{
+			name:          "invalid json",
+			rules:         `{"achievements": [`,
+			expectedError: true,
+		},
+		{
+			name:          "missing name",
+			rules:         `{"achievements": [{"id": "level_99", "conditions": [{"type": "level", "min": 99}]}]}`,
+			expectedError: true,
+		},
+		{
+			name:          "without conditions",
+			rules:         `{"achievements": [{"id": "level_99", "name": "Reached level 99"}]}`,
+			expectedError: true,
+		},
+		{
+			name:          "unknown condition",
+			rules:         `{"achievements": [{"id": "gold", "name": "Rich", "conditions": [{"type": "gold", "min": 1}]}]}`,
+			expectedError: true,
+		},
+		{
+			name:          "unknown difficulty",
+			rules:         `{"achievements": [{"id": "kills", "name": "Kills", "conditions": [{"type": "kills", "difficulty": "Inferno", "min": 1}]}]}`,
+			expectedError: true,
+		},
+		{
+			name:          "level out of range",
+			rules:         `{"achievements": [{"id": "level_100", "name": "Level 100", "conditions": [{"type": "level", "min": 100}]}]}`,
+			expectedError: true,
+		},
+		{
+			name: "declared twice",
+			rules: `{"achievements": [
+				{"id": "level_99", "name": "Reached level 99", "conditions": [{"type": "level", "min": 99}]},
+				{"id": "level_99", "name": "Reached level 99", "conditions": [{"type": "level", "min": 99}]}
+			]}`,
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "achievements.json")
+			if err := ioutil.WriteFile(path, []byte(tt.rules), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			rules, err := LoadRules(path)
+			if (err != nil) != tt.expectedError {
+				t.Fatalf("got error = %v, expectedError %v", err, tt.expectedError)
+			}
+
+			if len(rules) != tt.expected {
+				t.Errorf("expected %d rules, got = %d", tt.expected, len(rules))
+			}
+		})
+	}
+}
+
+func TestLoadExampleRules(t *testing.T) {
+	rules, err := LoadRules("../../docs/achievements.json")
+	if err != nil {
+		t.Fatalf("expected the example rules to be valid, got = %v", err)
+	}
+
+	if len(rules) == 0 {
+		t.Errorf("expected example rules")
+	}
+}
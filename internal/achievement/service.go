@@ -0,0 +1,212 @@
+package achievement
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+	"github.com/nokka/d2s"
+)
+
+//go:generate moq -out ./service_mocks.go . achievementRepository characterRepository statisticsRepository
+
+// achievementRepository is the interface representation of the data layer
+// the service depend on.
+type achievementRepository interface {
+	Unlock(ctx context.Context, unlocks []domain.AchievementUnlock) error
+	FindByCharacter(ctx context.Context, character string) ([]domain.AchievementUnlock, error)
+	Tally(ctx context.Context, exclude map[string]struct{}) ([]domain.AchievementTally, error)
+}
+
+// characterRepository is the interface representation of the cached characters,
+// rules are evaluated against when statistics are posted.
+type characterRepository interface {
+	Find(ctx context.Context, id string) (*domain.Character, error)
+}
+
+// statisticsRepository is the interface representation of the statistics,
+// rules are evaluated against when characters are parsed.
+type statisticsRepository interface {
+	GetByCharacter(ctx context.Context, character string) (*domain.CharacterStatistics, error)
+}
+
+// Service unlocks the achievements of characters as their rules are satisfied.
+type Service struct {
+	rules        []Rule
+	repository   achievementRepository
+	characters   characterRepository
+	statistics   statisticsRepository
+	now          func() time.Time
+	achievements []domain.Achievement
+}
+
+// CharacterPersisted evaluates the rules against the parsed character and its statistics.
+func (s Service) CharacterPersisted(ctx context.Context, previous *domain.Character, current *domain.Character) error {
+	if current == nil || current.D2s == nil {
+		return nil
+	}
+
+	stats, err := s.statistics.GetByCharacter(ctx, current.ID)
+	if err != nil && !errors.Is(err, domain.ErrNotFound) {
+		return err
+	}
+
+	return s.evaluate(ctx, current.ID, current.D2s, stats)
+}
+
+// StatisticsSubmitted evaluates the rules against the statistics, as they are after
+// the submission, and the character as it was last parsed.
+func (s Service) StatisticsSubmitted(ctx context.Context, previous *domain.CharacterStatistics, submitted domain.StatisticsRequest) error {
+	name := domain.NormalizeName(submitted.Character)
+
+	stats, err := s.statistics.GetByCharacter(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	var c *d2s.Character
+	char, err := s.characters.Find(ctx, name)
+	switch {
+	case err == nil:
+		c = char.D2s
+	case !errors.Is(err, domain.ErrNotFound):
+		return err
+	}
+
+	return s.evaluate(ctx, name, c, stats)
+}
+
+// evaluate unlocks the achievements the character has satisfied the rules of since they were last evaluated.
+func (s Service) evaluate(ctx context.Context, name string, c *d2s.Character, stats *domain.CharacterStatistics) error {
+	if len(s.rules) == 0 {
+		return nil
+	}
+
+	unlocked, err := s.unlocked(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	subject := &subject{character: c, statistics: stats}
+	now := s.now().UTC().Truncate(time.Millisecond)
+
+	var unlocks []domain.AchievementUnlock
+	for _, r := range s.rules {
+		if _, ok := unlocked[r.ID]; ok {
+			continue
+		}
+
+		if subject.satisfied(r) {
+			unlocks = append(unlocks, domain.AchievementUnlock{
+				Character:   name,
+				Achievement: r.ID,
+				UnlockedAt:  now,
+			})
+		}
+	}
+
+	if len(unlocks) == 0 {
+		return nil
+	}
+
+	return s.repository.Unlock(ctx, unlocks)
+}
+
+// unlocked returns when the character unlocked its achievements by achievement.
+func (s Service) unlocked(ctx context.Context, name string) (map[string]time.Time, error) {
+	unlocks, err := s.repository.FindByCharacter(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	unlocked := make(map[string]time.Time, len(unlocks))
+	for _, u := range unlocks {
+		unlocked[u.Achievement] = u.UnlockedAt
+	}
+
+	return unlocked, nil
+}
+
+// Character will get every achievement and which of them the character has unlocked.
+func (s Service) Character(ctx context.Context, name string) (*domain.CharacterAchievements, error) {
+	name = domain.NormalizeName(name)
+
+	unlocked, err := s.unlocked(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &domain.CharacterAchievements{
+		Character:    name,
+		Total:        len(s.achievements),
+		Achievements: make([]domain.CharacterAchievement, 0, len(s.achievements)),
+	}
+
+	// Achievements that are no longer declared aren't shown.
+	for _, a := range s.achievements {
+		ca := domain.CharacterAchievement{Achievement: a}
+
+		if at, ok := unlocked[a.ID]; ok {
+			at := at
+			ca.UnlockedAt = &at
+			result.Unlocked++
+		}
+
+		result.Achievements = append(result.Achievements, ca)
+	}
+
+	return result, nil
+}
+
+// List will list every achievement with how many characters have unlocked it,
+// and which did so first and last. Characters in exclude aren't counted.
+func (s Service) List(ctx context.Context, exclude map[string]struct{}) ([]domain.AchievementSummary, error) {
+	tallies, err := s.repository.Tally(ctx, exclude)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[string]domain.AchievementTally, len(tallies))
+	for _, t := range tallies {
+		byID[t.Achievement] = t
+	}
+
+	summaries := make([]domain.AchievementSummary, 0, len(s.achievements))
+	for _, a := range s.achievements {
+		summary := domain.AchievementSummary{Achievement: a}
+
+		if t, ok := byID[a.ID]; ok {
+			first, latest := t.First, t.Latest
+			summary.Unlocked = t.Unlocked
+			summary.First = &first
+			summary.Latest = &latest
+		}
+
+		summaries = append(summaries, summary)
+	}
+
+	return summaries, nil
+}
+
+// NewService constructs a new achievement service with all the dependencies,
+// the achievements are unlocked by the rules in the order they're declared.
+func NewService(rules []Rule, repository achievementRepository, characters characterRepository, statistics statisticsRepository) *Service {
+	achievements := make([]domain.Achievement, 0, len(rules))
+	for _, r := range rules {
+		achievements = append(achievements, domain.Achievement{
+			ID:          r.ID,
+			Name:        r.Name,
+			Description: r.Description,
+		})
+	}
+
+	return &Service{
+		rules:        rules,
+		repository:   repository,
+		characters:   characters,
+		statistics:   statistics,
+		now:          time.Now,
+		achievements: achievements,
+	}
+}
@@ -0,0 +1,240 @@
+package achievement
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+	"github.com/nokka/d2s"
+)
+
+var rules = []Rule{
+	{ID: "level_99", Name: "Reached level 99", Conditions: []Condition{{Type: ConditionLevel, Min: 99}}},
+	{ID: "hardcore_90", Name: "Survivor", Conditions: []Condition{{Type: ConditionHardcore}, {Type: ConditionLevel, Min: 90}}},
+	{ID: "hell_kills", Name: "Killed 10,000 monsters in Hell", Conditions: []Condition{{Type: ConditionKills, Difficulty: domain.DifficultyHell, Min: 10000}}},
+	{ID: "uniques", Name: "Killed 100 unique monsters", Conditions: []Condition{{Type: ConditionKills, Stat: StatUniqueKills, Min: 100}}},
+	{ID: "baal", Name: "Killed Baal 10 times", Conditions: []Condition{{Type: ConditionMonsterKills, Monster: "Baal", Min: 10}}},
+	{ID: "nightmare_quests", Name: "Completed all Nightmare quests", Conditions: []Condition{{Type: ConditionQuests, Difficulty: domain.DifficultyNightmare}}},
+	{ID: "shako_enigma", Name: "Shako and Enigma", Conditions: []Condition{{Type: ConditionItems, Items: []string{"Harlequin Crest", "Enigma"}}}},
+}
+
+// completeQuests marks every quest of the difficulty as completed, the quest types of d2s are unexported.
+func completeQuests(quests interface{}) {
+	acts := reflect.ValueOf(quests).Elem()
+	for i := 0; i < acts.NumField(); i++ {
+		act := acts.Field(i)
+		if act.Kind() != reflect.Struct {
+			continue
+		}
+
+		for j := 0; j < act.NumField(); j++ {
+			if q := act.Field(j); q.Kind() == reflect.Array && q.CanSet() {
+				q.Index(0).SetUint(1)
+			}
+		}
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	softcore := d2s.Header{Level: 99}
+	softcore.Status = 0x20
+
+	hardcore := d2s.Header{Level: 92}
+	hardcore.Status = 0x24
+	completeQuests(&hardcore.QuestsNm)
+
+	items := []d2s.Item{
+		{Type: "uap", UniqueName: "Harlequin Crest"},
+		{Type: "uui", RunewordName: "Enigma"},
+	}
+
+	tests := []struct {
+		name      string
+		character *d2s.Character
+		stats     *domain.CharacterStatistics
+		expected  []string
+	}{
+		{
+			name:      "softcore level 99",
+			character: &d2s.Character{Header: softcore},
+			expected:  []string{"level_99"},
+		},
+		{
+			name:      "hardcore character with completed nightmare quests",
+			character: &d2s.Character{Header: hardcore, Items: items},
+			expected:  []string{"hardcore_90", "nightmare_quests", "shako_enigma"},
+		},
+		{
+			name: "statistics only",
+			stats: &domain.CharacterStatistics{
+				Normal:    domain.Stats{TotalUniqueKills: 60, Special: map[string]int{"Baal": 4}},
+				Nightmare: domain.Stats{Special: map[string]int{"Baal": 4}},
+				Hell:      domain.Stats{TotalKills: 10000, TotalUniqueKills: 40, Special: map[string]int{"Baal": 2}},
+			},
+			expected: []string{"hell_kills", "uniques", "baal"},
+		},
+		{
+			name: "not enough kills",
+			stats: &domain.CharacterStatistics{
+				Normal: domain.Stats{TotalKills: 10000},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subject := &subject{character: tt.character, statistics: tt.stats}
+
+			var unlocked []string
+			for _, r := range rules {
+				if subject.satisfied(r) {
+					unlocked = append(unlocked, r.ID)
+				}
+			}
+
+			if !reflect.DeepEqual(unlocked, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, unlocked)
+			}
+		})
+	}
+}
+
+func TestCharacterPersisted(t *testing.T) {
+	now := time.Date(2021, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	header := d2s.Header{Level: 99}
+	header.Status = 0x24
+
+	repository := &achievementRepositoryMock{
+		FindByCharacterFunc: func(ctx context.Context, character string) ([]domain.AchievementUnlock, error) {
+			return []domain.AchievementUnlock{{Character: "nokka", Achievement: "level_99"}}, nil
+		},
+		UnlockFunc: func(ctx context.Context, unlocks []domain.AchievementUnlock) error {
+			return nil
+		},
+	}
+
+	statistics := &statisticsRepositoryMock{
+		GetByCharacterFunc: func(ctx context.Context, character string) (*domain.CharacterStatistics, error) {
+			return nil, domain.ErrNotFound
+		},
+	}
+
+	s := NewService(rules, repository, &characterRepositoryMock{}, statistics)
+	s.now = func() time.Time { return now }
+
+	err := s.CharacterPersisted(context.Background(), nil, &domain.Character{ID: "nokka", D2s: &d2s.Character{Header: header}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Level 99 was unlocked before, so only the new achievement is unlocked.
+	expected := []domain.AchievementUnlock{{Character: "nokka", Achievement: "hardcore_90", UnlockedAt: now}}
+
+	calls := repository.UnlockCalls()
+	if len(calls) != 1 || !reflect.DeepEqual(calls[0].Unlocks, expected) {
+		t.Errorf("expected %v to be unlocked, got %+v", expected, calls)
+	}
+}
+
+func TestStatisticsSubmitted(t *testing.T) {
+	repository := &achievementRepositoryMock{
+		FindByCharacterFunc: func(ctx context.Context, character string) ([]domain.AchievementUnlock, error) {
+			return nil, nil
+		},
+		UnlockFunc: func(ctx context.Context, unlocks []domain.AchievementUnlock) error {
+			return nil
+		},
+	}
+
+	statistics := &statisticsRepositoryMock{
+		GetByCharacterFunc: func(ctx context.Context, character string) (*domain.CharacterStatistics, error) {
+			return &domain.CharacterStatistics{Hell: domain.Stats{TotalKills: 12000}}, nil
+		},
+	}
+
+	characters := &characterRepositoryMock{
+		FindFunc: func(ctx context.Context, id string) (*domain.Character, error) {
+			return nil, domain.ErrNotFound
+		},
+	}
+
+	s := NewService(rules, repository, characters, statistics)
+
+	err := s.StatisticsSubmitted(context.Background(), nil, domain.StatisticsRequest{Character: "Nokka", Difficulty: domain.DifficultyHell})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	calls := repository.UnlockCalls()
+	if len(calls) != 1 || len(calls[0].Unlocks) != 1 || calls[0].Unlocks[0].Achievement != "hell_kills" || calls[0].Unlocks[0].Character != "nokka" {
+		t.Errorf("expected hell_kills to be unlocked by nokka, got %+v", calls)
+	}
+}
+
+func TestCharacter(t *testing.T) {
+	at := time.Date(2021, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	repository := &achievementRepositoryMock{
+		FindByCharacterFunc: func(ctx context.Context, character string) ([]domain.AchievementUnlock, error) {
+			return []domain.AchievementUnlock{
+				{Character: "nokka", Achievement: "baal", UnlockedAt: at},
+				{Character: "nokka", Achievement: "removed", UnlockedAt: at},
+			}, nil
+		},
+	}
+
+	s := NewService(rules, repository, &characterRepositoryMock{}, &statisticsRepositoryMock{})
+
+	achievements, err := s.Character(context.Background(), "Nokka")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if achievements.Unlocked != 1 || achievements.Total != len(rules) || len(achievements.Achievements) != len(rules) {
+		t.Fatalf("unexpected achievements %+v", achievements)
+	}
+
+	for _, a := range achievements.Achievements {
+		if unlocked := a.UnlockedAt != nil; unlocked != (a.ID == "baal") {
+			t.Errorf("unexpected unlock of %s", a.ID)
+		}
+	}
+}
+
+func TestList(t *testing.T) {
+	first := domain.AchievementUnlock{Character: "nokka", Achievement: "baal"}
+	latest := domain.AchievementUnlock{Character: "sorc", Achievement: "baal"}
+
+	repository := &achievementRepositoryMock{
+		TallyFunc: func(ctx context.Context, exclude map[string]struct{}) ([]domain.AchievementTally, error) {
+			return []domain.AchievementTally{{Achievement: "baal", Unlocked: 2, First: first, Latest: latest}}, nil
+		},
+	}
+
+	s := NewService(rules, repository, &characterRepositoryMock{}, &statisticsRepositoryMock{})
+
+	summaries, err := s.List(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(summaries) != len(rules) {
+		t.Fatalf("expected every achievement, got = %d", len(summaries))
+	}
+
+	for _, summary := range summaries {
+		if summary.ID != "baal" {
+			if summary.Unlocked != 0 || summary.First != nil {
+				t.Errorf("didn't expect %s to be unlocked", summary.ID)
+			}
+			continue
+		}
+
+		if summary.Unlocked != 2 || *summary.First != first || *summary.Latest != latest {
+			t.Errorf("unexpected summary %+v", summary)
+		}
+	}
+}
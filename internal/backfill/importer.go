@@ -0,0 +1,231 @@
+package backfill
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/nokka/d2-armory-api/internal/charsave"
+	"github.com/nokka/d2-armory-api/internal/domain"
+)
+
+//go:generate moq -out ./importer_mocks.go . index characterService characterRepository
+
+// index is the interface representation of the character binaries on disk.
+type index interface {
+	Refresh() error
+	Entries() []charsave.Entry
+}
+
+// characterService is the interface representation of the character service
+// the importer depend on, characters are imported the same way they're parsed
+// on request so the listeners are notified and takedowns are honored.
+type characterService interface {
+	Reparse(ctx context.Context, name string) (*domain.Character, error)
+}
+
+// characterRepository is the interface representation of the data layer
+// the importer depend on.
+type characterRepository interface {
+	FindSummaries(ctx context.Context, ids []string) ([]domain.CharacterSummary, error)
+}
+
+// batchSize is the number of characters to look up at once when
+// deciding which characters are already imported.
+const batchSize = 500
+
+// Failure describes a character that couldn't be imported.
+type Failure struct {
+	Name string
+	Err  error
+}
+
+// Progress is a snapshot of a running or finished import.
+type Progress struct {
+	Total    int
+	Imported int
+	Skipped  int
+	Failed   int
+	Elapsed  time.Duration
+}
+
+// Rate returns the number of characters processed per second.
+func (p Progress) Rate() float64 {
+	if p.Elapsed <= 0 {
+		return 0
+	}
+
+	return float64(p.Imported+p.Skipped+p.Failed) / p.Elapsed.Seconds()
+}
+
+// Importer parses every character binary on disk and stores it, it's safe to run
+// it again after an interruption, characters that haven't been saved since they
+// were last parsed are skipped unless forced.
+type Importer struct {
+	index      index
+	service    characterService
+	characters characterRepository
+	workers    int
+	force      bool
+
+	started  int64
+	total    int64
+	imported int64
+	skipped  int64
+	failed   int64
+}
+
+// Progress returns a snapshot of the current progress.
+func (i *Importer) Progress() Progress {
+	return Progress{
+		Total:    int(atomic.LoadInt64(&i.total)),
+		Imported: int(atomic.LoadInt64(&i.imported)),
+		Skipped:  int(atomic.LoadInt64(&i.skipped)),
+		Failed:   int(atomic.LoadInt64(&i.failed)),
+		Elapsed:  time.Since(time.Unix(0, atomic.LoadInt64(&i.started))),
+	}
+}
+
+// Run will import all characters using a bounded pool of workers, it returns
+// the characters that failed to import, failures don't stop the import.
+func (i *Importer) Run(ctx context.Context) ([]Failure, error) {
+	atomic.StoreInt64(&i.started, time.Now().UnixNano())
+
+	if err := i.index.Refresh(); err != nil {
+		return nil, err
+	}
+
+	entries := i.index.Entries()
+	atomic.StoreInt64(&i.total, int64(len(entries)))
+
+	var (
+		jobs     = make(chan charsave.Entry)
+		mu       sync.Mutex
+		failures []Failure
+		wg       sync.WaitGroup
+	)
+
+	for w := 0; w < i.workers; w++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for e := range jobs {
+				if err := i.importCharacter(ctx, e); err != nil {
+					// Taken down, or deleted since it was indexed, there's nothing to import.
+					if errors.Is(err, domain.ErrGone) {
+						atomic.AddInt64(&i.skipped, 1)
+						continue
+					}
+
+					atomic.AddInt64(&i.failed, 1)
+
+					mu.Lock()
+					failures = append(failures, Failure{Name: e.Name, Err: err})
+					mu.Unlock()
+
+					continue
+				}
+
+				atomic.AddInt64(&i.imported, 1)
+			}
+		}()
+	}
+
+	err := i.dispatch(ctx, entries, jobs)
+
+	close(jobs)
+	wg.Wait()
+
+	return failures, err
+}
+
+// dispatch sends the entries that need to be imported to the workers, in batches
+// to be able to skip the already imported characters with a single query.
+func (i *Importer) dispatch(ctx context.Context, entries []charsave.Entry, jobs chan<- charsave.Entry) error {
+	for start := 0; start < len(entries); start += batchSize {
+		end := start + batchSize
+		if end > len(entries) {
+			end = len(entries)
+		}
+
+		batch := entries[start:end]
+
+		imported, err := i.alreadyImported(ctx, batch)
+		if err != nil {
+			return err
+		}
+
+		for _, e := range batch {
+			if _, ok := imported[e.Name]; ok {
+				atomic.AddInt64(&i.skipped, 1)
+				continue
+			}
+
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case jobs <- e:
+			}
+		}
+	}
+
+	return nil
+}
+
+// alreadyImported returns the names of the characters in the batch that have been
+// parsed after they were last saved, they would be identical if parsed again.
+func (i *Importer) alreadyImported(ctx context.Context, batch []charsave.Entry) (map[string]struct{}, error) {
+	imported := make(map[string]struct{})
+
+	if i.force {
+		return imported, nil
+	}
+
+	ids := make([]string, 0, len(batch))
+	for _, e := range batch {
+		ids = append(ids, e.Name)
+	}
+
+	summaries, err := i.characters.FindSummaries(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	saved := make(map[string]time.Time, len(batch))
+	for _, e := range batch {
+		saved[e.Name] = e.ModTime
+	}
+
+	for _, s := range summaries {
+		if s.LastParsed != nil && s.LastParsed.After(saved[s.Name]) {
+			imported[s.Name] = struct{}{}
+		}
+	}
+
+	return imported, nil
+}
+
+func (i *Importer) importCharacter(ctx context.Context, e charsave.Entry) error {
+	_, err := i.service.Reparse(ctx, e.Name)
+	return err
+}
+
+// NewImporter constructs a new importer with all the dependencies, force will
+// import all characters, even those already imported.
+func NewImporter(index index, characterService characterService, characterRepository characterRepository, workers int, force bool) *Importer {
+	if workers < 1 {
+		workers = 1
+	}
+
+	return &Importer{
+		index:      index,
+		service:    characterService,
+		characters: characterRepository,
+		workers:    workers,
+		force:      force,
+	}
+}
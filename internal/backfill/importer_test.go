@@ -0,0 +1,116 @@
+package backfill
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nokka/d2-armory-api/internal/charsave"
+	"github.com/nokka/d2-armory-api/internal/domain"
+)
+
+func TestImporterRun(t *testing.T) {
+	now := time.Now()
+
+	entries := []charsave.Entry{
+		{Name: "nokka", ModTime: now.Add(-2 * time.Hour)},
+		{Name: "sorc", ModTime: now.Add(-1 * time.Hour)},
+		{Name: "broken", ModTime: now.Add(-1 * time.Hour)},
+		{Name: "banned", ModTime: now.Add(-1 * time.Hour)},
+	}
+
+	// Nokka was parsed after it was last saved, sorc was saved since.
+	lastParsed := now.Add(-90 * time.Minute)
+
+	tests := []struct {
+		name         string
+		force        bool
+		imported     int
+		skipped      int
+		failed       int
+		reparseCalls int
+	}{
+		{
+			name:         "resumes where it left off",
+			imported:     1,
+			skipped:      2,
+			failed:       1,
+			reparseCalls: 3,
+		},
+		{
+			name:         "forced import",
+			force:        true,
+			imported:     2,
+			skipped:      1,
+			failed:       1,
+			reparseCalls: 4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			index := &indexMock{
+				RefreshFunc: func() error {
+					return nil
+				},
+				EntriesFunc: func() []charsave.Entry {
+					return entries
+				},
+			}
+
+			// Banned was taken down, it's skipped rather than failed.
+			characterService := &characterServiceMock{
+				ReparseFunc: func(ctx context.Context, name string) (*domain.Character, error) {
+					switch name {
+					case "broken":
+						return nil, errors.New("binary parse error")
+					case "banned":
+						return nil, domain.ErrGone
+					}
+					return &domain.Character{ID: name}, nil
+				},
+			}
+
+			characterRepository := &characterRepositoryMock{
+				FindSummariesFunc: func(ctx context.Context, ids []string) ([]domain.CharacterSummary, error) {
+					return []domain.CharacterSummary{
+						{Name: "nokka", LastParsed: &lastParsed},
+						{Name: "sorc", LastParsed: &lastParsed},
+					}, nil
+				},
+			}
+
+			importer := NewImporter(index, characterService, characterRepository, 2, tt.force)
+
+			failures, err := importer.Run(context.TODO())
+			if err != nil {
+				t.Fatalf("didn't expect an error, got = %v", err)
+			}
+
+			if len(failures) != 1 || failures[0].Name != "broken" {
+				t.Errorf("expected broken to fail, got = %v", failures)
+			}
+
+			progress := importer.Progress()
+
+			if progress.Total != len(entries) {
+				t.Errorf("expected total to be = %d, got = %d", len(entries), progress.Total)
+			}
+
+			if progress.Imported != tt.imported || progress.Skipped != tt.skipped || progress.Failed != tt.failed {
+				t.Errorf("expected imported/skipped/failed to be = %d/%d/%d, got = %d/%d/%d",
+					tt.imported, tt.skipped, tt.failed,
+					progress.Imported, progress.Skipped, progress.Failed,
+				)
+			}
+
+			if len(characterService.ReparseCalls()) != tt.reparseCalls {
+				t.Errorf("expected characterService.Reparse() to be called exactly %d times but was called %d times",
+					tt.reparseCalls,
+					len(characterService.ReparseCalls()),
+				)
+			}
+		})
+	}
+}
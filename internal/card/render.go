@@ -0,0 +1,181 @@
+package card
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+	"github.com/nokka/d2-armory-api/internal/gear"
+	"github.com/nokka/d2s"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/math/fixed"
+)
+
+// Templates of the card.
+const (
+	TemplateClassic = "classic"
+	TemplateDark    = "dark"
+	TemplateLight   = "light"
+)
+
+// Sizes of the card.
+const (
+	SizeSmall  = "small"
+	SizeMedium = "medium"
+	SizeLarge  = "large"
+)
+
+// theme are the colors of a template.
+type theme struct {
+	background color.Color
+	border     color.Color
+	text       color.Color
+	muted      color.Color
+	hardcore   color.Color
+}
+
+var templates = map[string]theme{
+	TemplateClassic: {
+		background: color.RGBA{R: 0x14, G: 0x10, B: 0x0c, A: 0xff},
+		border:     color.RGBA{R: 0x8c, G: 0x76, B: 0x4a, A: 0xff},
+		text:       color.RGBA{R: 0xc7, G: 0xb3, B: 0x77, A: 0xff},
+		muted:      color.RGBA{R: 0x8a, G: 0x80, B: 0x6c, A: 0xff},
+		hardcore:   color.RGBA{R: 0xd0, G: 0x30, B: 0x30, A: 0xff},
+	},
+	TemplateDark: {
+		background: color.RGBA{R: 0x1e, G: 0x1f, B: 0x22, A: 0xff},
+		border:     color.RGBA{R: 0x3a, G: 0x3c, B: 0x42, A: 0xff},
+		text:       color.RGBA{R: 0xf2, G: 0xf3, B: 0xf5, A: 0xff},
+		muted:      color.RGBA{R: 0x94, G: 0x9b, B: 0xa4, A: 0xff},
+		hardcore:   color.RGBA{R: 0xed, G: 0x42, B: 0x45, A: 0xff},
+	},
+	TemplateLight: {
+		background: color.RGBA{R: 0xfa, G: 0xfa, B: 0xfa, A: 0xff},
+		border:     color.RGBA{R: 0xcc, G: 0xcc, B: 0xcc, A: 0xff},
+		text:       color.RGBA{R: 0x20, G: 0x20, B: 0x20, A: 0xff},
+		muted:      color.RGBA{R: 0x70, G: 0x70, B: 0x70, A: 0xff},
+		hardcore:   color.RGBA{R: 0xb0, G: 0x00, B: 0x00, A: 0xff},
+	},
+}
+
+// dimensions of a card size, gear is the number of items listed.
+type dimensions struct {
+	width  int
+	height int
+	gear   int
+}
+
+var sizes = map[string]dimensions{
+	SizeSmall:  {width: 350, height: 50, gear: 0},
+	SizeMedium: {width: 468, height: 80, gear: 2},
+	SizeLarge:  {width: 600, height: 140, gear: 6},
+}
+
+// Item qualities shown as top gear, in order of preference.
+const (
+	qualitySet    = 5
+	qualityUnique = 7
+)
+
+// Layout of the text on the card.
+const (
+	padding    = 10
+	lineHeight = 16
+)
+
+// render renders the card of the character as a PNG.
+func render(c *domain.Character, template string, size string) ([]byte, error) {
+	t, ok := templates[template]
+	if !ok {
+		return nil, fmt.Errorf("unknown template %s: %w", template, domain.ErrRequest)
+	}
+
+	dim, ok := sizes[size]
+	if !ok {
+		return nil, fmt.Errorf("unknown size %s: %w", size, domain.ErrRequest)
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, dim.width, dim.height))
+	draw.Draw(img, img.Bounds(), &image.Uniform{C: t.border}, image.Point{}, draw.Src)
+	draw.Draw(img, image.Rect(1, 1, dim.width-1, dim.height-1), &image.Uniform{C: t.background}, image.Point{}, draw.Src)
+
+	h := c.D2s.Header
+	status := h.Status.Readable()
+
+	y := padding + lineHeight - 4
+	x := text(img, padding, y, h.Name.String(), t.text)
+
+	if status.Hardcore {
+		label := "HARDCORE"
+		if status.Died {
+			label = "HARDCORE - DEAD"
+		}
+		text(img, x+padding, y, label, t.hardcore)
+	}
+
+	mode := "Classic"
+	if status.Expansion {
+		mode = "Expansion"
+	}
+
+	y += lineHeight
+	text(img, padding, y, fmt.Sprintf("Level %d %s - %s", h.Level, h.Class.String(), mode), t.muted)
+
+	for _, name := range topGear(c.D2s.Items, h.ActiveArms, dim.gear) {
+		y += lineHeight
+		text(img, padding, y, name, t.text)
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// text draws the string at the baseline y and returns where it ends.
+func text(img draw.Image, x int, y int, s string, c color.Color) int {
+	d := font.Drawer{
+		Dst:  img,
+		Src:  &image.Uniform{C: c},
+		Face: basicfont.Face7x13,
+		Dot:  fixed.P(x, y),
+	}
+
+	d.DrawString(s)
+
+	return d.Dot.X.Ceil()
+}
+
+// topGear returns the names of the most notable equipped items, runewords
+// first, then uniques and sets.
+func topGear(items []d2s.Item, activeArms uint32, max int) []string {
+	if max == 0 {
+		return nil
+	}
+
+	var runewords, uniques, sets []string
+	for _, item := range gear.Equipped(items, activeArms) {
+		switch {
+		case item.RunewordName != "":
+			runewords = append(runewords, item.RunewordName)
+		case item.Quality == qualityUnique && item.UniqueName != "":
+			uniques = append(uniques, item.UniqueName)
+		case item.Quality == qualitySet && item.SetName != "":
+			sets = append(sets, item.SetName)
+		}
+	}
+
+	names := append(append(runewords, uniques...), sets...)
+	if len(names) > max {
+		names = names[:max]
+	}
+
+	return names
+}
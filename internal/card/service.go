@@ -0,0 +1,84 @@
+package card
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+)
+
+//go:generate moq -out ./service_mocks.go . characterService
+
+// characterService is the interface representation of the characters
+// the service render cards of.
+type characterService interface {
+	Parse(ctx context.Context, name string) (*domain.Character, error)
+}
+
+// maxCached is the number of rendered cards kept in memory.
+const maxCached = 1000
+
+// cached is a rendered card and the parse of the character it was rendered from.
+type cached struct {
+	lastParsed time.Time
+	image      []byte
+}
+
+// Service renders character cards.
+type Service struct {
+	characterService characterService
+
+	mu    sync.Mutex
+	cache map[string]cached
+}
+
+// Render will render the card of the character with the template and size, the
+// card is rendered again once the character has been parsed again.
+func (s *Service) Render(ctx context.Context, name string, template string, size string) ([]byte, time.Time, error) {
+	if template == "" {
+		template = TemplateClassic
+	}
+
+	if size == "" {
+		size = SizeMedium
+	}
+
+	character, err := s.characterService.Parse(ctx, name)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	key := domain.NormalizeName(character.ID) + "/" + template + "/" + size
+
+	s.mu.Lock()
+	c, ok := s.cache[key]
+	s.mu.Unlock()
+
+	if ok && c.lastParsed.Equal(character.LastParsed) {
+		return c.image, c.lastParsed, nil
+	}
+
+	image, err := render(character, template, size)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	s.mu.Lock()
+	// Start over rather than tracking usage, cards are cheap to render again.
+	if len(s.cache) >= maxCached {
+		s.cache = make(map[string]cached)
+	}
+	s.cache[key] = cached{lastParsed: character.LastParsed, image: image}
+	s.mu.Unlock()
+
+	return image, character.LastParsed, nil
+}
+
+// NewService constructs a new card service with all the dependencies.
+func NewService(characterService characterService) *Service {
+	return &Service{
+		characterService: characterService,
+		cache:            make(map[string]cached),
+	}
+}
@@ -0,0 +1,119 @@
+package card
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image/png"
+	"testing"
+	"time"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+	"github.com/nokka/d2s"
+)
+
+func TestRenderCard(t *testing.T) {
+	var header d2s.Header
+	copy(header.Name[:], "nokka")
+	header.Class = d2s.Sorceress
+	header.Level = 90
+	header.Status = 0x24
+
+	lastParsed := time.Date(2021, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	characterService := &characterServiceMock{
+		ParseFunc: func(ctx context.Context, name string) (*domain.Character, error) {
+			return &domain.Character{
+				ID:         name,
+				LastParsed: lastParsed,
+				D2s: &d2s.Character{
+					Header: header,
+					Items: []d2s.Item{
+						{LocationID: 1, EquippedID: 1, Quality: 7, UniqueName: "Harlequin Crest"},
+						{LocationID: 1, EquippedID: 3, RunewordName: "Enigma"},
+					},
+				},
+			}, nil
+		},
+	}
+
+	s := NewService(characterService)
+
+	tests := []struct {
+		name           string
+		template       string
+		size           string
+		expectedWidth  int
+		expectedHeight int
+		expectedError  error
+	}{
+		{name: "defaults", expectedWidth: 468, expectedHeight: 80},
+		{name: "large dark", template: TemplateDark, size: SizeLarge, expectedWidth: 600, expectedHeight: 140},
+		{name: "small light", template: TemplateLight, size: SizeSmall, expectedWidth: 350, expectedHeight: 50},
+		{name: "unknown template", template: "neon", expectedError: domain.ErrRequest},
+		{name: "unknown size", size: "huge", expectedError: domain.ErrRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, modified, err := s.Render(context.Background(), "nokka", tt.template, tt.size)
+			if !errors.Is(err, tt.expectedError) {
+				t.Fatalf("expected error %v, got %v", tt.expectedError, err)
+			}
+
+			if tt.expectedError != nil {
+				return
+			}
+
+			if !modified.Equal(lastParsed) {
+				t.Errorf("expected last modified %s, got %s", lastParsed, modified)
+			}
+
+			img, err := png.Decode(bytes.NewReader(data))
+			if err != nil {
+				t.Fatalf("invalid png: %v", err)
+			}
+
+			if img.Bounds().Dx() != tt.expectedWidth || img.Bounds().Dy() != tt.expectedHeight {
+				t.Errorf("expected %dx%d, got %dx%d", tt.expectedWidth, tt.expectedHeight, img.Bounds().Dx(), img.Bounds().Dy())
+			}
+		})
+	}
+}
+
+func TestRenderCardCache(t *testing.T) {
+	lastParsed := time.Date(2021, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	characterService := &characterServiceMock{
+		ParseFunc: func(ctx context.Context, name string) (*domain.Character, error) {
+			return &domain.Character{ID: name, LastParsed: lastParsed, D2s: &d2s.Character{}}, nil
+		},
+	}
+
+	s := NewService(characterService)
+
+	first, _, err := s.Render(context.Background(), "nokka", "", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	second, _, err := s.Render(context.Background(), "Nokka", "", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if &first[0] != &second[0] {
+		t.Error("expected the cached card to be served")
+	}
+
+	lastParsed = lastParsed.Add(time.Minute)
+
+	third, _, err := s.Render(context.Background(), "nokka", "", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if &first[0] == &third[0] {
+		t.Error("expected the card to be rendered again after the character was parsed")
+	}
+}
@@ -3,34 +3,67 @@ package character
 import (
 	"context"
 	"errors"
+	"fmt"
+	"log"
 	"regexp"
+	"sort"
 	"time"
 
+	"github.com/nokka/d2-armory-api/internal/charsave"
 	"github.com/nokka/d2-armory-api/internal/domain"
 )
 
-//go:generate moq -out ./service_mocks.go . parser characterRepository
+//go:generate moq -out ./service_mocks.go . parser index characterRepository Listener
 
 // parser is the interface representation of a d2 parser the service depend on.
 type parser interface {
 	Parse(name string) (*domain.Character, error)
 }
 
+// index is the interface representation of the character binaries on disk.
+type index interface {
+	Lookup(name string) (charsave.Entry, bool)
+	Entries() []charsave.Entry
+}
+
 // characterRepository is the interface representation of the data layer
 // the service depend on.
 type characterRepository interface {
 	Find(ctx context.Context, id string) (*domain.Character, error)
+	FindSummaries(ctx context.Context, ids []string) ([]domain.CharacterSummary, error)
+	FindIDs(ctx context.Context) ([]string, error)
+	FindDeletedBefore(ctx context.Context, before time.Time) ([]string, error)
 	Update(ctx context.Context, character *domain.Character) error
 	Store(ctx context.Context, character *domain.Character) error
+	MarkDeleted(ctx context.Context, id string, at time.Time) error
+	Purge(ctx context.Context, id string) error
+	TakeDown(ctx context.Context, id string, at time.Time) error
+	IsTakenDown(ctx context.Context, id string) (bool, error)
+	FindTakenDown(ctx context.Context) ([]string, error)
+}
+
+// Listener is notified whenever a parsed character has been persisted,
+// previous is the character as it was cached before, nil if it wasn't.
+// Listeners are best effort, errors are logged and never fail the parse.
+type Listener interface {
+	CharacterPersisted(ctx context.Context, previous *domain.Character, current *domain.Character) error
 }
 
 // Service performs all operations on parsing characters.
 type Service struct {
 	parser        parser
+	index         index
 	characters    characterRepository
 	cacheDuration time.Duration
+	listeners     []Listener
 }
 
+// Page size limits when listing characters.
+const (
+	defaultListLimit = 20
+	maxListLimit     = 100
+)
+
 // The name regexp required for character names, to enforce strict diablo rules
 // on the names to prevent missuse of the endpoint.
 const nameRegexp = "^[a-zA-Z]+[_-]?[a-zA-Z]+$"
@@ -42,20 +75,38 @@ func (s Service) Parse(ctx context.Context, name string) (*domain.Character, err
 		return nil, domain.ErrInvalidArgument
 	}
 
+	// Names are case insensitive, so Nokka and nokka share the same cache entry.
+	name = domain.NormalizeName(name)
+
 	// Read character from db cache.
 	c, err := s.characters.Find(ctx, name)
 	if err != nil {
 		if errors.Is(err, domain.ErrNotFound) {
+			// A character that was taken down is never parsed from its binary again.
+			down, err := s.characters.IsTakenDown(ctx, name)
+			if err != nil {
+				return nil, err
+			}
+
+			if down {
+				return nil, fmt.Errorf("character %s was taken down: %w", name, domain.ErrGone)
+			}
+
 			// Character didn't exist at all, so lets parse and store it.
 			parsed, err := s.parser.Parse(name)
 			if err != nil {
 				return nil, err
 			}
 
+			now := time.Now()
+			parsed.FirstSeen = &now
+
 			if err := s.characters.Store(ctx, parsed); err != nil {
 				return nil, err
 			}
 
+			s.notify(ctx, nil, parsed)
+
 			return parsed, nil
 		}
 
@@ -67,29 +118,266 @@ func (s Service) Parse(ctx context.Context, name string) (*domain.Character, err
 	diff := time.Since(c.LastParsed)
 
 	if diff >= s.cacheDuration {
-		parsed, err := s.parser.Parse(name)
-		if err != nil {
-			return nil, err
+		return s.reparse(ctx, c)
+	}
+
+	if c.DeletedAt != nil {
+		return nil, fmt.Errorf("character %s was deleted: %w", name, domain.ErrGone)
+	}
+
+	// We parsed this character less than 3 minutes ago so return the db version.
+	return c, nil
+}
+
+// Reparse will parse the character from its binary no matter how recently it was
+// parsed, used when the binary is known to have been saved since.
+func (s Service) Reparse(ctx context.Context, name string) (*domain.Character, error) {
+	name = domain.NormalizeName(name)
+
+	c, err := s.characters.Find(ctx, name)
+	if err != nil {
+		// Never parsed before, parse and store it like any other character.
+		if errors.Is(err, domain.ErrNotFound) {
+			return s.Parse(ctx, name)
+		}
+
+		return nil, err
+	}
+
+	return s.reparse(ctx, c)
+}
+
+// reparse parses the cached character again and updates the record in the db.
+func (s Service) reparse(ctx context.Context, c *domain.Character) (*domain.Character, error) {
+	parsed, err := s.parser.Parse(c.ID)
+	if err != nil {
+		// The binary is gone but we've seen it before, so it was deleted in game.
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, s.markDeleted(ctx, c)
+		}
+
+		return nil, err
+	}
+
+	// Update the existing record in the db, the binary is parsed anew so it
+	// doesn't know when the character was first seen. A binary showing up again
+	// after the character was deleted is a new character using the same name.
+	parsed.FirstSeen = c.FirstSeen
+	if c.DeletedAt != nil {
+		now := time.Now()
+		parsed.FirstSeen = &now
+	}
+
+	err = s.characters.Update(ctx, parsed)
+	if err != nil {
+		return nil, err
+	}
+
+	s.notify(ctx, c, parsed)
+
+	return parsed, nil
+}
+
+// notify lets the listeners know the character has been persisted. The character
+// is persisted no matter what, so a failing listener is logged rather than failing
+// the parse, and the listeners after it are still notified.
+func (s Service) notify(ctx context.Context, previous *domain.Character, current *domain.Character) {
+	for _, l := range s.listeners {
+		if err := l.CharacterPersisted(ctx, previous, current); err != nil {
+			log.Printf("failed to notify listener of character %s: %s", current.ID, err)
 		}
+	}
+}
+
+// Delete will take the character down, removing it and all of its statistics
+// and keeping it from being parsed again while its binary is still on disk.
+func (s Service) Delete(ctx context.Context, name string) error {
+	match, _ := regexp.MatchString(nameRegexp, name)
+	if !match {
+		return domain.ErrInvalidArgument
+	}
+
+	return s.characters.TakeDown(ctx, domain.NormalizeName(name), time.Now())
+}
 
-		// Update the existing record in the db.
-		err = s.characters.Update(ctx, parsed)
+// SweepDeleted will mark all stored characters without a binary on disk as deleted,
+// and purge the characters that have been deleted for longer than the grace period.
+func (s Service) SweepDeleted(ctx context.Context, grace time.Duration) (marked int, purged int, err error) {
+	// An empty directory most likely means it isn't mounted, rather than every
+	// character being deleted, so don't mark anything in that case.
+	if len(s.index.Entries()) > 0 {
+		ids, err := s.characters.FindIDs(ctx)
 		if err != nil {
-			return nil, err
+			return marked, purged, err
 		}
 
-		return parsed, nil
+		for _, id := range ids {
+			if _, ok := s.index.Lookup(id); ok {
+				continue
+			}
+
+			if err := s.characters.MarkDeleted(ctx, id, time.Now()); err != nil {
+				return marked, purged, err
+			}
+
+			marked++
+		}
 	}
 
-	// We parsed this character less than 3 minutes ago so return the db version.
-	return c, nil
+	ids, err := s.characters.FindDeletedBefore(ctx, time.Now().Add(-grace))
+	if err != nil {
+		return marked, purged, err
+	}
+
+	for _, id := range ids {
+		if err := s.characters.Purge(ctx, id); err != nil {
+			// Purged by someone else in the meantime.
+			if errors.Is(err, domain.ErrNotFound) {
+				continue
+			}
+
+			return marked, purged, err
+		}
+
+		purged++
+	}
+
+	return marked, purged, nil
+}
+
+// markDeleted marks the character as deleted the first time it's found missing.
+func (s Service) markDeleted(ctx context.Context, c *domain.Character) error {
+	if c.DeletedAt == nil {
+		if err := s.characters.MarkDeleted(ctx, c.ID, time.Now()); err != nil {
+			return err
+		}
+	}
+
+	return fmt.Errorf("character %s was deleted: %w", c.ID, domain.ErrGone)
+}
+
+// List will list the characters available on disk, merged with the metadata
+// of the characters that have been parsed before.
+func (s Service) List(ctx context.Context, opts domain.ListOptions) (*domain.CharacterList, error) {
+	if opts.Offset < 0 {
+		return nil, fmt.Errorf("offset can't be negative: %w", domain.ErrRequest)
+	}
+
+	if opts.Limit == 0 {
+		opts.Limit = defaultListLimit
+	}
+
+	if opts.Limit < 0 || opts.Limit > maxListLimit {
+		return nil, fmt.Errorf("limit must be between 1 and %d: %w", maxListLimit, domain.ErrRequest)
+	}
+
+	if opts.Sort == "" {
+		opts.Sort = domain.SortLastSaved
+	}
+
+	// Characters taken down are left out, even though their binaries are still on disk.
+	takenDown, err := s.characters.FindTakenDown(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	exclude := make(map[string]struct{}, len(opts.Exclude)+len(takenDown))
+	for name := range opts.Exclude {
+		exclude[name] = struct{}{}
+	}
+
+	for _, name := range takenDown {
+		exclude[name] = struct{}{}
+	}
+
+	// Entries are returned sorted by name.
+	entries := s.index.Entries()
+
+	if len(exclude) > 0 {
+		included := entries[:0]
+		for _, e := range entries {
+			if _, ok := exclude[e.Name]; !ok {
+				included = append(included, e)
+			}
+		}
+
+		entries = included
+	}
+
+	switch opts.Sort {
+	case domain.SortLastSaved:
+		sort.SliceStable(entries, func(i, j int) bool {
+			if opts.Descending {
+				return entries[i].ModTime.After(entries[j].ModTime)
+			}
+			return entries[i].ModTime.Before(entries[j].ModTime)
+		})
+	case domain.SortName:
+		if opts.Descending {
+			sort.SliceStable(entries, func(i, j int) bool {
+				return entries[i].Name > entries[j].Name
+			})
+		}
+	default:
+		return nil, fmt.Errorf("unknown sort %s: %w", opts.Sort, domain.ErrRequest)
+	}
+
+	list := &domain.CharacterList{
+		Total:      len(entries),
+		Offset:     opts.Offset,
+		Limit:      opts.Limit,
+		Characters: make([]domain.CharacterSummary, 0, opts.Limit),
+	}
+
+	if opts.Offset >= len(entries) {
+		return list, nil
+	}
+
+	end := opts.Offset + opts.Limit
+	if end > len(entries) {
+		end = len(entries)
+	}
+
+	page := entries[opts.Offset:end]
+
+	// Only look up the metadata for the characters on the requested page.
+	ids := make([]string, 0, len(page))
+	for _, e := range page {
+		ids = append(ids, e.Name)
+	}
+
+	cached, err := s.characters.FindSummaries(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	byName := make(map[string]domain.CharacterSummary, len(cached))
+	for _, c := range cached {
+		byName[c.Name] = c
+	}
+
+	for _, e := range page {
+		summary, ok := byName[e.Name]
+		if !ok {
+			// Never parsed, all we know is what's on disk.
+			summary = domain.CharacterSummary{Name: e.Name}
+		}
+
+		summary.LastSaved = e.ModTime
+		list.Characters = append(list.Characters, summary)
+	}
+
+	return list, nil
 }
 
-// NewService constructs a new parsing service with all the dependencies.
-func NewService(parser parser, characterRepository characterRepository, cacheDuration time.Duration) *Service {
+// NewService constructs a new parsing service with all the dependencies,
+// the listeners are notified of every character persisted.
+func NewService(parser parser, index index, characterRepository characterRepository, cacheDuration time.Duration, listeners ...Listener) *Service {
 	return &Service{
 		parser:        parser,
+		index:         index,
 		characters:    characterRepository,
 		cacheDuration: cacheDuration,
+		listeners:     listeners,
 	}
 }
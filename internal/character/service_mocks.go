@@ -5,8 +5,10 @@ package character
 
 import (
 	"context"
+	"github.com/nokka/d2-armory-api/internal/charsave"
 	"github.com/nokka/d2-armory-api/internal/domain"
 	"sync"
+	"time"
 )
 
 // Ensure, that parserMock does implement parser.
@@ -74,6 +76,107 @@ func (mock *parserMock) ParseCalls() []struct {
 	return calls
 }
 
+// Ensure, that indexMock does implement index.
+// If this is not the case, regenerate this file with moq.
+var _ index = &indexMock{}
+
+// indexMock is a mock implementation of index.
+//
+// 	func TestSomethingThatUsesindex(t *testing.T) {
+//
+// 		// make and configure a mocked index
+// 		mockedindex := &indexMock{
+// 			EntriesFunc: func() []charsave.Entry {
+// 				panic("mock out the Entries method")
+// 			},
+// 			LookupFunc: func(name string) (charsave.Entry, bool) {
+// 				panic("mock out the Lookup method")
+// 			},
+// 		}
+//
+// 		// use mockedindex in code that requires index
+// 		// and then make assertions.
+//
+// 	}
+type indexMock struct {
+	// EntriesFunc mocks the Entries method.
+	EntriesFunc func() []charsave.Entry
+
+	// LookupFunc mocks the Lookup method.
+	LookupFunc func(name string) (charsave.Entry, bool)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Entries holds details about calls to the Entries method.
+		Entries []struct {
+		}
+		// Lookup holds details about calls to the Lookup method.
+		Lookup []struct {
+			// Name is the name argument value.
+			Name string
+		}
+	}
+	lockEntries sync.RWMutex
+	lockLookup  sync.RWMutex
+}
+
+// Entries calls EntriesFunc.
+func (mock *indexMock) Entries() []charsave.Entry {
+	if mock.EntriesFunc == nil {
+		panic("indexMock.EntriesFunc: method is nil but index.Entries was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockEntries.Lock()
+	mock.calls.Entries = append(mock.calls.Entries, callInfo)
+	mock.lockEntries.Unlock()
+	return mock.EntriesFunc()
+}
+
+// EntriesCalls gets all the calls that were made to Entries.
+// Check the length with:
+//     len(mockedindex.EntriesCalls())
+func (mock *indexMock) EntriesCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockEntries.RLock()
+	calls = mock.calls.Entries
+	mock.lockEntries.RUnlock()
+	return calls
+}
+
+// Lookup calls LookupFunc.
+func (mock *indexMock) Lookup(name string) (charsave.Entry, bool) {
+	if mock.LookupFunc == nil {
+		panic("indexMock.LookupFunc: method is nil but index.Lookup was just called")
+	}
+	callInfo := struct {
+		Name string
+	}{
+		Name: name,
+	}
+	mock.lockLookup.Lock()
+	mock.calls.Lookup = append(mock.calls.Lookup, callInfo)
+	mock.lockLookup.Unlock()
+	return mock.LookupFunc(name)
+}
+
+// LookupCalls gets all the calls that were made to Lookup.
+// Check the length with:
+//     len(mockedindex.LookupCalls())
+func (mock *indexMock) LookupCalls() []struct {
+	Name string
+} {
+	var calls []struct {
+		Name string
+	}
+	mock.lockLookup.RLock()
+	calls = mock.calls.Lookup
+	mock.lockLookup.RUnlock()
+	return calls
+}
+
 // Ensure, that characterRepositoryMock does implement characterRepository.
 // If this is not the case, regenerate this file with moq.
 var _ characterRepository = &characterRepositoryMock{}
@@ -87,9 +190,33 @@ var _ characterRepository = &characterRepositoryMock{}
 // 			FindFunc: func(ctx context.Context, id string) (*domain.Character, error) {
 // 				panic("mock out the Find method")
 // 			},
+// 			FindDeletedBeforeFunc: func(ctx context.Context, before time.Time) ([]string, error) {
+// 				panic("mock out the FindDeletedBefore method")
+// 			},
+// 			FindIDsFunc: func(ctx context.Context) ([]string, error) {
+// 				panic("mock out the FindIDs method")
+// 			},
+// 			FindSummariesFunc: func(ctx context.Context, ids []string) ([]domain.CharacterSummary, error) {
+// 				panic("mock out the FindSummaries method")
+// 			},
+// 			FindTakenDownFunc: func(ctx context.Context) ([]string, error) {
+// 				panic("mock out the FindTakenDown method")
+// 			},
+// 			IsTakenDownFunc: func(ctx context.Context, id string) (bool, error) {
+// 				panic("mock out the IsTakenDown method")
+// 			},
+// 			MarkDeletedFunc: func(ctx context.Context, id string, at time.Time) error {
+// 				panic("mock out the MarkDeleted method")
+// 			},
+// 			PurgeFunc: func(ctx context.Context, id string) error {
+// 				panic("mock out the Purge method")
+// 			},
 // 			StoreFunc: func(ctx context.Context, character *domain.Character) error {
 // 				panic("mock out the Store method")
 // 			},
+// 			TakeDownFunc: func(ctx context.Context, id string, at time.Time) error {
+// 				panic("mock out the TakeDown method")
+// 			},
 // 			UpdateFunc: func(ctx context.Context, character *domain.Character) error {
 // 				panic("mock out the Update method")
 // 			},
@@ -103,9 +230,33 @@ type characterRepositoryMock struct {
 	// FindFunc mocks the Find method.
 	FindFunc func(ctx context.Context, id string) (*domain.Character, error)
 
+	// FindDeletedBeforeFunc mocks the FindDeletedBefore method.
+	FindDeletedBeforeFunc func(ctx context.Context, before time.Time) ([]string, error)
+
+	// FindIDsFunc mocks the FindIDs method.
+	FindIDsFunc func(ctx context.Context) ([]string, error)
+
+	// FindSummariesFunc mocks the FindSummaries method.
+	FindSummariesFunc func(ctx context.Context, ids []string) ([]domain.CharacterSummary, error)
+
+	// FindTakenDownFunc mocks the FindTakenDown method.
+	FindTakenDownFunc func(ctx context.Context) ([]string, error)
+
+	// IsTakenDownFunc mocks the IsTakenDown method.
+	IsTakenDownFunc func(ctx context.Context, id string) (bool, error)
+
+	// MarkDeletedFunc mocks the MarkDeleted method.
+	MarkDeletedFunc func(ctx context.Context, id string, at time.Time) error
+
+	// PurgeFunc mocks the Purge method.
+	PurgeFunc func(ctx context.Context, id string) error
+
 	// StoreFunc mocks the Store method.
 	StoreFunc func(ctx context.Context, character *domain.Character) error
 
+	// TakeDownFunc mocks the TakeDown method.
+	TakeDownFunc func(ctx context.Context, id string, at time.Time) error
+
 	// UpdateFunc mocks the Update method.
 	UpdateFunc func(ctx context.Context, character *domain.Character) error
 
@@ -118,6 +269,53 @@ type characterRepositoryMock struct {
 			// ID is the id argument value.
 			ID string
 		}
+		// FindDeletedBefore holds details about calls to the FindDeletedBefore method.
+		FindDeletedBefore []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Before is the before argument value.
+			Before time.Time
+		}
+		// FindIDs holds details about calls to the FindIDs method.
+		FindIDs []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// FindSummaries holds details about calls to the FindSummaries method.
+		FindSummaries []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Ids is the ids argument value.
+			Ids []string
+		}
+		// FindTakenDown holds details about calls to the FindTakenDown method.
+		FindTakenDown []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// IsTakenDown holds details about calls to the IsTakenDown method.
+		IsTakenDown []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// MarkDeleted holds details about calls to the MarkDeleted method.
+		MarkDeleted []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+			// At is the at argument value.
+			At time.Time
+		}
+		// Purge holds details about calls to the Purge method.
+		Purge []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
 		// Store holds details about calls to the Store method.
 		Store []struct {
 			// Ctx is the ctx argument value.
@@ -125,6 +323,15 @@ type characterRepositoryMock struct {
 			// Character is the character argument value.
 			Character *domain.Character
 		}
+		// TakeDown holds details about calls to the TakeDown method.
+		TakeDown []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+			// At is the at argument value.
+			At time.Time
+		}
 		// Update holds details about calls to the Update method.
 		Update []struct {
 			// Ctx is the ctx argument value.
@@ -133,9 +340,17 @@ type characterRepositoryMock struct {
 			Character *domain.Character
 		}
 	}
-	lockFind   sync.RWMutex
-	lockStore  sync.RWMutex
-	lockUpdate sync.RWMutex
+	lockFind              sync.RWMutex
+	lockFindDeletedBefore sync.RWMutex
+	lockFindIDs           sync.RWMutex
+	lockFindSummaries     sync.RWMutex
+	lockFindTakenDown     sync.RWMutex
+	lockIsTakenDown       sync.RWMutex
+	lockMarkDeleted       sync.RWMutex
+	lockPurge             sync.RWMutex
+	lockStore             sync.RWMutex
+	lockTakeDown          sync.RWMutex
+	lockUpdate            sync.RWMutex
 }
 
 // Find calls FindFunc.
@@ -173,6 +388,247 @@ func (mock *characterRepositoryMock) FindCalls() []struct {
 	return calls
 }
 
+// FindDeletedBefore calls FindDeletedBeforeFunc.
+func (mock *characterRepositoryMock) FindDeletedBefore(ctx context.Context, before time.Time) ([]string, error) {
+	if mock.FindDeletedBeforeFunc == nil {
+		panic("characterRepositoryMock.FindDeletedBeforeFunc: method is nil but characterRepository.FindDeletedBefore was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Before time.Time
+	}{
+		Ctx:    ctx,
+		Before: before,
+	}
+	mock.lockFindDeletedBefore.Lock()
+	mock.calls.FindDeletedBefore = append(mock.calls.FindDeletedBefore, callInfo)
+	mock.lockFindDeletedBefore.Unlock()
+	return mock.FindDeletedBeforeFunc(ctx, before)
+}
+
+// FindDeletedBeforeCalls gets all the calls that were made to FindDeletedBefore.
+// Check the length with:
+//     len(mockedcharacterRepository.FindDeletedBeforeCalls())
+func (mock *characterRepositoryMock) FindDeletedBeforeCalls() []struct {
+	Ctx    context.Context
+	Before time.Time
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Before time.Time
+	}
+	mock.lockFindDeletedBefore.RLock()
+	calls = mock.calls.FindDeletedBefore
+	mock.lockFindDeletedBefore.RUnlock()
+	return calls
+}
+
+// FindIDs calls FindIDsFunc.
+func (mock *characterRepositoryMock) FindIDs(ctx context.Context) ([]string, error) {
+	if mock.FindIDsFunc == nil {
+		panic("characterRepositoryMock.FindIDsFunc: method is nil but characterRepository.FindIDs was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockFindIDs.Lock()
+	mock.calls.FindIDs = append(mock.calls.FindIDs, callInfo)
+	mock.lockFindIDs.Unlock()
+	return mock.FindIDsFunc(ctx)
+}
+
+// FindIDsCalls gets all the calls that were made to FindIDs.
+// Check the length with:
+//     len(mockedcharacterRepository.FindIDsCalls())
+func (mock *characterRepositoryMock) FindIDsCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockFindIDs.RLock()
+	calls = mock.calls.FindIDs
+	mock.lockFindIDs.RUnlock()
+	return calls
+}
+
+// FindSummaries calls FindSummariesFunc.
+func (mock *characterRepositoryMock) FindSummaries(ctx context.Context, ids []string) ([]domain.CharacterSummary, error) {
+	if mock.FindSummariesFunc == nil {
+		panic("characterRepositoryMock.FindSummariesFunc: method is nil but characterRepository.FindSummaries was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Ids []string
+	}{
+		Ctx: ctx,
+		Ids: ids,
+	}
+	mock.lockFindSummaries.Lock()
+	mock.calls.FindSummaries = append(mock.calls.FindSummaries, callInfo)
+	mock.lockFindSummaries.Unlock()
+	return mock.FindSummariesFunc(ctx, ids)
+}
+
+// FindSummariesCalls gets all the calls that were made to FindSummaries.
+// Check the length with:
+//     len(mockedcharacterRepository.FindSummariesCalls())
+func (mock *characterRepositoryMock) FindSummariesCalls() []struct {
+	Ctx context.Context
+	Ids []string
+} {
+	var calls []struct {
+		Ctx context.Context
+		Ids []string
+	}
+	mock.lockFindSummaries.RLock()
+	calls = mock.calls.FindSummaries
+	mock.lockFindSummaries.RUnlock()
+	return calls
+}
+
+// FindTakenDown calls FindTakenDownFunc.
+func (mock *characterRepositoryMock) FindTakenDown(ctx context.Context) ([]string, error) {
+	if mock.FindTakenDownFunc == nil {
+		panic("characterRepositoryMock.FindTakenDownFunc: method is nil but characterRepository.FindTakenDown was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockFindTakenDown.Lock()
+	mock.calls.FindTakenDown = append(mock.calls.FindTakenDown, callInfo)
+	mock.lockFindTakenDown.Unlock()
+	return mock.FindTakenDownFunc(ctx)
+}
+
+// FindTakenDownCalls gets all the calls that were made to FindTakenDown.
+// Check the length with:
+//     len(mockedcharacterRepository.FindTakenDownCalls())
+func (mock *characterRepositoryMock) FindTakenDownCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockFindTakenDown.RLock()
+	calls = mock.calls.FindTakenDown
+	mock.lockFindTakenDown.RUnlock()
+	return calls
+}
+
+// IsTakenDown calls IsTakenDownFunc.
+func (mock *characterRepositoryMock) IsTakenDown(ctx context.Context, id string) (bool, error) {
+	if mock.IsTakenDownFunc == nil {
+		panic("characterRepositoryMock.IsTakenDownFunc: method is nil but characterRepository.IsTakenDown was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockIsTakenDown.Lock()
+	mock.calls.IsTakenDown = append(mock.calls.IsTakenDown, callInfo)
+	mock.lockIsTakenDown.Unlock()
+	return mock.IsTakenDownFunc(ctx, id)
+}
+
+// IsTakenDownCalls gets all the calls that were made to IsTakenDown.
+// Check the length with:
+//     len(mockedcharacterRepository.IsTakenDownCalls())
+func (mock *characterRepositoryMock) IsTakenDownCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockIsTakenDown.RLock()
+	calls = mock.calls.IsTakenDown
+	mock.lockIsTakenDown.RUnlock()
+	return calls
+}
+
+// MarkDeleted calls MarkDeletedFunc.
+func (mock *characterRepositoryMock) MarkDeleted(ctx context.Context, id string, at time.Time) error {
+	if mock.MarkDeletedFunc == nil {
+		panic("characterRepositoryMock.MarkDeletedFunc: method is nil but characterRepository.MarkDeleted was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+		At  time.Time
+	}{
+		Ctx: ctx,
+		ID:  id,
+		At:  at,
+	}
+	mock.lockMarkDeleted.Lock()
+	mock.calls.MarkDeleted = append(mock.calls.MarkDeleted, callInfo)
+	mock.lockMarkDeleted.Unlock()
+	return mock.MarkDeletedFunc(ctx, id, at)
+}
+
+// MarkDeletedCalls gets all the calls that were made to MarkDeleted.
+// Check the length with:
+//     len(mockedcharacterRepository.MarkDeletedCalls())
+func (mock *characterRepositoryMock) MarkDeletedCalls() []struct {
+	Ctx context.Context
+	ID  string
+	At  time.Time
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+		At  time.Time
+	}
+	mock.lockMarkDeleted.RLock()
+	calls = mock.calls.MarkDeleted
+	mock.lockMarkDeleted.RUnlock()
+	return calls
+}
+
+// Purge calls PurgeFunc.
+func (mock *characterRepositoryMock) Purge(ctx context.Context, id string) error {
+	if mock.PurgeFunc == nil {
+		panic("characterRepositoryMock.PurgeFunc: method is nil but characterRepository.Purge was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockPurge.Lock()
+	mock.calls.Purge = append(mock.calls.Purge, callInfo)
+	mock.lockPurge.Unlock()
+	return mock.PurgeFunc(ctx, id)
+}
+
+// PurgeCalls gets all the calls that were made to Purge.
+// Check the length with:
+//     len(mockedcharacterRepository.PurgeCalls())
+func (mock *characterRepositoryMock) PurgeCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockPurge.RLock()
+	calls = mock.calls.Purge
+	mock.lockPurge.RUnlock()
+	return calls
+}
+
 // Store calls StoreFunc.
 func (mock *characterRepositoryMock) Store(ctx context.Context, character *domain.Character) error {
 	if mock.StoreFunc == nil {
@@ -208,6 +664,45 @@ func (mock *characterRepositoryMock) StoreCalls() []struct {
 	return calls
 }
 
+// TakeDown calls TakeDownFunc.
+func (mock *characterRepositoryMock) TakeDown(ctx context.Context, id string, at time.Time) error {
+	if mock.TakeDownFunc == nil {
+		panic("characterRepositoryMock.TakeDownFunc: method is nil but characterRepository.TakeDown was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+		At  time.Time
+	}{
+		Ctx: ctx,
+		ID:  id,
+		At:  at,
+	}
+	mock.lockTakeDown.Lock()
+	mock.calls.TakeDown = append(mock.calls.TakeDown, callInfo)
+	mock.lockTakeDown.Unlock()
+	return mock.TakeDownFunc(ctx, id, at)
+}
+
+// TakeDownCalls gets all the calls that were made to TakeDown.
+// Check the length with:
+//     len(mockedcharacterRepository.TakeDownCalls())
+func (mock *characterRepositoryMock) TakeDownCalls() []struct {
+	Ctx context.Context
+	ID  string
+	At  time.Time
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+		At  time.Time
+	}
+	mock.lockTakeDown.RLock()
+	calls = mock.calls.TakeDown
+	mock.lockTakeDown.RUnlock()
+	return calls
+}
+
 // Update calls UpdateFunc.
 func (mock *characterRepositoryMock) Update(ctx context.Context, character *domain.Character) error {
 	if mock.UpdateFunc == nil {
@@ -242,3 +737,80 @@ func (mock *characterRepositoryMock) UpdateCalls() []struct {
 	mock.lockUpdate.RUnlock()
 	return calls
 }
+
+// Ensure, that ListenerMock does implement Listener.
+// If this is not the case, regenerate this file with moq.
+var _ Listener = &ListenerMock{}
+
+// ListenerMock is a mock implementation of Listener.
+//
+// 	func TestSomethingThatUsesListener(t *testing.T) {
+//
+// 		// make and configure a mocked Listener
+// 		mockedListener := &ListenerMock{
+// 			CharacterPersistedFunc: func(ctx context.Context, previous *domain.Character, current *domain.Character) error {
+// 				panic("mock out the CharacterPersisted method")
+// 			},
+// 		}
+//
+// 		// use mockedListener in code that requires Listener
+// 		// and then make assertions.
+//
+// 	}
+type ListenerMock struct {
+	// CharacterPersistedFunc mocks the CharacterPersisted method.
+	CharacterPersistedFunc func(ctx context.Context, previous *domain.Character, current *domain.Character) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CharacterPersisted holds details about calls to the CharacterPersisted method.
+		CharacterPersisted []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Previous is the previous argument value.
+			Previous *domain.Character
+			// Current is the current argument value.
+			Current *domain.Character
+		}
+	}
+	lockCharacterPersisted sync.RWMutex
+}
+
+// CharacterPersisted calls CharacterPersistedFunc.
+func (mock *ListenerMock) CharacterPersisted(ctx context.Context, previous *domain.Character, current *domain.Character) error {
+	if mock.CharacterPersistedFunc == nil {
+		panic("ListenerMock.CharacterPersistedFunc: method is nil but Listener.CharacterPersisted was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Previous *domain.Character
+		Current  *domain.Character
+	}{
+		Ctx:      ctx,
+		Previous: previous,
+		Current:  current,
+	}
+	mock.lockCharacterPersisted.Lock()
+	mock.calls.CharacterPersisted = append(mock.calls.CharacterPersisted, callInfo)
+	mock.lockCharacterPersisted.Unlock()
+	return mock.CharacterPersistedFunc(ctx, previous, current)
+}
+
+// CharacterPersistedCalls gets all the calls that were made to CharacterPersisted.
+// Check the length with:
+//     len(mockedListener.CharacterPersistedCalls())
+func (mock *ListenerMock) CharacterPersistedCalls() []struct {
+	Ctx      context.Context
+	Previous *domain.Character
+	Current  *domain.Character
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Previous *domain.Character
+		Current  *domain.Character
+	}
+	mock.lockCharacterPersisted.RLock()
+	calls = mock.calls.CharacterPersisted
+	mock.lockCharacterPersisted.RUnlock()
+	return calls
+}
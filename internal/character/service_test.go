@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/nokka/d2-armory-api/internal/charsave"
 	"github.com/nokka/d2-armory-api/internal/domain"
 )
 
@@ -50,6 +51,9 @@ func TestParseCharacter(t *testing.T) {
 					StoreFunc: func(ctx context.Context, character *domain.Character) error {
 						return nil
 					},
+					IsTakenDownFunc: func(ctx context.Context, id string) (bool, error) {
+						return false, nil
+					},
 				},
 				parser: &parserMock{
 					ParseFunc: func(name string) (*domain.Character, error) {
@@ -63,6 +67,31 @@ func TestParseCharacter(t *testing.T) {
 				updateCalls: 0,
 			},
 		},
+		{
+			name: "taken down",
+			args: args{
+				name:          "nokka",
+				ctx:           context.TODO(),
+				cacheDuration: 1 * time.Minute,
+			},
+			fields: fields{
+				characterRepository: &characterRepositoryMock{
+					FindFunc: func(ctx context.Context, id string) (*domain.Character, error) {
+						return nil, domain.ErrNotFound
+					},
+					IsTakenDownFunc: func(ctx context.Context, id string) (bool, error) {
+						return true, nil
+					},
+				},
+				parser: &parserMock{},
+			},
+			calls: calls{
+				storeCalls:  0,
+				parseCalls:  0,
+				updateCalls: 0,
+			},
+			expectedError: domain.ErrGone,
+		},
 		{
 			name: "update successful",
 			args: args{
@@ -124,7 +153,7 @@ func TestParseCharacter(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			s := NewService(tt.fields.parser, tt.fields.characterRepository, tt.args.cacheDuration)
+			s := NewService(tt.fields.parser, &indexMock{}, tt.fields.characterRepository, tt.args.cacheDuration)
 
 			_, err := s.Parse(tt.args.ctx, tt.args.name)
 
@@ -159,3 +188,522 @@ func TestParseCharacter(t *testing.T) {
 		})
 	}
 }
+
+func TestParseCharacterCaseInsensitive(t *testing.T) {
+	characterRepository := &characterRepositoryMock{
+		FindFunc: func(ctx context.Context, id string) (*domain.Character, error) {
+			return &domain.Character{ID: id, LastParsed: time.Now()}, nil
+		},
+	}
+
+	s := NewService(&parserMock{}, &indexMock{}, characterRepository, 1*time.Minute)
+
+	for _, name := range []string{"Nokka", "NOKKA", "nokka"} {
+		if _, err := s.Parse(context.TODO(), name); err != nil {
+			t.Fatalf("didn't expect an error, got = %v", err)
+		}
+	}
+
+	for _, call := range characterRepository.FindCalls() {
+		if call.ID != "nokka" {
+			t.Errorf("expected characterRepository.Find() to be called with nokka, got = %s", call.ID)
+		}
+	}
+}
+
+func TestParseCharacterNotifiesListeners(t *testing.T) {
+	cached := &domain.Character{ID: "nokka", LastParsed: time.Now().Add(-time.Hour)}
+	parsed := &domain.Character{ID: "nokka", LastParsed: time.Now()}
+
+	tests := []struct {
+		name             string
+		find             func(ctx context.Context, id string) (*domain.Character, error)
+		expectedPrevious *domain.Character
+	}{
+		{
+			name: "stored",
+			find: func(ctx context.Context, id string) (*domain.Character, error) {
+				return nil, domain.ErrNotFound
+			},
+		},
+		{
+			name: "updated",
+			find: func(ctx context.Context, id string) (*domain.Character, error) {
+				return cached, nil
+			},
+			expectedPrevious: cached,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			characterRepository := &characterRepositoryMock{
+				FindFunc: tt.find,
+				StoreFunc: func(ctx context.Context, character *domain.Character) error {
+					return nil
+				},
+				IsTakenDownFunc: func(ctx context.Context, id string) (bool, error) {
+					return false, nil
+				},
+				UpdateFunc: func(ctx context.Context, character *domain.Character) error {
+					return nil
+				},
+			}
+
+			parser := &parserMock{
+				ParseFunc: func(name string) (*domain.Character, error) {
+					return parsed, nil
+				},
+			}
+
+			listener := &ListenerMock{
+				CharacterPersistedFunc: func(ctx context.Context, previous *domain.Character, current *domain.Character) error {
+					return nil
+				},
+			}
+
+			s := NewService(parser, &indexMock{}, characterRepository, time.Minute, listener)
+			if _, err := s.Parse(context.TODO(), "nokka"); err != nil {
+				t.Fatalf("didn't expect an error, got = %v", err)
+			}
+
+			calls := listener.CharacterPersistedCalls()
+			if len(calls) != 1 {
+				t.Fatalf("expected listener to be called once, got = %d", len(calls))
+			}
+
+			if calls[0].Previous != tt.expectedPrevious || calls[0].Current != parsed {
+				t.Errorf("unexpected listener call %+v", calls[0])
+			}
+		})
+	}
+}
+
+func TestParseCharacterFirstSeen(t *testing.T) {
+	seen := time.Now().Add(-24 * time.Hour)
+	deletedAt := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name   string
+		cached *domain.Character
+		// check reports whether the first sighting of the persisted character is as expected.
+		check func(firstSeen *time.Time) bool
+	}{
+		{
+			name:  "new character",
+			check: func(firstSeen *time.Time) bool { return firstSeen != nil && firstSeen.After(seen) },
+		},
+		{
+			name:   "known character",
+			cached: &domain.Character{ID: "nokka", FirstSeen: &seen},
+			check:  func(firstSeen *time.Time) bool { return firstSeen != nil && firstSeen.Equal(seen) },
+		},
+		{
+			name:   "character stored before first sightings were kept",
+			cached: &domain.Character{ID: "nokka"},
+			check:  func(firstSeen *time.Time) bool { return firstSeen == nil },
+		},
+		{
+			name:   "new character using the name of a deleted one",
+			cached: &domain.Character{ID: "nokka", FirstSeen: &seen, DeletedAt: &deletedAt},
+			check:  func(firstSeen *time.Time) bool { return firstSeen != nil && firstSeen.After(deletedAt) },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var persisted *domain.Character
+
+			characterRepository := &characterRepositoryMock{
+				FindFunc: func(ctx context.Context, id string) (*domain.Character, error) {
+					if tt.cached == nil {
+						return nil, domain.ErrNotFound
+					}
+					return tt.cached, nil
+				},
+				IsTakenDownFunc: func(ctx context.Context, id string) (bool, error) {
+					return false, nil
+				},
+				StoreFunc: func(ctx context.Context, character *domain.Character) error {
+					persisted = character
+					return nil
+				},
+				UpdateFunc: func(ctx context.Context, character *domain.Character) error {
+					persisted = character
+					return nil
+				},
+			}
+
+			parser := &parserMock{
+				ParseFunc: func(name string) (*domain.Character, error) {
+					return &domain.Character{ID: name, LastParsed: time.Now()}, nil
+				},
+			}
+
+			s := NewService(parser, &indexMock{}, characterRepository, 0)
+			if _, err := s.Parse(context.TODO(), "nokka"); err != nil {
+				t.Fatalf("didn't expect an error, got = %v", err)
+			}
+
+			if persisted == nil || !tt.check(persisted.FirstSeen) {
+				t.Errorf("unexpected first sighting of the persisted character = %+v", persisted)
+			}
+		})
+	}
+}
+
+func TestParseCharacterListenerFails(t *testing.T) {
+	characterRepository := &characterRepositoryMock{
+		FindFunc: func(ctx context.Context, id string) (*domain.Character, error) {
+			return nil, domain.ErrNotFound
+		},
+		IsTakenDownFunc: func(ctx context.Context, id string) (bool, error) {
+			return false, nil
+		},
+		StoreFunc: func(ctx context.Context, character *domain.Character) error {
+			return nil
+		},
+	}
+
+	parser := &parserMock{
+		ParseFunc: func(name string) (*domain.Character, error) {
+			return &domain.Character{ID: name}, nil
+		},
+	}
+
+	failing := &ListenerMock{
+		CharacterPersistedFunc: func(ctx context.Context, previous *domain.Character, current *domain.Character) error {
+			return fmt.Errorf("webhook queue: %w", domain.ErrTemporary)
+		},
+	}
+
+	next := &ListenerMock{
+		CharacterPersistedFunc: func(ctx context.Context, previous *domain.Character, current *domain.Character) error {
+			return nil
+		},
+	}
+
+	s := NewService(parser, &indexMock{}, characterRepository, time.Minute, failing, next)
+
+	if _, err := s.Parse(context.TODO(), "nokka"); err != nil {
+		t.Fatalf("didn't expect an error, got = %v", err)
+	}
+
+	if len(next.CharacterPersistedCalls()) != 1 {
+		t.Errorf("expected the listener after the failing one to be notified")
+	}
+}
+
+func TestListCharacters(t *testing.T) {
+	now := time.Now()
+
+	index := &indexMock{
+		EntriesFunc: func() []charsave.Entry {
+			return []charsave.Entry{
+				{Name: "amazon", ModTime: now.Add(-3 * time.Hour)},
+				{Name: "nokka", ModTime: now.Add(-1 * time.Hour)},
+				{Name: "sorc", ModTime: now.Add(-2 * time.Hour)},
+				{Name: "takendown", ModTime: now},
+			}
+		},
+	}
+
+	characterRepository := &characterRepositoryMock{
+		FindSummariesFunc: func(ctx context.Context, ids []string) ([]domain.CharacterSummary, error) {
+			return []domain.CharacterSummary{
+				{Name: "nokka", Class: "Sorceress", Level: 90, LastParsed: &now},
+			}, nil
+		},
+		FindTakenDownFunc: func(ctx context.Context) ([]string, error) {
+			return []string{"takendown"}, nil
+		},
+	}
+
+	tests := []struct {
+		name          string
+		opts          domain.ListOptions
+		expected      []string
+		expectedError error
+	}{
+		{
+			name:     "recently saved first",
+			opts:     domain.ListOptions{Descending: true},
+			expected: []string{"nokka", "sorc", "amazon"},
+		},
+		{
+			name:     "by name",
+			opts:     domain.ListOptions{Sort: domain.SortName},
+			expected: []string{"amazon", "nokka", "sorc"},
+		},
+		{
+			name:     "paginated",
+			opts:     domain.ListOptions{Sort: domain.SortName, Offset: 1, Limit: 1},
+			expected: []string{"nokka"},
+		},
+		{
+			name:     "offset out of range",
+			opts:     domain.ListOptions{Offset: 10},
+			expected: []string{},
+		},
+		{
+			name:          "limit too large",
+			opts:          domain.ListOptions{Limit: maxListLimit + 1},
+			expectedError: domain.ErrRequest,
+		},
+		{
+			name:          "unknown sort",
+			opts:          domain.ListOptions{Sort: "class"},
+			expectedError: domain.ErrRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewService(&parserMock{}, index, characterRepository, 1*time.Minute)
+
+			list, err := s.List(context.TODO(), tt.opts)
+			if !errors.Is(err, tt.expectedError) {
+				t.Fatalf("expected error to be = %v, got = %v", tt.expectedError, err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if list.Total != 3 {
+				t.Errorf("expected total to be = 3, got = %d", list.Total)
+			}
+
+			names := make([]string, 0, len(list.Characters))
+			for _, c := range list.Characters {
+				names = append(names, c.Name)
+
+				if c.Name == "nokka" && c.Level != 90 {
+					t.Errorf("expected cached metadata to be merged, got level = %d", c.Level)
+				}
+
+				if c.LastSaved.IsZero() {
+					t.Errorf("expected last saved to be set for %s", c.Name)
+				}
+			}
+
+			if fmt.Sprint(names) != fmt.Sprint(tt.expected) {
+				t.Errorf("expected characters to be = %v, got = %v", tt.expected, names)
+			}
+		})
+	}
+}
+
+func TestParseDeletedCharacter(t *testing.T) {
+	deletedAt := time.Now()
+
+	tests := []struct {
+		name             string
+		cached           *domain.Character
+		parseErr         error
+		parseCalls       int
+		markDeletedCalls int
+	}{
+		{
+			name:             "binary disappeared",
+			cached:           &domain.Character{ID: "nokka"},
+			parseErr:         fmt.Errorf("character binary does not exist: %w", domain.ErrNotFound),
+			parseCalls:       1,
+			markDeletedCalls: 1,
+		},
+		{
+			name:             "already marked as deleted",
+			cached:           &domain.Character{ID: "nokka", DeletedAt: &deletedAt},
+			parseErr:         fmt.Errorf("character binary does not exist: %w", domain.ErrNotFound),
+			parseCalls:       1,
+			markDeletedCalls: 0,
+		},
+		{
+			name:             "deleted within cache duration",
+			cached:           &domain.Character{ID: "nokka", LastParsed: time.Now(), DeletedAt: &deletedAt},
+			parseCalls:       0,
+			markDeletedCalls: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			characterRepository := &characterRepositoryMock{
+				FindFunc: func(ctx context.Context, id string) (*domain.Character, error) {
+					return tt.cached, nil
+				},
+				MarkDeletedFunc: func(ctx context.Context, id string, at time.Time) error {
+					return nil
+				},
+			}
+
+			parser := &parserMock{
+				ParseFunc: func(name string) (*domain.Character, error) {
+					return nil, tt.parseErr
+				},
+			}
+
+			s := NewService(parser, &indexMock{}, characterRepository, 1*time.Minute)
+
+			_, err := s.Parse(context.TODO(), "nokka")
+			if !errors.Is(err, domain.ErrGone) {
+				t.Errorf("expected error to be = %v, got = %v", domain.ErrGone, err)
+			}
+
+			if len(parser.ParseCalls()) != tt.parseCalls {
+				t.Errorf("expected parser.Parse() to be called exactly %d times but was called %d times",
+					tt.parseCalls,
+					len(parser.ParseCalls()),
+				)
+			}
+
+			if len(characterRepository.MarkDeletedCalls()) != tt.markDeletedCalls {
+				t.Errorf("expected characterRepository.MarkDeleted() to be called exactly %d times but was called %d times",
+					tt.markDeletedCalls,
+					len(characterRepository.MarkDeletedCalls()),
+				)
+			}
+		})
+	}
+}
+
+func TestSweepDeleted(t *testing.T) {
+	tests := []struct {
+		name     string
+		entries  []charsave.Entry
+		purgeErr error
+		marked   int
+		purged   int
+	}{
+		{
+			name:    "marks missing binaries and purges expired",
+			entries: []charsave.Entry{{Name: "nokka"}},
+			marked:  1,
+			purged:  1,
+		},
+		{
+			name:    "empty directory doesn't mark anything",
+			entries: []charsave.Entry{},
+			marked:  0,
+			purged:  1,
+		},
+		{
+			name:     "already purged isn't counted",
+			entries:  []charsave.Entry{{Name: "nokka"}},
+			purgeErr: fmt.Errorf("nothing to purge for expired: %w", domain.ErrNotFound),
+			marked:   1,
+			purged:   0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			index := &indexMock{
+				EntriesFunc: func() []charsave.Entry {
+					return tt.entries
+				},
+				LookupFunc: func(name string) (charsave.Entry, bool) {
+					for _, e := range tt.entries {
+						if e.Name == name {
+							return e, true
+						}
+					}
+					return charsave.Entry{}, false
+				},
+			}
+
+			characterRepository := &characterRepositoryMock{
+				FindIDsFunc: func(ctx context.Context) ([]string, error) {
+					return []string{"nokka", "deleted"}, nil
+				},
+				MarkDeletedFunc: func(ctx context.Context, id string, at time.Time) error {
+					return nil
+				},
+				FindDeletedBeforeFunc: func(ctx context.Context, before time.Time) ([]string, error) {
+					return []string{"expired"}, nil
+				},
+				PurgeFunc: func(ctx context.Context, id string) error {
+					return tt.purgeErr
+				},
+			}
+
+			s := NewService(&parserMock{}, index, characterRepository, 1*time.Minute)
+
+			marked, purged, err := s.SweepDeleted(context.TODO(), 24*time.Hour)
+			if err != nil {
+				t.Fatalf("didn't expect an error, got = %v", err)
+			}
+
+			if marked != tt.marked || purged != tt.purged {
+				t.Errorf("expected marked/purged to be = %d/%d, got = %d/%d", tt.marked, tt.purged, marked, purged)
+			}
+
+			for _, call := range characterRepository.PurgeCalls() {
+				if call.ID != "expired" {
+					t.Errorf("expected only expired to be purged, got = %s", call.ID)
+				}
+			}
+		})
+	}
+}
+
+func TestDeleteCharacter(t *testing.T) {
+	characterRepository := &characterRepositoryMock{
+		TakeDownFunc: func(ctx context.Context, id string, at time.Time) error {
+			return nil
+		},
+	}
+
+	s := NewService(&parserMock{}, &indexMock{}, characterRepository, 1*time.Minute)
+
+	if err := s.Delete(context.TODO(), "n0kka"); !errors.Is(err, domain.ErrInvalidArgument) {
+		t.Errorf("expected error to be = %v, got = %v", domain.ErrInvalidArgument, err)
+	}
+
+	if err := s.Delete(context.TODO(), "Nokka"); err != nil {
+		t.Fatalf("didn't expect an error, got = %v", err)
+	}
+
+	calls := characterRepository.TakeDownCalls()
+	if len(calls) != 1 || calls[0].ID != "nokka" {
+		t.Errorf("expected nokka to be taken down once, got = %+v", calls)
+	}
+}
+
+func TestListCharactersExclude(t *testing.T) {
+	index := &indexMock{
+		EntriesFunc: func() []charsave.Entry {
+			return []charsave.Entry{{Name: "hidden"}, {Name: "nokka"}, {Name: "takendown"}}
+		},
+	}
+
+	characterRepository := &characterRepositoryMock{
+		FindSummariesFunc: func(ctx context.Context, ids []string) ([]domain.CharacterSummary, error) {
+			return nil, nil
+		},
+		FindTakenDownFunc: func(ctx context.Context) ([]string, error) {
+			return []string{"takendown"}, nil
+		},
+	}
+
+	s := NewService(&parserMock{}, index, characterRepository, 1*time.Minute)
+
+	exclude := map[string]struct{}{"hidden": {}}
+
+	list, err := s.List(context.TODO(), domain.ListOptions{
+		Sort:    domain.SortName,
+		Exclude: exclude,
+	})
+	if err != nil {
+		t.Fatalf("didn't expect an error, got = %v", err)
+	}
+
+	if list.Total != 1 || list.Characters[0].Name != "nokka" {
+		t.Errorf("expected only nokka to be listed, got = %v", list.Characters)
+	}
+
+	// The excluded characters of the caller are left untouched.
+	if len(exclude) != 1 {
+		t.Errorf("expected the excluded characters to be left untouched, got = %v", exclude)
+	}
+}
@@ -0,0 +1,206 @@
+package charsave
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+)
+
+// extension is the optional file extension of a character save file, PvPGN stores
+// the binaries without it, while single player saves have it.
+const extension = ".d2s"
+
+// Entry describes a single character binary on disk.
+type Entry struct {
+	// Name is the normalized character name, used as the key everywhere.
+	Name string
+
+	// Filename is the actual name of the file within the directory.
+	Filename string
+
+	// ModTime is the last time the binary was written, i.e. last saved in game.
+	ModTime time.Time
+
+	// Size is the size of the binary in bytes.
+	Size int64
+}
+
+// Index maps normalized character names to the actual files in a directory,
+// making lookups case insensitive regardless of how the file was named on disk.
+type Index struct {
+	path string
+
+	mu      sync.RWMutex
+	entries map[string]Entry
+	dirMod  time.Time
+}
+
+// Path returns the absolute path of the entry on disk.
+func (i *Index) Path(e Entry) string {
+	return filepath.Join(i.path, e.Filename)
+}
+
+// Lookup will find the entry for the given name, if the name isn't indexed
+// and the directory changed since the last scan, the index is refreshed first.
+func (i *Index) Lookup(name string) (Entry, bool) {
+	name = domain.NormalizeName(name)
+
+	i.mu.RLock()
+	e, ok := i.entries[name]
+	i.mu.RUnlock()
+
+	if ok {
+		return e, true
+	}
+
+	// A character might have been created since the last refresh.
+	if i.changed() {
+		if err := i.Refresh(); err != nil {
+			return Entry{}, false
+		}
+
+		i.mu.RLock()
+		e, ok = i.entries[name]
+		i.mu.RUnlock()
+	}
+
+	return e, ok
+}
+
+// Stat will find the entry for the given name with the modification time and size
+// of the binary as it is on disk right now, rather than as of the last scan.
+func (i *Index) Stat(name string) (Entry, bool) {
+	e, ok := i.Lookup(name)
+	if !ok {
+		return Entry{}, false
+	}
+
+	info, err := os.Stat(i.Path(e))
+	if err != nil {
+		return Entry{}, false
+	}
+
+	e.ModTime = info.ModTime()
+	e.Size = info.Size()
+
+	return e, true
+}
+
+// Entries returns all indexed entries sorted by name.
+func (i *Index) Entries() []Entry {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	entries := make([]Entry, 0, len(i.entries))
+	for _, e := range i.entries {
+		entries = append(entries, e)
+	}
+
+	sort.Slice(entries, func(a, b int) bool {
+		return entries[a].Name < entries[b].Name
+	})
+
+	return entries
+}
+
+// Refresh will scan the directory and replace the index with its contents.
+func (i *Index) Refresh() error {
+	dir, err := os.Stat(i.path)
+	if err != nil {
+		return err
+	}
+
+	files, err := ioutil.ReadDir(i.path)
+	if err != nil {
+		return err
+	}
+
+	entries := make(map[string]Entry, len(files))
+
+	for _, f := range files {
+		if f.IsDir() || strings.HasPrefix(f.Name(), ".") {
+			continue
+		}
+
+		base := f.Name()
+		ext := filepath.Ext(base)
+
+		// Only accept extension less files or .d2s files, the directory
+		// might hold other files such as backups or single player keys.
+		switch {
+		case ext == "":
+		case strings.EqualFold(ext, extension):
+			base = strings.TrimSuffix(base, ext)
+		default:
+			continue
+		}
+
+		e := Entry{
+			Name:     domain.NormalizeName(base),
+			Filename: f.Name(),
+			ModTime:  f.ModTime(),
+			Size:     f.Size(),
+		}
+
+		// Two files may normalize to the same name, e.g. Nokka and nokka,
+		// in that case the most recently saved one wins.
+		if existing, ok := entries[e.Name]; ok && existing.ModTime.After(e.ModTime) {
+			continue
+		}
+
+		entries[e.Name] = e
+	}
+
+	i.mu.Lock()
+	i.entries = entries
+	i.dirMod = dir.ModTime()
+	i.mu.Unlock()
+
+	return nil
+}
+
+// Watch will refresh the index every interval until the context is done.
+func (i *Index) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// Errors are transient, such as the directory being remounted,
+			// keep serving the last known index until the next tick.
+			_ = i.Refresh()
+		}
+	}
+}
+
+// changed reports if files have been added or removed since the last scan.
+func (i *Index) changed() bool {
+	dir, err := os.Stat(i.path)
+	if err != nil {
+		return false
+	}
+
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	return !dir.ModTime().Equal(i.dirMod)
+}
+
+// NewIndex returns a new index over the given directory, the index is empty
+// until Refresh is called.
+func NewIndex(path string) *Index {
+	return &Index{
+		path:    path,
+		entries: make(map[string]Entry),
+	}
+}
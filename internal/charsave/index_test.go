@@ -0,0 +1,131 @@
+package charsave
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestIndexLookup(t *testing.T) {
+	dir, err := ioutil.TempDir("", "charsave")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	for _, name := range []string{"Nokka", "sorc.d2s", "backup.bak", ".hidden"} {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte{}, 0600); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	index := NewIndex(dir)
+	if err := index.Refresh(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		filename string
+		found    bool
+	}{
+		{name: "nokka", filename: "Nokka", found: true},
+		{name: "NOKKA", filename: "Nokka", found: true},
+		{name: "Sorc", filename: "sorc.d2s", found: true},
+		{name: "backup", found: false},
+		{name: "hidden", found: false},
+		{name: "unknown", found: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, ok := index.Lookup(tt.name)
+			if ok != tt.found {
+				t.Fatalf("index.Lookup(%q) found = %v, want %v", tt.name, ok, tt.found)
+			}
+
+			if e.Filename != tt.filename {
+				t.Fatalf("index.Lookup(%q) filename = %q, want %q", tt.name, e.Filename, tt.filename)
+			}
+		})
+	}
+
+	if got, want := len(index.Entries()), 2; got != want {
+		t.Fatalf("len(index.Entries()) = %d, want %d", got, want)
+	}
+}
+
+func TestIndexRefreshOnMiss(t *testing.T) {
+	dir, err := ioutil.TempDir("", "charsave")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	index := NewIndex(dir)
+	if err := index.Refresh(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, ok := index.Lookup("nokka"); ok {
+		t.Fatal("did not expect nokka to be indexed")
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(dir, "Nokka"), []byte{}, 0600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Make sure the directory modification time differs from the last scan,
+	// regardless of the file system timestamp resolution.
+	future := time.Now().Add(time.Minute)
+	if err := os.Chtimes(dir, future, future); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, ok := index.Lookup("nokka"); !ok {
+		t.Fatal("expected nokka to be indexed after the directory changed")
+	}
+}
+
+func TestIndexStat(t *testing.T) {
+	dir, err := ioutil.TempDir("", "charsave")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "Nokka")
+	if err := ioutil.WriteFile(path, []byte{}, 0600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	index := NewIndex(dir)
+	if err := index.Refresh(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Saving in game rewrites the file without touching the directory.
+	saved := time.Now().Add(time.Hour).Truncate(time.Second)
+	if err := os.Chtimes(path, saved, saved); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if e, _ := index.Lookup("nokka"); e.ModTime.Equal(saved) {
+		t.Fatal("expected the lookup to return the time of the last scan")
+	}
+
+	e, ok := index.Stat("nokka")
+	if !ok {
+		t.Fatal("index.Stat(\"nokka\") found = false, want true")
+	}
+
+	if !e.ModTime.Equal(saved) {
+		t.Errorf("e.ModTime = %v, want %v", e.ModTime, saved)
+	}
+
+	if _, ok := index.Stat("unknown"); ok {
+		t.Error("index.Stat(\"unknown\") found = true, want false")
+	}
+}
@@ -0,0 +1,288 @@
+package compare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+	"github.com/nokka/d2-armory-api/internal/gear"
+	"github.com/nokka/d2-armory-api/internal/skill"
+	"github.com/nokka/d2s"
+)
+
+//go:generate moq -out ./service_mocks.go . characterService statisticsService
+
+// characterService is the interface representation of the characters
+// the service compare.
+type characterService interface {
+	Parse(ctx context.Context, name string) (*domain.Character, error)
+}
+
+// statisticsService is the interface representation of the statistics
+// the service compare.
+type statisticsService interface {
+	GetCharacter(ctx context.Context, character string) (*domain.CharacterStatistics, error)
+}
+
+// derived are the stats derived from the equipped items and charms.
+var derived = []struct {
+	stat string
+	id   uint64
+}{
+	{"fire_resist", gear.AttrFireResist},
+	{"cold_resist", gear.AttrColdResist},
+	{"lightning_resist", gear.AttrLightningResist},
+	{"poison_resist", gear.AttrPoisonResist},
+	{"all_skills", gear.AttrAllSkills},
+	{"faster_cast_rate", gear.AttrFasterCastRate},
+	{"faster_hit_recovery", gear.AttrFasterHitRecovery},
+	{"faster_run_walk", gear.AttrFasterRunWalk},
+	{"faster_block_rate", gear.AttrFasterBlockRate},
+	{"increased_attack_speed", gear.AttrIncreasedAttackSpeed},
+	{"magic_find", gear.AttrMagicFind},
+	{"gold_find", gear.AttrGoldFind},
+	{"life_steal", gear.AttrLifeSteal},
+	{"mana_steal", gear.AttrManaSteal},
+	{"crushing_blow", gear.AttrCrushingBlow},
+	{"deadly_strike", gear.AttrDeadlyStrike},
+}
+
+// slots are the equipped slots in the order they're compared.
+var slots = []uint64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+
+// Service compares characters.
+type Service struct {
+	characterService  characterService
+	statisticsService statisticsService
+}
+
+// Compare will compare the two characters.
+func (s Service) Compare(ctx context.Context, a string, b string) (*domain.Comparison, error) {
+	if a == "" || b == "" {
+		return nil, fmt.Errorf("two characters are required: %w", domain.ErrRequest)
+	}
+
+	charA, err := s.characterService.Parse(ctx, a)
+	if err != nil {
+		return nil, err
+	}
+
+	charB, err := s.characterService.Parse(ctx, b)
+	if err != nil {
+		return nil, err
+	}
+
+	statsA, err := s.statistics(ctx, a)
+	if err != nil {
+		return nil, err
+	}
+
+	statsB, err := s.statistics(ctx, b)
+	if err != nil {
+		return nil, err
+	}
+
+	return Of(charA.D2s, charB.D2s, statsA, statsB), nil
+}
+
+// statistics returns the statistics of the character, nil when none have been submitted.
+func (s Service) statistics(ctx context.Context, character string) (*domain.CharacterStatistics, error) {
+	stats, err := s.statisticsService.GetCharacter(ctx, character)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, nil
+	}
+
+	return stats, err
+}
+
+// Of compares the two characters and their statistics, which are optional.
+func Of(a *d2s.Character, b *d2s.Character, statsA *domain.CharacterStatistics, statsB *domain.CharacterStatistics) *domain.Comparison {
+	return &domain.Comparison{
+		A:          compared(a, statsA),
+		B:          compared(b, statsB),
+		Attributes: attributes(a.Attributes, b.Attributes),
+		Derived:    derivedStats(a, b),
+		Skills:     skills(a, b),
+		Items:      items(a, b),
+		Statistics: statistics(statsA, statsB),
+	}
+}
+
+func compared(c *d2s.Character, stats *domain.CharacterStatistics) domain.ComparedCharacter {
+	return domain.ComparedCharacter{
+		Name:       c.Header.Name.String(),
+		Class:      c.Header.Class.String(),
+		Level:      int(c.Header.Level),
+		Statistics: stats != nil,
+	}
+}
+
+func diff(stat string, a int64, b int64) domain.StatDiff {
+	return domain.StatDiff{Stat: stat, A: a, B: b, Difference: b - a}
+}
+
+func attributes(a d2s.Attributes, b d2s.Attributes) []domain.StatDiff {
+	return []domain.StatDiff{
+		diff("level", int64(a.Level), int64(b.Level)),
+		diff("experience", int64(a.Experience), int64(b.Experience)),
+		diff("strength", int64(a.Strength), int64(b.Strength)),
+		diff("dexterity", int64(a.Dexterity), int64(b.Dexterity)),
+		diff("vitality", int64(a.Vitality), int64(b.Vitality)),
+		diff("energy", int64(a.Energy), int64(b.Energy)),
+		diff("unused_stats", int64(a.UnusedStats), int64(b.UnusedStats)),
+		diff("unused_skill_points", int64(a.UnusedSkillPoints), int64(b.UnusedSkillPoints)),
+		diff("life", int64(a.MaxHP), int64(b.MaxHP)),
+		diff("mana", int64(a.MaxMana), int64(b.MaxMana)),
+		diff("stamina", int64(a.MaxStamina), int64(b.MaxStamina)),
+		diff("gold", int64(a.Gold), int64(b.Gold)),
+		diff("stashed_gold", int64(a.StashedGold), int64(b.StashedGold)),
+	}
+}
+
+func derivedStats(a *d2s.Character, b *d2s.Character) []domain.StatDiff {
+	attrsA := gear.AllAttributes(gear.Active(a.Items, a.Header.ActiveArms))
+	attrsB := gear.AllAttributes(gear.Active(b.Items, b.Header.ActiveArms))
+
+	stats := make([]domain.StatDiff, 0, len(derived))
+	for _, d := range derived {
+		stats = append(stats, diff(d.stat, gear.Sum(attrsA, d.id), gear.Sum(attrsB, d.id)))
+	}
+
+	return stats
+}
+
+// skills compares the skills either character has a level in, in order of skill ID.
+func skills(a *d2s.Character, b *d2s.Character) []domain.SkillDiff {
+	bySkill := make(map[int]*domain.SkillDiff)
+
+	add := func(tree *domain.SkillTree, side func(*domain.SkillDiff) *domain.SkillPoints) {
+		levels := append([]domain.SkillLevel{}, tree.OSkills...)
+		for _, tab := range tree.Tabs {
+			levels = append(levels, tab.Skills...)
+		}
+
+		for _, l := range levels {
+			if l.Level == 0 {
+				continue
+			}
+
+			d, ok := bySkill[l.ID]
+			if !ok {
+				d = &domain.SkillDiff{ID: l.ID, Name: l.Name}
+				bySkill[l.ID] = d
+			}
+
+			*side(d) = domain.SkillPoints{BasePoints: l.BasePoints, Level: l.Level}
+		}
+	}
+
+	add(skill.Allocation(a), func(d *domain.SkillDiff) *domain.SkillPoints { return &d.A })
+	add(skill.Allocation(b), func(d *domain.SkillDiff) *domain.SkillPoints { return &d.B })
+
+	diffs := make([]domain.SkillDiff, 0, len(bySkill))
+	for _, d := range bySkill {
+		d.Difference = d.B.Level - d.A.Level
+		diffs = append(diffs, *d)
+	}
+
+	sort.Slice(diffs, func(i, j int) bool {
+		return diffs[i].ID < diffs[j].ID
+	})
+
+	return diffs
+}
+
+// items compares the items equipped in each slot, the hands hold the weapons of the active weapon set.
+func items(a *d2s.Character, b *d2s.Character) []domain.SlotDiff {
+	equippedA := bySlot(a.Items, a.Header.ActiveArms)
+	equippedB := bySlot(b.Items, b.Header.ActiveArms)
+
+	diffs := make([]domain.SlotDiff, 0, len(slots))
+	for _, slot := range slots {
+		itemA, itemB := equippedA[slot], equippedB[slot]
+
+		var location string
+		if itemA != nil {
+			location = gear.Location(*itemA)
+		} else if itemB != nil {
+			location = gear.Location(*itemB)
+		} else {
+			continue
+		}
+
+		diffs = append(diffs, domain.SlotDiff{
+			Slot: location,
+			A:    comparedItem(itemA),
+			B:    comparedItem(itemB),
+			Same: itemA != nil && itemB != nil && itemA.Type == itemB.Type && gear.ItemName(*itemA) == gear.ItemName(*itemB),
+		})
+	}
+
+	return diffs
+}
+
+func bySlot(items []d2s.Item, activeArms uint32) map[uint64]*d2s.Item {
+	equipped := gear.Equipped(items, activeArms)
+
+	slots := make(map[uint64]*d2s.Item, len(equipped))
+	for i := range equipped {
+		slots[equipped[i].EquippedID] = &equipped[i]
+	}
+
+	return slots
+}
+
+func comparedItem(item *d2s.Item) *domain.ComparedItem {
+	if item == nil {
+		return nil
+	}
+
+	return &domain.ComparedItem{
+		Name:    gear.ItemName(*item),
+		Base:    item.TypeName,
+		Code:    item.Type,
+		Quality: gear.QualityName(item.Quality),
+	}
+}
+
+// statistics compares the kills of each difficulty, characters without
+// statistics count as having none.
+func statistics(a *domain.CharacterStatistics, b *domain.CharacterStatistics) []domain.StatDiff {
+	if a == nil {
+		a = &domain.CharacterStatistics{}
+	}
+
+	if b == nil {
+		b = &domain.CharacterStatistics{}
+	}
+
+	difficulties := []struct {
+		name string
+		a, b domain.Stats
+	}{
+		{"normal", a.Normal, b.Normal},
+		{"nightmare", a.Nightmare, b.Nightmare},
+		{"hell", a.Hell, b.Hell},
+	}
+
+	stats := make([]domain.StatDiff, 0, 3*len(difficulties))
+	for _, d := range difficulties {
+		stats = append(stats,
+			diff(d.name+".total_kills", int64(d.a.TotalKills), int64(d.b.TotalKills)),
+			diff(d.name+".total_unique_kills", int64(d.a.TotalUniqueKills), int64(d.b.TotalUniqueKills)),
+			diff(d.name+".total_champ_kills", int64(d.a.TotalChampKills), int64(d.b.TotalChampKills)),
+		)
+	}
+
+	return stats
+}
+
+// NewService constructs a new compare service with all the dependencies.
+func NewService(characterService characterService, statisticsService statisticsService) *Service {
+	return &Service{
+		characterService:  characterService,
+		statisticsService: statisticsService,
+	}
+}
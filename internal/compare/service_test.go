@@ -0,0 +1,149 @@
+package compare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+	"github.com/nokka/d2-armory-api/internal/gear/geartest"
+	"github.com/nokka/d2s"
+)
+
+func TestCompare(t *testing.T) {
+	var header d2s.Header
+	header.Class = d2s.Sorceress
+	header.Level = 90
+
+	before := &d2s.Character{
+		Header:     header,
+		Attributes: d2s.Attributes{Strength: 100, Level: 90},
+		Skills:     []d2s.Skill{{ID: 54, Points: 1}, {ID: 59, Points: 20}},
+		Items: []d2s.Item{
+			geartest.Item(t, `{"location_id": 1, "equipped_id": 1, "type": "uap", "type_name": "Shako", "quality": 7, "unique_name": "Harlequin Crest",
+				"magic_attributes": [{"id": 127, "values": [2]}]}`),
+			geartest.Item(t, `{"location_id": 1, "equipped_id": 3, "type": "uui", "type_name": "Dusk Shroud", "runeword_name": "Enigma"}`),
+		},
+	}
+
+	after := &d2s.Character{
+		Header:     header,
+		Attributes: d2s.Attributes{Strength: 156, Level: 91},
+		Skills:     []d2s.Skill{{ID: 54, Points: 1}, {ID: 59, Points: 20}, {ID: 64, Points: 1}},
+		Items: []d2s.Item{
+			geartest.Item(t, `{"location_id": 1, "equipped_id": 1, "type": "ci3", "type_name": "Diadem", "quality": 7, "unique_name": "Griffon's Eye",
+				"magic_attributes": [{"id": 127, "values": [1]}, {"id": 105, "values": [25]}]}`),
+			geartest.Item(t, `{"location_id": 1, "equipped_id": 3, "type": "uui", "type_name": "Dusk Shroud", "runeword_name": "Enigma"}`),
+			geartest.Item(t, `{"location_id": 1, "equipped_id": 2, "type": "amu", "type_name": "Amulet", "quality": 7, "unique_name": "Mara's Kaleidoscope"}`),
+		},
+	}
+
+	characters := map[string]*d2s.Character{"before": before, "after": after}
+
+	characterService := &characterServiceMock{
+		ParseFunc: func(ctx context.Context, name string) (*domain.Character, error) {
+			c, ok := characters[name]
+			if !ok {
+				return nil, fmt.Errorf("character %s: %w", name, domain.ErrNotFound)
+			}
+			return &domain.Character{ID: name, D2s: c}, nil
+		},
+	}
+
+	statisticsService := &statisticsServiceMock{
+		GetCharacterFunc: func(ctx context.Context, character string) (*domain.CharacterStatistics, error) {
+			if character == "after" {
+				return nil, fmt.Errorf("statistics: %w", domain.ErrNotFound)
+			}
+			return &domain.CharacterStatistics{Hell: domain.Stats{TotalKills: 1000}}, nil
+		},
+	}
+
+	s := NewService(characterService, statisticsService)
+
+	t.Run("comparison", func(t *testing.T) {
+		c, err := s.Compare(context.Background(), "before", "after")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !c.A.Statistics || c.B.Statistics {
+			t.Errorf("expected statistics only for a, got %v and %v", c.A.Statistics, c.B.Statistics)
+		}
+
+		expectStat(t, c.Attributes, domain.StatDiff{Stat: "strength", A: 100, B: 156, Difference: 56})
+		expectStat(t, c.Attributes, domain.StatDiff{Stat: "level", A: 90, B: 91, Difference: 1})
+		expectStat(t, c.Derived, domain.StatDiff{Stat: "all_skills", A: 2, B: 1, Difference: -1})
+		expectStat(t, c.Derived, domain.StatDiff{Stat: "faster_cast_rate", A: 0, B: 25, Difference: 25})
+		expectStat(t, c.Statistics, domain.StatDiff{Stat: "hell.total_kills", A: 1000, B: 0, Difference: -1000})
+
+		skills := make(map[int]domain.SkillDiff)
+		for _, s := range c.Skills {
+			skills[s.ID] = s
+		}
+
+		if d := skills[59]; d.A.Level != 22 || d.B.Level != 21 || d.Difference != -1 {
+			t.Errorf("unexpected blizzard %+v", d)
+		}
+
+		if d := skills[64]; d.A.Level != 0 || d.B.Level != 2 || d.B.BasePoints != 1 {
+			t.Errorf("unexpected frozen orb %+v", d)
+		}
+
+		slots := make(map[string]domain.SlotDiff)
+		for _, s := range c.Items {
+			slots[s.Slot] = s
+		}
+
+		if len(slots) != 3 {
+			t.Fatalf("expected 3 slots, got %d", len(slots))
+		}
+
+		if d := slots["head"]; d.Same || d.A.Name != "Harlequin Crest" || d.B.Name != "Griffon's Eye" {
+			t.Errorf("unexpected head %+v", d)
+		}
+
+		if d := slots["torso"]; !d.Same {
+			t.Errorf("expected the same torso %+v", d)
+		}
+
+		if d := slots["neck"]; d.A != nil || d.B.Name != "Mara's Kaleidoscope" {
+			t.Errorf("unexpected neck %+v", d)
+		}
+	})
+
+	tests := []struct {
+		name          string
+		a             string
+		b             string
+		expectedError error
+	}{
+		{name: "missing character", a: "before", expectedError: domain.ErrRequest},
+		{name: "unknown character", a: "before", b: "unknown", expectedError: domain.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.Compare(context.Background(), tt.a, tt.b)
+			if !errors.Is(err, tt.expectedError) {
+				t.Errorf("expected error %v, got %v", tt.expectedError, err)
+			}
+		})
+	}
+}
+
+func expectStat(t *testing.T, stats []domain.StatDiff, expected domain.StatDiff) {
+	t.Helper()
+
+	for _, s := range stats {
+		if s.Stat == expected.Stat {
+			if s != expected {
+				t.Errorf("expected %+v, got %+v", expected, s)
+			}
+			return
+		}
+	}
+
+	t.Errorf("stat %s missing", expected.Stat)
+}
@@ -0,0 +1,139 @@
+package craft
+
+// Base item types runewords can be made in.
+const (
+	typeSwords         = "swords"
+	typeAxes           = "axes"
+	typeMaces          = "maces"
+	typeClubs          = "clubs"
+	typeHammers        = "hammers"
+	typeScepters       = "scepters"
+	typeWands          = "wands"
+	typeStaves         = "staves"
+	typePolearms       = "polearms"
+	typeSpears         = "spears"
+	typeDaggers        = "daggers"
+	typeClaws          = "claws"
+	typeOrbs           = "orbs"
+	typeBows           = "bows"
+	typeCrossbows      = "crossbows"
+	typeHelms          = "helms"
+	typeBodyArmor      = "body_armor"
+	typeShields        = "shields"
+	typePaladinShields = "paladin_shields"
+)
+
+// Groups of base item types used by runewords.
+const (
+	groupWeapons        = "weapons"
+	groupMeleeWeapons   = "melee_weapons"
+	groupMissileWeapons = "missile_weapons"
+)
+
+// baseCodes are the item codes of each base item type, normal, exceptional and elite.
+var baseCodes = map[string][]string{
+	typeSwords: {
+		"ssd", "scm", "sbr", "flc", "crs", "bsd", "lsd", "wsd", "2hs", "clm", "gis", "bsw", "flb", "gsd",
+		"9ss", "9sm", "9sb", "9fc", "9cr", "9bs", "9ls", "9wd", "92h", "9cm", "9gs", "9b9", "9fb", "9gd",
+		"7ss", "7sm", "7sb", "7fc", "7cr", "7bs", "7ls", "7wd", "72h", "7cm", "7gs", "7b7", "7fb", "7gd",
+	},
+	typeAxes: {
+		"hax", "axe", "2ax", "mpi", "wax", "lax", "bax", "btx", "gax", "gix",
+		"9ha", "9ax", "92a", "9mp", "9wa", "9la", "9ba", "9bt", "9ga", "9gi",
+		"7ha", "7ax", "72a", "7mp", "7wa", "7la", "7ba", "7bt", "7ga", "7gi",
+	},
+	typeMaces:    {"mac", "mst", "fla", "9ma", "9mt", "9fl", "7ma", "7mt", "7fl"},
+	typeClubs:    {"clb", "spc", "9cl", "9sp", "7cl", "7sp"},
+	typeHammers:  {"whm", "mau", "gma", "9wh", "9m9", "9gm", "7wh", "7m7", "7gm"},
+	typeScepters: {"scp", "gsc", "wsp", "9sc", "9qs", "9ws", "7sc", "7qs", "7ws"},
+	typeWands:    {"wnd", "ywn", "bwn", "gwn", "9wn", "9yw", "9bw", "9gw", "7wn", "7yw", "7bw", "7gw"},
+	typeStaves:   {"sst", "lst", "cst", "bst", "wst", "8ss", "8ls", "8cs", "8bs", "8ws", "6ss", "6ls", "6cs", "6bs", "6ws"},
+	typePolearms: {
+		"bar", "vou", "scy", "pax", "hal", "wsc",
+		"9b7", "9vo", "9s8", "9pa", "9h9", "9wc",
+		"7o7", "7vo", "7s8", "7pa", "7h7", "7wc",
+	},
+	typeSpears: {
+		"spr", "tri", "brn", "spt", "pik", "9sr", "9tr", "9br", "9st", "9p9", "7sr", "7tr", "7br", "7st", "7p7",
+		"am3", "am4", "am8", "am9", "amd", "ame",
+	},
+	typeDaggers: {"dgr", "dir", "kri", "bld", "9dg", "9di", "9kr", "9bl", "7dg", "7di", "7kr", "7bl"},
+	typeClaws: {
+		"ktr", "wrb", "axf", "ces", "clw", "btl", "skr",
+		"9ar", "9wb", "9xf", "9cs", "9lw", "9tw", "9qr",
+		"7ar", "7wb", "7xf", "7cs", "7lw", "7tw", "7qr",
+	},
+	typeOrbs: {"ob1", "ob2", "ob3", "ob4", "ob5", "ob6", "ob7", "ob8", "ob9", "oba", "obb", "obc", "obd", "obe", "obf"},
+	typeBows: {
+		"sbw", "hbw", "lbw", "cbw", "sbb", "lbb", "swb", "lwb",
+		"8sb", "8hb", "8lb", "8cb", "8s8", "8l8", "8sw", "8lw",
+		"6sb", "6hb", "6lb", "6cb", "6s7", "6l7", "6sw", "6lw",
+		"am1", "am2", "am6", "am7", "amb", "amc",
+	},
+	typeCrossbows: {"lxb", "mxb", "hxb", "rxb", "8lx", "8mx", "8hx", "8rx", "6lx", "6mx", "6hx", "6rx"},
+	typeHelms: {
+		"cap", "skp", "hlm", "fhl", "ghm", "crn", "msk", "bhm",
+		"xap", "xkp", "xlm", "xhl", "xhm", "xrn", "xsk", "xh9",
+		"uap", "ukp", "ulm", "uhl", "uhm", "urn", "usk", "uh9",
+		"ci0", "ci1", "ci2", "ci3",
+		"ba1", "ba2", "ba3", "ba4", "ba5", "ba6", "ba7", "ba8", "ba9", "baa", "bab", "bac", "bad", "bae", "baf",
+		"dr1", "dr2", "dr3", "dr4", "dr5", "dr6", "dr7", "dr8", "dr9", "dra", "drb", "drc", "drd", "dre", "drf",
+	},
+	typeBodyArmor: {
+		"qui", "lea", "hla", "stu", "rng", "scl", "chn", "brs", "spl", "plt", "fld", "gth", "ful", "aar", "ltp",
+		"xui", "xea", "xla", "xtu", "xng", "xcl", "xhn", "xrs", "xpl", "xlt", "xld", "xth", "xul", "xar", "xtp",
+		"uui", "uea", "ula", "utu", "ung", "ucl", "uhn", "urs", "upl", "ult", "uld", "uth", "uul", "uar", "utp",
+	},
+	typeShields: {
+		"buc", "sml", "lrg", "kit", "tow", "gts", "bsh", "spk",
+		"xuc", "xml", "xrg", "xit", "xow", "xts", "xsh", "xpk",
+		"uuc", "uml", "urg", "uit", "uow", "uts", "ush", "upk",
+		"ne1", "ne2", "ne3", "ne4", "ne5", "ne6", "ne7", "ne8", "ne9", "nea", "neb", "nec", "ned", "nee", "nef", "neg",
+	},
+	typePaladinShields: {
+		"pa1", "pa2", "pa3", "pa4", "pa5", "pa6", "pa7", "pa8", "pa9", "paa", "pab", "pac", "pad", "pae", "paf",
+	},
+}
+
+// groups are the base item types in each group.
+var groups = map[string][]string{
+	groupMeleeWeapons: {
+		typeSwords, typeAxes, typeMaces, typeClubs, typeHammers, typeScepters, typeWands,
+		typeStaves, typePolearms, typeSpears, typeDaggers, typeClaws, typeOrbs,
+	},
+	groupMissileWeapons: {typeBows, typeCrossbows},
+	groupWeapons: {
+		typeSwords, typeAxes, typeMaces, typeClubs, typeHammers, typeScepters, typeWands,
+		typeStaves, typePolearms, typeSpears, typeDaggers, typeClaws, typeOrbs, typeBows, typeCrossbows,
+	},
+	// Paladin shields are shields as well.
+	typeShields: {typeShields, typePaladinShields},
+}
+
+// baseTypes maps item codes to their base item type.
+var baseTypes = func() map[string]string {
+	m := make(map[string]string)
+	for t, codes := range baseCodes {
+		for _, c := range codes {
+			m[c] = t
+		}
+	}
+	return m
+}()
+
+// fits returns true if the base item type is one of the allowed types or groups.
+func fits(baseType string, allowed []string) bool {
+	for _, a := range allowed {
+		if a == baseType {
+			return true
+		}
+
+		for _, t := range groups[a] {
+			if t == baseType {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,78 @@
+package craft
+
+import "fmt"
+
+// runeNames are the runes in order, El is r01 and Zod is r33.
+var runeNames = []string{
+	"El", "Eld", "Tir", "Nef", "Eth", "Ith", "Tal", "Ral", "Ort", "Thul", "Amn",
+	"Sol", "Shael", "Dol", "Hel", "Io", "Lum", "Ko", "Fal", "Lem", "Pul", "Um",
+	"Mal", "Ist", "Gul", "Vex", "Ohm", "Lo", "Sur", "Ber", "Jah", "Cham", "Zod",
+}
+
+// runeIndex maps rune names to their position in runeNames.
+var runeIndex = func() map[string]int {
+	m := make(map[string]int, len(runeNames))
+	for i, name := range runeNames {
+		m[name] = i
+	}
+	return m
+}()
+
+// runeCode returns the item code of the rune at the index, e.g. r01 for El.
+func runeCode(i int) string {
+	return fmt.Sprintf("r%02d", i+1)
+}
+
+// runeOf returns the index of the rune with the item code, false if it's not a rune.
+func runeOf(code string) (int, bool) {
+	var n int
+	if _, err := fmt.Sscanf(code, "r%02d", &n); err != nil || len(code) != 3 || n < 1 || n > len(runeNames) {
+		return 0, false
+	}
+
+	return n - 1, true
+}
+
+// gemNames are the names of the gems by item code, the ones used by the rune upgrade recipes.
+var gemNames = map[string]string{
+	"gcy": "Chipped Topaz",
+	"gcv": "Chipped Amethyst",
+	"gcb": "Chipped Sapphire",
+	"gcr": "Chipped Ruby",
+	"gcg": "Chipped Emerald",
+	"gcw": "Chipped Diamond",
+	"gfy": "Flawed Topaz",
+	"gfv": "Flawed Amethyst",
+	"gfb": "Flawed Sapphire",
+	"gfr": "Flawed Ruby",
+	"gfg": "Flawed Emerald",
+	"gfw": "Flawed Diamond",
+	"gsy": "Topaz",
+	"gsv": "Amethyst",
+	"gsb": "Sapphire",
+	"gsr": "Ruby",
+	"gsg": "Emerald",
+	"gsw": "Diamond",
+	"gly": "Flawless Topaz",
+	"gzv": "Flawless Amethyst",
+	"glb": "Flawless Sapphire",
+	"glr": "Flawless Ruby",
+	"glg": "Flawless Emerald",
+}
+
+// upgrade is the cube recipe upgrading a rune to the next one.
+type upgrade struct {
+	count int
+	gem   string
+}
+
+// upgrades are the cube recipes by the index of the rune they upgrade,
+// e.g. 3 Thul and a Chipped Topaz make an Amn. Zod can't be upgraded.
+var upgrades = []upgrade{
+	{3, ""}, {3, ""}, {3, ""}, {3, ""}, {3, ""}, {3, ""}, {3, ""}, {3, ""}, {3, ""}, // El to Ort
+	{3, "gcy"}, {3, "gcv"}, {3, "gcb"}, {3, "gcr"}, {3, "gcg"}, {3, "gcw"}, // Thul to Hel
+	{3, "gfy"}, {3, "gfv"}, {3, "gfb"}, {3, "gfr"}, {3, "gfg"}, // Io to Lem
+	{2, "gfw"},                                                             // Pul
+	{2, "gsy"}, {2, "gsv"}, {2, "gsb"}, {2, "gsr"}, {2, "gsg"}, {2, "gsw"}, // Um to Ohm
+	{2, "gly"}, {2, "gzv"}, {2, "glb"}, {2, "glr"}, {2, "glg"}, // Lo to Cham
+}
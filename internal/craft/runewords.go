@@ -0,0 +1,91 @@
+package craft
+
+// runeword is the recipe of a runeword, the runes in socket order and the
+// base item types it can be made in.
+type runeword struct {
+	name  string
+	runes []string
+	types []string
+}
+
+// runewords are the runewords of the game.
+var runewords = []runeword{
+	{"Ancient's Pledge", []string{"Ral", "Ort", "Tal"}, []string{typeShields}},
+	{"Black", []string{"Thul", "Io", "Nef"}, []string{typeClubs, typeHammers, typeMaces}},
+	{"Fury", []string{"Jah", "Gul", "Eth"}, []string{groupMeleeWeapons}},
+	{"Holy Thunder", []string{"Eth", "Ral", "Ort", "Tal"}, []string{typeScepters}},
+	{"Honor", []string{"Amn", "El", "Ith", "Tir", "Sol"}, []string{groupMeleeWeapons}},
+	{"King's Grace", []string{"Amn", "Ral", "Thul"}, []string{typeSwords, typeScepters}},
+	{"Leaf", []string{"Tir", "Ral"}, []string{typeStaves}},
+	{"Lionheart", []string{"Hel", "Lum", "Fal"}, []string{typeBodyArmor}},
+	{"Lore", []string{"Ort", "Sol"}, []string{typeHelms}},
+	{"Malice", []string{"Ith", "El", "Eth"}, []string{groupMeleeWeapons}},
+	{"Melody", []string{"Shael", "Ko", "Nef"}, []string{groupMissileWeapons}},
+	{"Memory", []string{"Lum", "Io", "Sol", "Eth"}, []string{typeStaves}},
+	{"Nadir", []string{"Nef", "Tir"}, []string{typeHelms}},
+	{"Radiance", []string{"Nef", "Sol", "Ith"}, []string{typeHelms}},
+	{"Rhyme", []string{"Shael", "Eth"}, []string{typeShields}},
+	{"Silence", []string{"Dol", "Eld", "Hel", "Ist", "Tir", "Vex"}, []string{groupWeapons}},
+	{"Smoke", []string{"Nef", "Lum"}, []string{typeBodyArmor}},
+	{"Stealth", []string{"Tal", "Eth"}, []string{typeBodyArmor}},
+	{"Steel", []string{"Tir", "El"}, []string{typeSwords, typeAxes, typeMaces}},
+	{"Strength", []string{"Amn", "Tir"}, []string{groupMeleeWeapons}},
+	{"Venom", []string{"Tal", "Dol", "Mal"}, []string{groupWeapons}},
+	{"Wealth", []string{"Lem", "Ko", "Tir"}, []string{typeBodyArmor}},
+	{"White", []string{"Dol", "Io"}, []string{typeWands}},
+	{"Zephyr", []string{"Ort", "Eth"}, []string{groupMissileWeapons}},
+	{"Beast", []string{"Ber", "Tir", "Um", "Mal", "Lum"}, []string{typeAxes, typeScepters, typeHammers}},
+	{"Bramble", []string{"Ral", "Ohm", "Sur", "Eth"}, []string{typeBodyArmor}},
+	{"Breath of the Dying", []string{"Vex", "Hel", "El", "Eld", "Zod", "Eth"}, []string{groupWeapons}},
+	{"Call to Arms", []string{"Amn", "Ral", "Mal", "Ist", "Ohm"}, []string{groupWeapons}},
+	{"Chains of Honor", []string{"Dol", "Um", "Ber", "Ist"}, []string{typeBodyArmor}},
+	{"Chaos", []string{"Fal", "Ohm", "Um"}, []string{typeClaws}},
+	{"Crescent Moon", []string{"Shael", "Um", "Tir"}, []string{typeAxes, typeSwords, typePolearms}},
+	{"Delirium", []string{"Lem", "Ist", "Io"}, []string{typeHelms}},
+	{"Doom", []string{"Hel", "Ohm", "Um", "Lo", "Cham"}, []string{typeAxes, typePolearms, typeHammers}},
+	{"Duress", []string{"Shael", "Um", "Thul"}, []string{typeBodyArmor}},
+	{"Enigma", []string{"Jah", "Ith", "Ber"}, []string{typeBodyArmor}},
+	{"Eternity", []string{"Amn", "Ber", "Ist", "Sol", "Sur"}, []string{groupMeleeWeapons}},
+	{"Exile", []string{"Vex", "Ohm", "Ist", "Dol"}, []string{typePaladinShields}},
+	{"Famine", []string{"Fal", "Ohm", "Ort", "Jah"}, []string{typeAxes, typeHammers}},
+	{"Gloom", []string{"Fal", "Um", "Pul"}, []string{typeBodyArmor}},
+	{"Hand of Justice", []string{"Sur", "Cham", "Amn", "Lo"}, []string{groupWeapons}},
+	{"Heart of the Oak", []string{"Ko", "Vex", "Pul", "Thul"}, []string{typeStaves, typeMaces}},
+	{"Kingslayer", []string{"Mal", "Um", "Gul", "Fal"}, []string{typeSwords, typeAxes}},
+	{"Passion", []string{"Dol", "Ort", "Eld", "Lem"}, []string{groupWeapons}},
+	{"Prudence", []string{"Mal", "Tir"}, []string{typeBodyArmor}},
+	{"Sanctuary", []string{"Ko", "Ko", "Mal"}, []string{typeShields}},
+	{"Splendor", []string{"Eth", "Lum"}, []string{typeShields}},
+	{"Stone", []string{"Shael", "Um", "Pul", "Lum"}, []string{typeBodyArmor}},
+	{"Wind", []string{"Sur", "El"}, []string{groupMeleeWeapons}},
+	{"Brand", []string{"Jah", "Lo", "Mal", "Gul"}, []string{groupMissileWeapons}},
+	{"Death", []string{"Hel", "El", "Vex", "Ort", "Gul"}, []string{typeSwords, typeAxes}},
+	{"Destruction", []string{"Vex", "Lo", "Ber", "Jah", "Ko"}, []string{typePolearms, typeSwords}},
+	{"Dragon", []string{"Sur", "Lo", "Sol"}, []string{typeBodyArmor, typeShields}},
+	{"Dream", []string{"Io", "Jah", "Pul"}, []string{typeHelms, typeShields}},
+	{"Edge", []string{"Tir", "Tal", "Amn"}, []string{groupMissileWeapons}},
+	{"Faith", []string{"Ohm", "Jah", "Lem", "Eld"}, []string{groupMissileWeapons}},
+	{"Fortitude", []string{"El", "Sol", "Dol", "Lo"}, []string{groupWeapons, typeBodyArmor}},
+	{"Grief", []string{"Eth", "Tir", "Lo", "Mal", "Ral"}, []string{typeSwords, typeAxes}},
+	{"Harmony", []string{"Tir", "Ith", "Sol", "Ko"}, []string{groupMissileWeapons}},
+	{"Ice", []string{"Amn", "Shael", "Jah", "Lo"}, []string{groupMissileWeapons}},
+	{"Infinity", []string{"Ber", "Mal", "Ber", "Ist"}, []string{typePolearms}},
+	{"Insight", []string{"Ral", "Tir", "Tal", "Sol"}, []string{typePolearms, typeStaves}},
+	{"Last Wish", []string{"Jah", "Mal", "Jah", "Sur", "Jah", "Ber"}, []string{typeSwords, typeHammers, typeAxes}},
+	{"Lawbringer", []string{"Amn", "Lem", "Ko"}, []string{typeSwords, typeHammers, typeScepters}},
+	{"Oath", []string{"Shael", "Pul", "Mal", "Lum"}, []string{typeSwords, typeAxes, typeMaces}},
+	{"Obedience", []string{"Hel", "Ko", "Thul", "Eth", "Fal"}, []string{typePolearms}},
+	{"Phoenix", []string{"Vex", "Vex", "Lo", "Jah"}, []string{groupWeapons, typeShields}},
+	{"Pride", []string{"Cham", "Sur", "Io", "Lo"}, []string{typePolearms}},
+	{"Rift", []string{"Hel", "Ko", "Lem", "Gul"}, []string{typePolearms, typeScepters}},
+	{"Spirit", []string{"Tal", "Thul", "Ort", "Amn"}, []string{typeSwords, typeShields}},
+	{"Voice of Reason", []string{"Lem", "Ko", "El", "Eld"}, []string{typeSwords, typeMaces}},
+	{"Wrath", []string{"Pul", "Lum", "Ber", "Mal"}, []string{groupMissileWeapons}},
+	{"Bone", []string{"Sol", "Um", "Um"}, []string{typeBodyArmor}},
+	{"Enlightenment", []string{"Pul", "Ral", "Sol"}, []string{typeBodyArmor}},
+	{"Myth", []string{"Hel", "Amn", "Nef"}, []string{typeBodyArmor}},
+	{"Peace", []string{"Shael", "Thul", "Amn"}, []string{typeBodyArmor}},
+	{"Principle", []string{"Ral", "Gul", "Eld"}, []string{typeBodyArmor}},
+	{"Rain", []string{"Ort", "Mal", "Ith"}, []string{typeBodyArmor}},
+	{"Treachery", []string{"Shael", "Thul", "Lem"}, []string{typeBodyArmor}},
+}
@@ -0,0 +1,238 @@
+package craft
+
+import (
+	"context"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+	"github.com/nokka/d2-armory-api/internal/gear"
+	"github.com/nokka/d2s"
+)
+
+//go:generate moq -out ./service_mocks.go . characterService
+
+// characterService is the interface representation of the characters
+// the service calculate craftable runewords for.
+type characterService interface {
+	Parse(ctx context.Context, name string) (*domain.Character, error)
+}
+
+// Item qualities runewords can be made in.
+const (
+	qualityLow      = 1
+	qualityNormal   = 2
+	qualitySuperior = 3
+)
+
+// Service calculates the runewords characters can make.
+type Service struct {
+	characterService characterService
+}
+
+// Get will get the runewords the character can make.
+func (s Service) Get(ctx context.Context, name string) (*domain.Craftable, error) {
+	character, err := s.characterService.Parse(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return Of(character.D2s), nil
+}
+
+// holdings are the runes, gems and bases held by a character.
+type holdings struct {
+	runes []int
+	gems  map[string]int
+	bases []domain.CraftBase
+}
+
+// held returns what the character holds in the inventory, stash and cube,
+// equipped items and items on the cursor aren't counted.
+func held(c *d2s.Character) holdings {
+	h := holdings{
+		runes: make([]int, len(runeNames)),
+		gems:  make(map[string]int),
+		bases: make([]domain.CraftBase, 0),
+	}
+
+	for _, item := range c.Items {
+		location := gear.Location(item)
+		if location != "inventory" && location != "stash" && location != "cube" {
+			continue
+		}
+
+		if r, ok := runeOf(item.Type); ok {
+			h.runes[r]++
+			continue
+		}
+
+		if _, ok := gemNames[item.Type]; ok {
+			h.gems[item.Type]++
+			continue
+		}
+
+		baseType, ok := baseTypes[item.Type]
+		if !ok || item.TotalNrOfSockets == 0 || len(item.SocketedItems) > 0 {
+			continue
+		}
+
+		if item.Quality != qualityLow && item.Quality != qualityNormal && item.Quality != qualitySuperior {
+			continue
+		}
+
+		h.bases = append(h.bases, domain.CraftBase{
+			Name:     item.TypeName,
+			Code:     item.Type,
+			Type:     baseType,
+			Sockets:  int(item.TotalNrOfSockets),
+			Ethereal: item.Ethereal == 1,
+			Location: location,
+		})
+	}
+
+	return h
+}
+
+// Of calculates the runewords the character can make right now, the ones it's a
+// single rune away from and the ones the rune upgrade cube recipes would complete.
+// Only runewords the character holds a base for are considered.
+func Of(c *d2s.Character) *domain.Craftable {
+	h := held(c)
+
+	craftable := &domain.Craftable{
+		Runes:       make(map[string]int),
+		Bases:       h.bases,
+		Craftable:   make([]domain.RunewordRecipe, 0),
+		OneRuneAway: make([]domain.RunewordRecipe, 0),
+		Upgradeable: make([]domain.RunewordRecipe, 0),
+	}
+
+	for i, n := range h.runes {
+		if n > 0 {
+			craftable.Runes[runeNames[i]] = n
+		}
+	}
+
+	for _, rw := range runewords {
+		bases := make([]string, 0)
+		for _, b := range h.bases {
+			if b.Sockets == len(rw.runes) && fits(b.Type, rw.types) {
+				bases = append(bases, b.Name)
+			}
+		}
+
+		if len(bases) == 0 {
+			continue
+		}
+
+		recipe := domain.RunewordRecipe{
+			Name:  rw.name,
+			Runes: rw.runes,
+			Types: rw.types,
+			Bases: bases,
+		}
+
+		// Use the runes held first, what's left is missing.
+		available := append([]int{}, h.runes...)
+		missing := make([]int, len(runeNames))
+		for _, name := range rw.runes {
+			r := runeIndex[name]
+			if available[r] > 0 {
+				available[r]--
+				continue
+			}
+
+			missing[r]++
+			recipe.Missing = append(recipe.Missing, name)
+		}
+
+		if len(recipe.Missing) == 0 {
+			craftable.Craftable = append(craftable.Craftable, recipe)
+			continue
+		}
+
+		recipe.Upgrades = upgradePath(missing, available, h.gems)
+
+		switch {
+		case len(recipe.Missing) == 1:
+			craftable.OneRuneAway = append(craftable.OneRuneAway, recipe)
+		case recipe.Upgrades != nil:
+			craftable.Upgradeable = append(craftable.Upgradeable, recipe)
+		}
+	}
+
+	return craftable
+}
+
+// upgradePath returns the cube recipes making the missing runes out of the
+// available runes and gems, nil if they can't all be made.
+func upgradePath(missing []int, available []int, gems map[string]int) []domain.CubeRecipe {
+	runes := append([]int{}, available...)
+	left := make(map[string]int, len(gems))
+	for g, n := range gems {
+		left[g] = n
+	}
+
+	var path []domain.CubeRecipe
+
+	// Make the most valuable runes first, since they consume the most runes below them.
+	for r := len(missing) - 1; r >= 0; r-- {
+		if missing[r] == 0 {
+			continue
+		}
+
+		if !produce(r, missing[r], runes, left, &path) {
+			return nil
+		}
+	}
+
+	return path
+}
+
+// produce makes n of the rune at index r, with the runes it takes to make it
+// if they're not available, recording the cube recipes used in the path.
+func produce(r int, n int, runes []int, gems map[string]int, path *[]domain.CubeRecipe) bool {
+	take := runes[r]
+	if take > n {
+		take = n
+	}
+	runes[r] -= take
+	n -= take
+
+	if n == 0 {
+		return true
+	}
+
+	// El is the lowest rune, there's nothing to make it of.
+	if r == 0 {
+		return false
+	}
+
+	u := upgrades[r-1]
+	if u.gem != "" {
+		if gems[u.gem] < n {
+			return false
+		}
+		gems[u.gem] -= n
+	}
+
+	if !produce(r-1, n*u.count, runes, gems, path) {
+		return false
+	}
+
+	*path = append(*path, domain.CubeRecipe{
+		Rune:   runeNames[r-1],
+		Count:  u.count,
+		Gem:    gemNames[u.gem],
+		Result: runeNames[r],
+		Times:  n,
+	})
+
+	return true
+}
+
+// NewService constructs a new craft service with all the dependencies.
+func NewService(characterService characterService) *Service {
+	return &Service{
+		characterService: characterService,
+	}
+}
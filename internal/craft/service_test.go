@@ -0,0 +1,167 @@
+package craft
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+	"github.com/nokka/d2s"
+)
+
+// stored returns an item stored in the inventory, stash or cube.
+func stored(code string, name string, storage uint64) d2s.Item {
+	return d2s.Item{LocationID: 0, AltPositionID: storage, Type: code, TypeName: name, Quality: qualityNormal}
+}
+
+func TestRunewordTable(t *testing.T) {
+	if len(runewords) != 78 {
+		t.Errorf("expected 78 runewords, got %d", len(runewords))
+	}
+
+	for _, rw := range runewords {
+		for _, r := range rw.runes {
+			if _, ok := runeIndex[r]; !ok {
+				t.Errorf("%s has an unknown rune %s", rw.name, r)
+			}
+		}
+
+		for _, typ := range rw.types {
+			if _, ok := baseCodes[typ]; !ok {
+				if _, ok := groups[typ]; !ok {
+					t.Errorf("%s has an unknown base type %s", rw.name, typ)
+				}
+			}
+		}
+	}
+
+	if len(upgrades) != len(runeNames)-1 {
+		t.Errorf("expected an upgrade recipe for every rune but Zod, got %d", len(upgrades))
+	}
+}
+
+func TestCraftable(t *testing.T) {
+	armor := stored("uui", "Dusk Shroud", 5)
+	armor.TotalNrOfSockets = 3
+
+	poleArm := stored("7o7", "Ogre Axe", 5)
+	poleArm.TotalNrOfSockets = 4
+
+	shield := stored("pa9", "Gilded Shield", 4)
+	shield.TotalNrOfSockets = 2
+
+	// Magic items and items already socketed aren't bases.
+	magic := stored("xui", "Ghost Armor", 5)
+	magic.TotalNrOfSockets = 3
+	magic.Quality = 4
+
+	socketed := stored("7cr", "Phase Blade", 5)
+	socketed.TotalNrOfSockets = 2
+	socketed.SocketedItems = []d2s.Item{{Type: "r01"}}
+
+	// Equipped runes aren't available.
+	equipped := d2s.Item{LocationID: 1, EquippedID: 4, Type: "r31"}
+
+	c := &d2s.Character{
+		Items: []d2s.Item{
+			armor, poleArm, shield, magic, socketed, equipped,
+			// Jah, Ith and Ber for Enigma.
+			stored("r31", "Jah Rune", 5), stored("r06", "Ith Rune", 1), stored("r30", "Ber Rune", 4),
+			// Ral, Tir and Tal for Insight, but no Sol.
+			stored("r08", "Ral Rune", 5), stored("r03", "Tir Rune", 5), stored("r07", "Tal Rune", 5),
+			// Three Amn and a Chipped Amethyst upgrade to a Sol.
+			stored("r11", "Amn Rune", 5), stored("r11", "Amn Rune", 5), stored("r11", "Amn Rune", 5),
+			stored("gcv", "Chipped Amethyst", 1),
+			// Eth for Rhyme, missing Shael which can't be made.
+			stored("r05", "Eth Rune", 1),
+		},
+	}
+
+	craftable := Of(c)
+
+	if len(craftable.Bases) != 3 {
+		t.Errorf("expected 3 bases, got %v", craftable.Bases)
+	}
+
+	if craftable.Runes["Amn"] != 3 || craftable.Runes["Jah"] != 1 {
+		t.Errorf("unexpected runes %v", craftable.Runes)
+	}
+
+	names := func(recipes []domain.RunewordRecipe) []string {
+		list := make([]string, 0, len(recipes))
+		for _, r := range recipes {
+			list = append(list, r.Name)
+		}
+		return list
+	}
+
+	if got := names(craftable.Craftable); !reflect.DeepEqual(got, []string{"Enigma"}) {
+		t.Errorf("expected Enigma to be craftable, got %v", got)
+	}
+
+	var insight domain.RunewordRecipe
+	for _, r := range craftable.OneRuneAway {
+		if r.Name == "Insight" {
+			insight = r
+		}
+	}
+
+	if !reflect.DeepEqual(insight.Missing, []string{"Sol"}) || !reflect.DeepEqual(insight.Bases, []string{"Ogre Axe"}) {
+		t.Fatalf("expected Insight to be one Sol away, got %+v", insight)
+	}
+
+	expectedPath := []domain.CubeRecipe{{Rune: "Amn", Count: 3, Gem: "Chipped Amethyst", Result: "Sol", Times: 1}}
+	if !reflect.DeepEqual(insight.Upgrades, expectedPath) {
+		t.Errorf("expected upgrade path %+v, got %+v", expectedPath, insight.Upgrades)
+	}
+
+	for _, r := range craftable.OneRuneAway {
+		if r.Name == "Rhyme" && r.Upgrades != nil {
+			t.Errorf("expected no upgrade path for Rhyme, got %+v", r.Upgrades)
+		}
+	}
+}
+
+func TestUpgradePath(t *testing.T) {
+	runes := make([]int, len(runeNames))
+	runes[runeIndex["El"]] = 9
+
+	missing := make([]int, len(runeNames))
+	missing[runeIndex["Tir"]] = 1
+
+	expected := []domain.CubeRecipe{
+		{Rune: "El", Count: 3, Result: "Eld", Times: 3},
+		{Rune: "Eld", Count: 3, Result: "Tir", Times: 1},
+	}
+
+	if path := upgradePath(missing, runes, nil); !reflect.DeepEqual(path, expected) {
+		t.Errorf("expected %+v, got %+v", expected, path)
+	}
+
+	// The runes available aren't changed.
+	if runes[runeIndex["El"]] != 9 {
+		t.Errorf("expected the available runes to be left as is")
+	}
+
+	missing[runeIndex["Tir"]] = 2
+	if path := upgradePath(missing, runes, nil); path != nil {
+		t.Errorf("expected no path, got %+v", path)
+	}
+}
+
+func TestGet(t *testing.T) {
+	characterService := &characterServiceMock{
+		ParseFunc: func(ctx context.Context, name string) (*domain.Character, error) {
+			return &domain.Character{ID: name, D2s: &d2s.Character{}}, nil
+		},
+	}
+
+	craftable, err := NewService(characterService).Get(context.Background(), "nokka")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(craftable.Craftable) != 0 || len(craftable.Bases) != 0 {
+		t.Errorf("expected nothing to be craftable, got %+v", craftable)
+	}
+}
@@ -0,0 +1,29 @@
+package domain
+
+// Account represents a battle.net account and the characters that belong to it.
+type Account struct {
+	Name       string             `json:"account"`
+	Characters []CharacterSummary `json:"characters"`
+	Statistics AccountStatistics  `json:"statistics"`
+}
+
+// AccountStatistics are the statistics of all the characters on an account combined.
+type AccountStatistics struct {
+	Normal    AccountStats `json:"normal"`
+	Nightmare AccountStats `json:"nightmare"`
+	Hell      AccountStats `json:"hell"`
+}
+
+// AccountStats is repeated for each difficulty.
+type AccountStats struct {
+	TotalKills       int `json:"total_kills"`
+	TotalUniqueKills int `json:"total_unique_kills"`
+	TotalChampKills  int `json:"total_champ_kills"`
+}
+
+// Add will add the character stats to the account stats.
+func (a *AccountStats) Add(s Stats) {
+	a.TotalKills += s.TotalKills
+	a.TotalUniqueKills += s.TotalUniqueKills
+	a.TotalChampKills += s.TotalChampKills
+}
@@ -0,0 +1,49 @@
+package domain
+
+import "time"
+
+// Achievement is a goal characters unlock, declared by a rule.
+type Achievement struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// AchievementUnlock is the moment a character unlocked an achievement.
+type AchievementUnlock struct {
+	Character   string    `json:"character"`
+	Achievement string    `json:"achievement"`
+	UnlockedAt  time.Time `json:"unlocked_at" bson:"unlocked_at"`
+}
+
+// CharacterAchievement is an achievement and when the character unlocked it,
+// nil if it hasn't yet.
+type CharacterAchievement struct {
+	Achievement
+	UnlockedAt *time.Time `json:"unlocked_at,omitempty"`
+}
+
+// CharacterAchievements are every achievement and which of them the character has unlocked.
+type CharacterAchievements struct {
+	Character    string                 `json:"character"`
+	Unlocked     int                    `json:"unlocked"`
+	Total        int                    `json:"total"`
+	Achievements []CharacterAchievement `json:"achievements"`
+}
+
+// AchievementTally is how many characters have unlocked an achievement, and
+// which did so first and last.
+type AchievementTally struct {
+	Achievement string            `bson:"_id"`
+	Unlocked    int               `bson:"unlocked"`
+	First       AchievementUnlock `bson:"first"`
+	Latest      AchievementUnlock `bson:"latest"`
+}
+
+// AchievementSummary is an achievement and how many characters have unlocked it.
+type AchievementSummary struct {
+	Achievement
+	Unlocked int                `json:"unlocked"`
+	First    *AchievementUnlock `json:"first,omitempty"`
+	Latest   *AchievementUnlock `json:"latest,omitempty"`
+}
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"strings"
 	"time"
 
 	"github.com/nokka/d2s"
@@ -11,4 +12,75 @@ type Character struct {
 	ID         string         `json:"d2s_id"`
 	D2s        *d2s.Character `json:"d2s"`
 	LastParsed time.Time      `json:"last_parsed"`
+	DeletedAt  *time.Time     `json:"deleted_at,omitempty"`
+	Info       *CharacterInfo `json:"info,omitempty"`
+
+	// FirstSeen is when the character was first parsed, it's unknown for
+	// characters stored before it was kept track of.
+	FirstSeen *time.Time `json:"first_seen,omitempty" bson:"firstseen,omitempty"`
+}
+
+// Born returns when the character was created according to the realm, or when
+// it was first seen otherwise, it's the zero time when neither is known.
+func (c Character) Born() time.Time {
+	if c.Info != nil && !c.Info.Created.IsZero() {
+		return c.Info.Created
+	}
+
+	if c.FirstSeen != nil {
+		return *c.FirstSeen
+	}
+
+	return time.Time{}
+}
+
+// CharacterInfo is the metadata the realm keeps about a character, only
+// available when the armory runs next to a PvPGN realm.
+type CharacterInfo struct {
+	Account   string    `json:"account"`
+	Realm     string    `json:"realm"`
+	Created   time.Time `json:"created"`
+	LastLogin time.Time `json:"last_login"`
+	Ladder    bool      `json:"ladder"`
+}
+
+// NormalizeName returns the canonical form of a character or account name,
+// names are case insensitive in game so they're stored and looked up lower cased.
+func NormalizeName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// Sort orders available when listing characters.
+const (
+	SortLastSaved = "last_saved"
+	SortName      = "name"
+)
+
+// CharacterSummary is a light weight description of a character, the class and level
+// are only known once the character has been parsed at least once.
+type CharacterSummary struct {
+	Name       string     `json:"name"`
+	Class      string     `json:"class,omitempty"`
+	Level      int        `json:"level,omitempty"`
+	LastSaved  time.Time  `json:"last_saved"`
+	LastParsed *time.Time `json:"last_parsed,omitempty"`
+}
+
+// CharacterList is a single page of character summaries.
+type CharacterList struct {
+	Total      int                `json:"total"`
+	Offset     int                `json:"offset"`
+	Limit      int                `json:"limit"`
+	Characters []CharacterSummary `json:"characters"`
+}
+
+// ListOptions determines the page and order of a character listing.
+type ListOptions struct {
+	Offset     int
+	Limit      int
+	Sort       string
+	Descending bool
+
+	// Exclude holds the names of characters to leave out of the listing.
+	Exclude map[string]struct{}
 }
@@ -0,0 +1,60 @@
+package domain
+
+// Comparison is two characters compared side by side, differences are
+// the value of B minus the value of A.
+type Comparison struct {
+	A          ComparedCharacter `json:"a"`
+	B          ComparedCharacter `json:"b"`
+	Attributes []StatDiff        `json:"attributes"`
+	Derived    []StatDiff        `json:"derived"`
+	Skills     []SkillDiff       `json:"skills"`
+	Items      []SlotDiff        `json:"items"`
+	Statistics []StatDiff        `json:"statistics"`
+}
+
+// ComparedCharacter describes one of the compared characters.
+type ComparedCharacter struct {
+	Name       string `json:"name"`
+	Class      string `json:"class"`
+	Level      int    `json:"level"`
+	Statistics bool   `json:"statistics"`
+}
+
+// StatDiff is a single stat of both characters.
+type StatDiff struct {
+	Stat       string `json:"stat"`
+	A          int64  `json:"a"`
+	B          int64  `json:"b"`
+	Difference int64  `json:"difference"`
+}
+
+// SkillDiff is a single skill of both characters.
+type SkillDiff struct {
+	ID         int         `json:"id"`
+	Name       string      `json:"name"`
+	A          SkillPoints `json:"a"`
+	B          SkillPoints `json:"b"`
+	Difference int         `json:"difference"`
+}
+
+// SkillPoints are the points invested in a skill and its level with item bonuses.
+type SkillPoints struct {
+	BasePoints int `json:"base_points"`
+	Level      int `json:"level"`
+}
+
+// SlotDiff is the item both characters have equipped in a slot.
+type SlotDiff struct {
+	Slot string        `json:"slot"`
+	A    *ComparedItem `json:"a"`
+	B    *ComparedItem `json:"b"`
+	Same bool          `json:"same"`
+}
+
+// ComparedItem describes an equipped item.
+type ComparedItem struct {
+	Name    string `json:"name"`
+	Base    string `json:"base"`
+	Code    string `json:"code"`
+	Quality string `json:"quality"`
+}
@@ -0,0 +1,40 @@
+package domain
+
+// Craftable are the runewords a character can make with the runes, gems and
+// bases it holds in the inventory, stash and cube.
+type Craftable struct {
+	Runes       map[string]int   `json:"runes"`
+	Bases       []CraftBase      `json:"bases"`
+	Craftable   []RunewordRecipe `json:"craftable"`
+	OneRuneAway []RunewordRecipe `json:"one_rune_away"`
+	Upgradeable []RunewordRecipe `json:"upgradeable"`
+}
+
+// CraftBase is an empty socketed item a runeword can be made in.
+type CraftBase struct {
+	Name     string `json:"name"`
+	Code     string `json:"code"`
+	Type     string `json:"type"`
+	Sockets  int    `json:"sockets"`
+	Ethereal bool   `json:"ethereal"`
+	Location string `json:"location"`
+}
+
+// RunewordRecipe is a runeword with the bases it fits in, and what's missing to make it.
+type RunewordRecipe struct {
+	Name     string       `json:"name"`
+	Runes    []string     `json:"runes"`
+	Types    []string     `json:"types"`
+	Bases    []string     `json:"bases"`
+	Missing  []string     `json:"missing,omitempty"`
+	Upgrades []CubeRecipe `json:"upgrades,omitempty"`
+}
+
+// CubeRecipe is a rune upgrade transmuted in the Horadric Cube, done Times times.
+type CubeRecipe struct {
+	Rune   string `json:"rune"`
+	Count  int    `json:"count"`
+	Gem    string `json:"gem,omitempty"`
+	Result string `json:"result"`
+	Times  int    `json:"times"`
+}
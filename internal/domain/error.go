@@ -14,6 +14,9 @@ const (
 	// ErrNotFound is returned when a resource can't be find.
 	ErrNotFound = Error("resource was not found")
 
+	// ErrGone is returned when a resource existed but has been deleted.
+	ErrGone = Error("resource is gone")
+
 	// ErrInvalidArgument is returned when one or more arguments are invalid.
 	ErrInvalidArgument = Error("invalid argument")
 
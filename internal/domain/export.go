@@ -0,0 +1,11 @@
+package domain
+
+import "time"
+
+// Export is a character exported to a file for third party tools.
+type Export struct {
+	Filename    string
+	ContentType string
+	Body        []byte
+	LastParsed  time.Time
+}
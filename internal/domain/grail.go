@@ -0,0 +1,43 @@
+package domain
+
+import "time"
+
+// Kinds of items tracked by the Holy Grail.
+const (
+	GrailUnique   = "unique"
+	GrailSet      = "set"
+	GrailRune     = "rune"
+	GrailRuneword = "runeword"
+)
+
+// GrailEntry is the first time an item was seen on an account.
+type GrailEntry struct {
+	Account   string    `json:"account" bson:"account"`
+	Realm     string    `json:"realm" bson:"realm"`
+	Kind      string    `json:"kind" bson:"kind"`
+	Name      string    `json:"name" bson:"name"`
+	Character string    `json:"character" bson:"character"`
+	FoundAt   time.Time `json:"found_at" bson:"found_at"`
+}
+
+// Grail is the Holy Grail of an account, every unique, set item, rune and
+// runeword ever found by the characters on it.
+type Grail struct {
+	Account    string        `json:"account"`
+	Realm      string        `json:"realm,omitempty"`
+	Found      int           `json:"found"`
+	Total      int           `json:"total"`
+	Completion float64       `json:"completion"`
+	Uniques    GrailCategory `json:"uniques"`
+	Sets       GrailCategory `json:"sets"`
+	Runes      GrailCategory `json:"runes"`
+	Runewords  GrailCategory `json:"runewords"`
+}
+
+// GrailCategory is the progress of a single kind of item.
+type GrailCategory struct {
+	Found      int          `json:"found"`
+	Total      int          `json:"total"`
+	Completion float64      `json:"completion"`
+	Items      []GrailEntry `json:"items"`
+}
@@ -0,0 +1,58 @@
+package domain
+
+import "time"
+
+// Graveyard sort orders.
+const (
+	GraveyardSortRecent = "recent"
+	GraveyardSortLevel  = "level"
+)
+
+// Grave is a hardcore character as it was the first time it was seen dead, a name
+// that's used again by a new character gets a grave of its own.
+type Grave struct {
+	Character  string      `json:"character"`
+	Born       time.Time   `json:"born" bson:"born"`
+	Class      string      `json:"class"`
+	Level      int         `json:"level"`
+	Experience uint64      `json:"experience"`
+	Account    string      `json:"account,omitempty"`
+	Realm      string      `json:"realm,omitempty"`
+	Difficulty string      `json:"difficulty,omitempty"`
+	Area       string      `json:"area,omitempty"`
+	Gear       []GraveItem `json:"gear"`
+	DiedAt     time.Time   `json:"died_at" bson:"died_at"`
+}
+
+// GraveItem is an item the character had equipped when it died.
+type GraveItem struct {
+	Slot    string `json:"slot"`
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Quality string `json:"quality"`
+}
+
+// LastArea is where the character was last known to be, according to the
+// statistics posted for it.
+type LastArea struct {
+	Character  string    `json:"character"`
+	Difficulty string    `json:"difficulty"`
+	Area       string    `json:"area"`
+	SeenAt     time.Time `json:"seen_at" bson:"seen_at"`
+}
+
+// GraveyardOptions determines the page and order of the graveyard.
+type GraveyardOptions struct {
+	Offset  int
+	Limit   int
+	Sort    string
+	Exclude map[string]struct{}
+}
+
+// Graveyard is a single page of graves.
+type Graveyard struct {
+	Total  int     `json:"total"`
+	Offset int     `json:"offset"`
+	Limit  int     `json:"limit"`
+	Graves []Grave `json:"graves"`
+}
@@ -0,0 +1,35 @@
+package domain
+
+import "time"
+
+// Ladder modes of the realm ladder.
+const (
+	LadderClassicSoftcore   = "classic-softcore"
+	LadderClassicHardcore   = "classic-hardcore"
+	LadderExpansionSoftcore = "expansion-softcore"
+	LadderExpansionHardcore = "expansion-hardcore"
+)
+
+// LadderOverall is the ladder class of the ladder including every class.
+const LadderOverall = "overall"
+
+// LadderEntry is a single character ranked on the realm ladder.
+type LadderEntry struct {
+	Mode       string    `json:"mode"`
+	Ladder     string    `json:"ladder"`
+	Rank       int       `json:"rank"`
+	Character  string    `json:"character"`
+	Class      string    `json:"class"`
+	Level      int       `json:"level"`
+	Experience uint32    `json:"experience"`
+	Dead       bool      `json:"dead"`
+	Armory     bool      `json:"armory"`
+	ImportedAt time.Time `json:"imported_at" bson:"imported_at"`
+}
+
+// RealmLadder is a single split of the realm ladder.
+type RealmLadder struct {
+	Mode    string        `json:"mode"`
+	Class   string        `json:"class"`
+	Entries []LadderEntry `json:"entries"`
+}
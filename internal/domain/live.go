@@ -0,0 +1,15 @@
+package domain
+
+// Live update types pushed to the live connections of a character.
+const (
+	LiveCharacter  = "character"
+	LiveStatistics = "statistics"
+)
+
+// LiveUpdate is a change to a character pushed to its live connections, either the
+// character as it was reparsed or the statistics posted for it.
+type LiveUpdate struct {
+	Type       string
+	Character  *Character
+	Statistics *StatisticsRequest
+}
@@ -0,0 +1,35 @@
+package domain
+
+import "github.com/nokka/d2s"
+
+// Mercenary is the hireling of a character.
+type Mercenary struct {
+	ID          string      `json:"id"`
+	NameID      int         `json:"name_id"`
+	Type        int         `json:"type"`
+	Act         int         `json:"act"`
+	Class       string      `json:"class"`
+	Variant     string      `json:"variant"`
+	Difficulty  string      `json:"difficulty"`
+	Experience  uint32      `json:"experience"`
+	Level       int         `json:"level"`
+	Dead        bool        `json:"dead"`
+	Items       []d2s.Item  `json:"items"`
+	Resistances Resistances `json:"resistances"`
+	Auras       []Aura      `json:"auras"`
+}
+
+// Resistances are the resistances granted by items.
+type Resistances struct {
+	Fire      int64 `json:"fire"`
+	Cold      int64 `json:"cold"`
+	Lightning int64 `json:"lightning"`
+	Poison    int64 `json:"poison"`
+}
+
+// Aura is an aura granted by an item when equipped.
+type Aura struct {
+	SkillID int    `json:"skill_id"`
+	Name    string `json:"name"`
+	Level   int    `json:"level"`
+}
@@ -0,0 +1,47 @@
+package domain
+
+// Progress is the quest and waypoint progression of a character.
+type Progress struct {
+	Title                 string             `json:"title,omitempty"`
+	CompletedDifficulties int                `json:"completed_difficulties"`
+	RemainingSkillPoints  int                `json:"remaining_skill_points"`
+	RemainingStatPoints   int                `json:"remaining_stat_points"`
+	RemainingResistance   int                `json:"remaining_resistance"`
+	Normal                DifficultyProgress `json:"normal"`
+	Nightmare             DifficultyProgress `json:"nightmare"`
+	Hell                  DifficultyProgress `json:"hell"`
+}
+
+// DifficultyProgress is repeated for each difficulty.
+type DifficultyProgress struct {
+	Quests               []QuestProgress `json:"quests"`
+	Waypoints            []Waypoint      `json:"waypoints"`
+	Rewards              QuestRewards    `json:"rewards"`
+	RemainingSkillPoints int             `json:"remaining_skill_points"`
+	RemainingStatPoints  int             `json:"remaining_stat_points"`
+	RemainingResistance  int             `json:"remaining_resistance"`
+}
+
+// QuestProgress is the state of a single quest.
+type QuestProgress struct {
+	Act                  int    `json:"act"`
+	Name                 string `json:"name"`
+	Completed            bool   `json:"completed"`
+	RequirementCompleted bool   `json:"requirement_completed"`
+}
+
+// Waypoint is a single waypoint and whether it's been unlocked.
+type Waypoint struct {
+	Act      int    `json:"act"`
+	Name     string `json:"name"`
+	Unlocked bool   `json:"unlocked"`
+}
+
+// QuestRewards are the permanent rewards of quests, true when they've been claimed.
+type QuestRewards struct {
+	DenOfEvil     bool `json:"den_of_evil"`
+	RadamentsBook bool `json:"radaments_book"`
+	LamEsensTome  bool `json:"lam_esens_tome"`
+	IzualsSoul    bool `json:"izuals_soul"`
+	AnyasScroll   bool `json:"anyas_scroll"`
+}
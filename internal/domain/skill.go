@@ -0,0 +1,38 @@
+package domain
+
+// SkillTree is the skill allocation of a character, including the bonuses of items.
+type SkillTree struct {
+	Class       string       `json:"class"`
+	AllSkills   int          `json:"all_skills"`
+	ClassSkills int          `json:"class_skills"`
+	Tabs        []SkillTab   `json:"tabs"`
+	OSkills     []SkillLevel `json:"oskills"`
+}
+
+// SkillTab is a single tab of the class skill tree.
+type SkillTab struct {
+	Name   string       `json:"name"`
+	Bonus  int          `json:"bonus"`
+	Skills []SkillLevel `json:"skills"`
+}
+
+// SkillLevel is the level of a single skill, base points are the points invested in the
+// skill and bonus points are granted by items. Item bonuses to all skills, the class or
+// a tab only apply to skills with base points.
+type SkillLevel struct {
+	ID          int            `json:"id"`
+	Name        string         `json:"name"`
+	BasePoints  int            `json:"base_points"`
+	BonusPoints int            `json:"bonus_points"`
+	Level       int            `json:"level"`
+	Synergies   []SynergyBonus `json:"synergies,omitempty"`
+}
+
+// SynergyBonus is the bonus a skill gets from the base points of another skill.
+type SynergyBonus struct {
+	SkillID int    `json:"skill_id"`
+	Name    string `json:"name"`
+	Points  int    `json:"points"`
+	Bonus   int    `json:"bonus"`
+	Effect  string `json:"effect"`
+}
@@ -0,0 +1,28 @@
+package domain
+
+import "time"
+
+// Visibility levels of a character.
+const (
+	// VisibilityPublic characters are visible to anyone and listed everywhere.
+	VisibilityPublic = "public"
+
+	// VisibilityUnlisted characters are visible to anyone who knows the name,
+	// but they're left out of listings.
+	VisibilityUnlisted = "unlisted"
+
+	// VisibilityPrivate characters are only visible with the share token.
+	VisibilityPrivate = "private"
+)
+
+// VisibilitySetting determines who is able to see a character.
+type VisibilitySetting struct {
+	Character  string    `json:"character"`
+	Visibility string    `json:"visibility"`
+	ShareToken string    `json:"share_token,omitempty" bson:"share_token"`
+	UpdatedAt  time.Time `json:"updated_at" bson:"updated_at"`
+
+	// OwnerSecretHash is the SHA-256 of the secret the owner changes the setting with,
+	// the secret itself is only known to the owner.
+	OwnerSecretHash string `json:"-" bson:"owner_secret_hash,omitempty"`
+}
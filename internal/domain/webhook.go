@@ -0,0 +1,47 @@
+package domain
+
+import "time"
+
+// Events webhooks can subscribe to.
+const (
+	// EventLevelUp is sent when a character gained one or more levels.
+	EventLevelUp = "level_up"
+
+	// EventDeath is sent when a hardcore character died.
+	EventDeath = "death"
+
+	// EventUniqueFound is sent when a character found uniques it didn't have before.
+	EventUniqueFound = "unique_found"
+
+	// EventStatisticsMilestone is sent when the total kills of a character passed a milestone.
+	EventStatisticsMilestone = "statistics_milestone"
+)
+
+// Webhook is a subscription to character events, delivered to the URL.
+type Webhook struct {
+	ID        string    `json:"id"`
+	URL       string    `json:"url"`
+	Events    []string  `json:"events"`
+	Secret    string    `json:"secret,omitempty"`
+	CreatedAt time.Time `json:"created_at" bson:"created_at"`
+}
+
+// Event is something that happened to a character, sent to the webhooks subscribed to it.
+type Event struct {
+	ID         string                 `json:"id"`
+	Type       string                 `json:"type"`
+	Character  string                 `json:"character"`
+	OccurredAt time.Time              `json:"occurred_at" bson:"occurred_at"`
+	Data       map[string]interface{} `json:"data"`
+}
+
+// DeadLetter is an event that couldn't be delivered to a webhook.
+type DeadLetter struct {
+	ID        string    `json:"id"`
+	WebhookID string    `json:"webhook_id" bson:"webhook_id"`
+	URL       string    `json:"url"`
+	Event     Event     `json:"event"`
+	Attempts  int       `json:"attempts"`
+	LastError string    `json:"last_error" bson:"last_error"`
+	FailedAt  time.Time `json:"failed_at" bson:"failed_at"`
+}
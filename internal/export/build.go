@@ -0,0 +1,161 @@
+package export
+
+import (
+	"encoding/json"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+	"github.com/nokka/d2-armory-api/internal/gear"
+	"github.com/nokka/d2s"
+)
+
+// buildFormat and buildVersion identify the build export, the version is
+// increased whenever a change would break tools importing it.
+const (
+	buildFormat  = "d2-armory-build"
+	buildVersion = 1
+)
+
+// Build is the build export, a stable description of a character that
+// planner tools can import. The format is documented in the README.
+type Build struct {
+	Format    string          `json:"format"`
+	Version   int             `json:"version"`
+	Character BuildCharacter  `json:"character"`
+	Stats     BuildStats      `json:"stats"`
+	Skills    []BuildSkill    `json:"skills"`
+	Items     []BuildItem     `json:"items"`
+	Mercenary *BuildMercenary `json:"mercenary,omitempty"`
+}
+
+// BuildCharacter describes the character.
+type BuildCharacter struct {
+	Name      string `json:"name"`
+	Class     string `json:"class"`
+	Level     int    `json:"level"`
+	Hardcore  bool   `json:"hardcore"`
+	Expansion bool   `json:"expansion"`
+}
+
+// BuildStats are the attributes of the character without the bonuses of items.
+type BuildStats struct {
+	Strength  uint64 `json:"strength"`
+	Dexterity uint64 `json:"dexterity"`
+	Vitality  uint64 `json:"vitality"`
+	Energy    uint64 `json:"energy"`
+	Life      uint64 `json:"life"`
+	Mana      uint64 `json:"mana"`
+	Stamina   uint64 `json:"stamina"`
+}
+
+// BuildSkill is a skill with the points invested in it.
+type BuildSkill struct {
+	ID     int    `json:"id"`
+	Name   string `json:"name"`
+	Points int    `json:"points"`
+}
+
+// BuildItem is an equipped item or charm.
+type BuildItem struct {
+	Slot       string      `json:"slot"`
+	Code       string      `json:"code"`
+	Base       string      `json:"base"`
+	Name       string      `json:"name"`
+	Quality    string      `json:"quality"`
+	Ethereal   bool        `json:"ethereal"`
+	Sockets    int         `json:"sockets"`
+	Socketed   []BuildItem `json:"socketed,omitempty"`
+	Properties []string    `json:"properties"`
+}
+
+// BuildMercenary is the mercenary and its equipped items.
+type BuildMercenary struct {
+	Type  int         `json:"type"`
+	Items []BuildItem `json:"items"`
+}
+
+// build creates the build export of the character.
+func build(c *domain.Character) ([]byte, error) {
+	h := c.D2s.Header
+	status := h.Status.Readable()
+
+	b := Build{
+		Format:  buildFormat,
+		Version: buildVersion,
+		Character: BuildCharacter{
+			Name:      h.Name.String(),
+			Class:     h.Class.String(),
+			Level:     int(h.Level),
+			Hardcore:  status.Hardcore,
+			Expansion: status.Expansion,
+		},
+		Stats: BuildStats{
+			Strength:  c.D2s.Attributes.Strength,
+			Dexterity: c.D2s.Attributes.Dexterity,
+			Vitality:  c.D2s.Attributes.Vitality,
+			Energy:    c.D2s.Attributes.Energy,
+			Life:      c.D2s.Attributes.MaxHP,
+			Mana:      c.D2s.Attributes.MaxMana,
+			Stamina:   c.D2s.Attributes.MaxStamina,
+		},
+		Skills: make([]BuildSkill, 0),
+		Items:  buildItems(gear.Active(c.D2s.Items, h.ActiveArms)),
+	}
+
+	for _, s := range c.D2s.Skills {
+		if s.Points > 0 {
+			b.Skills = append(b.Skills, BuildSkill{ID: s.ID, Name: s.Name, Points: s.Points})
+		}
+	}
+
+	if h.MercID != 0 {
+		b.Mercenary = &BuildMercenary{
+			Type:  int(h.MercType),
+			Items: buildItems(gear.Equipped(c.D2s.MercItems, gear.WeaponSetPrimary)),
+		}
+	}
+
+	return json.MarshalIndent(b, "", "  ")
+}
+
+func buildItems(items []d2s.Item) []BuildItem {
+	list := make([]BuildItem, 0, len(items))
+	for _, item := range items {
+		list = append(list, buildItem(item))
+	}
+
+	return list
+}
+
+func buildItem(item d2s.Item) BuildItem {
+	b := BuildItem{
+		Slot:       gear.Location(item),
+		Code:       item.Type,
+		Base:       item.TypeName,
+		Name:       gear.ItemName(item),
+		Quality:    gear.QualityName(item.Quality),
+		Ethereal:   item.Ethereal == 1,
+		Sockets:    int(item.TotalNrOfSockets),
+		Properties: properties(item),
+	}
+
+	for _, s := range item.SocketedItems {
+		b.Socketed = append(b.Socketed, buildItem(s))
+	}
+
+	return b
+}
+
+// properties returns the descriptions of the item's own properties, the
+// properties of socketed items are listed with them.
+func properties(item d2s.Item) []string {
+	props := make([]string, 0, len(item.MagicAttributes)+len(item.RunewordAttributes))
+	for _, a := range item.MagicAttributes {
+		props = append(props, gear.Describe(gear.Attribute{ID: a.ID, Name: a.Name, Values: a.Values}))
+	}
+
+	for _, a := range item.RunewordAttributes {
+		props = append(props, gear.Describe(gear.Attribute{ID: a.ID, Name: a.Name, Values: a.Values}))
+	}
+
+	return props
+}
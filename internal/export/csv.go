@@ -0,0 +1,128 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strconv"
+	"strings"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+	"github.com/nokka/d2-armory-api/internal/gear"
+)
+
+// itemColumns are the columns of the items CSV.
+var itemColumns = []string{
+	"location",
+	"name",
+	"base",
+	"code",
+	"quality",
+	"ethereal",
+	"sockets",
+	"socketed",
+	"item_level",
+	"defense",
+	"properties",
+}
+
+// itemsCSV exports every item of the character as a row.
+func itemsCSV(c *domain.Character) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	if err := w.Write(itemColumns); err != nil {
+		return nil, err
+	}
+
+	for _, item := range c.D2s.Items {
+		socketed := make([]string, 0, len(item.SocketedItems))
+		for _, s := range item.SocketedItems {
+			socketed = append(socketed, gear.ItemName(s))
+		}
+
+		row := []string{
+			gear.Location(item),
+			gear.ItemName(item),
+			item.TypeName,
+			item.Type,
+			gear.QualityName(item.Quality),
+			strconv.FormatBool(item.Ethereal == 1),
+			strconv.FormatUint(item.TotalNrOfSockets, 10),
+			strings.Join(socketed, "; "),
+			strconv.FormatUint(item.Level, 10),
+			strconv.FormatInt(item.DefenseRating, 10),
+			strings.Join(properties(item), "; "),
+		}
+
+		if err := w.Write(escapeRow(row)); err != nil {
+			return nil, err
+		}
+	}
+
+	w.Flush()
+
+	return buf.Bytes(), w.Error()
+}
+
+// statsCSV exports the stats of the character as rows of name and value,
+// resistances are the sum of the equipped items and charms.
+func statsCSV(c *domain.Character) ([]byte, error) {
+	h := c.D2s.Header
+	a := c.D2s.Attributes
+	res := gear.ResistancesOf(gear.AllAttributes(gear.Active(c.D2s.Items, h.ActiveArms)))
+
+	rows := [][]string{
+		{"stat", "value"},
+		{"name", h.Name.String()},
+		{"class", h.Class.String()},
+		{"level", strconv.Itoa(int(h.Level))},
+		{"experience", strconv.FormatUint(a.Experience, 10)},
+		{"strength", strconv.FormatUint(a.Strength, 10)},
+		{"dexterity", strconv.FormatUint(a.Dexterity, 10)},
+		{"vitality", strconv.FormatUint(a.Vitality, 10)},
+		{"energy", strconv.FormatUint(a.Energy, 10)},
+		{"unused_stats", strconv.FormatUint(a.UnusedStats, 10)},
+		{"unused_skill_points", strconv.FormatUint(a.UnusedSkillPoints, 10)},
+		{"life", strconv.FormatUint(a.MaxHP, 10)},
+		{"mana", strconv.FormatUint(a.MaxMana, 10)},
+		{"stamina", strconv.FormatUint(a.MaxStamina, 10)},
+		{"fire_resist_items", strconv.FormatInt(res.Fire, 10)},
+		{"cold_resist_items", strconv.FormatInt(res.Cold, 10)},
+		{"lightning_resist_items", strconv.FormatInt(res.Lightning, 10)},
+		{"poison_resist_items", strconv.FormatInt(res.Poison, 10)},
+		{"gold", strconv.FormatUint(a.Gold, 10)},
+		{"stashed_gold", strconv.FormatUint(a.StashedGold, 10)},
+	}
+
+	for i := range rows {
+		rows[i] = escapeRow(rows[i])
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.WriteAll(rows); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// escapeRow escapes every cell of the row that a spreadsheet would evaluate as
+// a formula by prefixing it with a quote, the names and properties of items come
+// from the save files so they can't be trusted. Numbers, such as negative
+// resistances, are left as they are.
+func escapeRow(row []string) []string {
+	for i, cell := range row {
+		if cell == "" || !strings.ContainsAny(cell[:1], "=+-@\t\r") {
+			continue
+		}
+
+		if _, err := strconv.ParseFloat(cell, 64); err == nil {
+			continue
+		}
+
+		row[i] = "'" + cell
+	}
+
+	return row
+}
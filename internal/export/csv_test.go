@@ -0,0 +1,38 @@
+package export
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEscapeRow(t *testing.T) {
+	row := escapeRow([]string{
+		"=HYPERLINK(\"http://evil\")",
+		"+1+1",
+		"-1+cmd|' /C calc'!A0",
+		"@SUM(A1:A2)",
+		"\t=1",
+		"\r=1",
+		"-10",
+		"+5",
+		"Harlequin Crest",
+		"",
+	})
+
+	want := []string{
+		"'=HYPERLINK(\"http://evil\")",
+		"'+1+1",
+		"'-1+cmd|' /C calc'!A0",
+		"'@SUM(A1:A2)",
+		"'\t=1",
+		"'\r=1",
+		"-10",
+		"+5",
+		"Harlequin Crest",
+		"",
+	}
+
+	if !reflect.DeepEqual(row, want) {
+		t.Errorf("escapeRow() = %q, want %q", row, want)
+	}
+}
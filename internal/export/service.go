@@ -0,0 +1,78 @@
+package export
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+)
+
+//go:generate moq -out ./service_mocks.go . characterService
+
+// characterService is the interface representation of the characters
+// the service export.
+type characterService interface {
+	Parse(ctx context.Context, name string) (*domain.Character, error)
+}
+
+// Files that can be exported.
+const (
+	FileItemsCSV    = "items.csv"
+	FileStatsCSV    = "stats.csv"
+	FileBuildJSON   = "build.json"
+	FileTooltipText = "tooltip.txt"
+)
+
+var contentTypes = map[string]string{
+	FileItemsCSV:    "text/csv; charset=utf-8",
+	FileStatsCSV:    "text/csv; charset=utf-8",
+	FileBuildJSON:   "application/json; charset=utf-8",
+	FileTooltipText: "text/plain; charset=utf-8",
+}
+
+// Service exports characters for third party tools.
+type Service struct {
+	characterService characterService
+}
+
+// Export will export the character to the given file.
+func (s Service) Export(ctx context.Context, name string, file string) (*domain.Export, error) {
+	contentType, ok := contentTypes[file]
+	if !ok {
+		return nil, fmt.Errorf("unknown export %s: %w", file, domain.ErrRequest)
+	}
+
+	character, err := s.characterService.Parse(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var body []byte
+	switch file {
+	case FileItemsCSV:
+		body, err = itemsCSV(character)
+	case FileStatsCSV:
+		body, err = statsCSV(character)
+	case FileBuildJSON:
+		body, err = build(character)
+	case FileTooltipText:
+		body = tooltips(character)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.Export{
+		Filename:    fmt.Sprintf("%s-%s", domain.NormalizeName(character.ID), file),
+		ContentType: contentType,
+		Body:        body,
+		LastParsed:  character.LastParsed,
+	}, nil
+}
+
+// NewService constructs a new export service with all the dependencies.
+func NewService(characterService characterService) *Service {
+	return &Service{
+		characterService: characterService,
+	}
+}
@@ -0,0 +1,156 @@
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+	"github.com/nokka/d2-armory-api/internal/gear/geartest"
+	"github.com/nokka/d2s"
+)
+
+func TestExport(t *testing.T) {
+	var header d2s.Header
+	copy(header.Name[:], "nokka")
+	header.Class = d2s.Sorceress
+	header.Level = 90
+	header.Status = 0x24
+
+	character := &d2s.Character{
+		Header: header,
+		Attributes: d2s.Attributes{
+			Strength: 156,
+			MaxHP:    1024,
+		},
+		Skills: []d2s.Skill{
+			{ID: 54, Name: "Teleport", Points: 1},
+			{ID: 59, Name: "Blizzard", Points: 0},
+		},
+		Items: []d2s.Item{
+			geartest.Item(t, `{"location_id": 1, "equipped_id": 1, "type": "uap", "type_name": "Shako", "quality": 7, "unique_name": "Harlequin Crest",
+				"defense_rating": 141, "max_durability": 12, "current_durability": 12,
+				"magic_attributes": [{"id": 127, "name": "+{0} to All Skills", "values": [2]}]}`),
+			geartest.Item(t, `{"location_id": 1, "equipped_id": 3, "type": "uui", "type_name": "Dusk Shroud", "quality": 2, "runeword_name": "Enigma",
+				"ethereal": 0, "total_nr_of_sockets": 3,
+				"runeword_attributes": [{"id": 97, "name": "+{1} to {0}", "values": [54, 1]}],
+				"socketed_items": [{"location_id": 6, "type": "r31", "type_name": "Jah Rune"}]}`),
+			geartest.Item(t, `{"location_id": 0, "alt_position_id": 1, "type": "cm3", "type_name": "Grand Charm", "quality": 4,
+				"magic_prefix_name": "Burning", "magic_attributes": [{"id": 188, "name": "+{2} to {0} Skills ({1} Only)", "values": [0, 1, 1]}]}`),
+			geartest.Item(t, `{"location_id": 0, "alt_position_id": 5, "type": "r33", "type_name": "Zod Rune"}`),
+		},
+	}
+
+	characterService := &characterServiceMock{
+		ParseFunc: func(ctx context.Context, name string) (*domain.Character, error) {
+			return &domain.Character{ID: name, D2s: character}, nil
+		},
+	}
+
+	s := NewService(characterService)
+
+	tests := []struct {
+		name                string
+		file                string
+		expectedContentType string
+		expectedContains    []string
+		expectedError       error
+	}{
+		{
+			name:                "items",
+			file:                FileItemsCSV,
+			expectedContentType: "text/csv; charset=utf-8",
+			expectedContains: []string{
+				"location,name,base,code,quality,ethereal,sockets,socketed,item_level,defense,properties",
+				"head,Harlequin Crest,Shako,uap,Unique,false,0,,0,141,'+2 to All Skills",
+				"torso,Enigma,Dusk Shroud,uui,Normal,false,3,Jah Rune,0,0,'+1 to Teleport",
+				"stash,Zod Rune,Zod Rune,r33",
+			},
+		},
+		{
+			name:                "stats",
+			file:                FileStatsCSV,
+			expectedContentType: "text/csv; charset=utf-8",
+			expectedContains:    []string{"stat,value", "name,nokka", "strength,156", "life,1024"},
+		},
+		{
+			name:                "build",
+			file:                FileBuildJSON,
+			expectedContentType: "application/json; charset=utf-8",
+			expectedContains:    []string{`"format": "d2-armory-build"`, `"version": 1`, `"name": "Teleport"`, `"slot": "inventory"`},
+		},
+		{
+			name:                "tooltip",
+			file:                FileTooltipText,
+			expectedContentType: "text/plain; charset=utf-8",
+			expectedContains: []string{
+				"[head]\nHarlequin Crest\nShako\nDefense: 141\nDurability: 12 of 12\n+2 to All Skills\n",
+				"Burning Grand Charm\nGrand Charm\n+1 to Fire Skills (Sorceress Only)\n",
+				"Socketed (3)\n",
+			},
+		},
+		{
+			name:          "unknown file",
+			file:          "items.xls",
+			expectedError: domain.ErrRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			export, err := s.Export(context.Background(), "nokka", tt.file)
+			if !errors.Is(err, tt.expectedError) {
+				t.Fatalf("expected error %v, got %v", tt.expectedError, err)
+			}
+
+			if tt.expectedError != nil {
+				return
+			}
+
+			if export.Filename != "nokka-"+tt.file {
+				t.Errorf("expected filename nokka-%s, got %s", tt.file, export.Filename)
+			}
+
+			if export.ContentType != tt.expectedContentType {
+				t.Errorf("expected content type %s, got %s", tt.expectedContentType, export.ContentType)
+			}
+
+			for _, s := range tt.expectedContains {
+				if !strings.Contains(string(export.Body), s) {
+					t.Errorf("expected export to contain %q, got:\n%s", s, export.Body)
+				}
+			}
+		})
+	}
+}
+
+func TestBuildRoundTrip(t *testing.T) {
+	var header d2s.Header
+	copy(header.Name[:], "nokka")
+	header.Class = d2s.Sorceress
+	header.MercID = 1
+	header.MercType = 14
+
+	body, err := build(&domain.Character{D2s: &d2s.Character{
+		Header:    header,
+		MercItems: []d2s.Item{{LocationID: 1, EquippedID: 4, RunewordName: "Insight"}},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var b Build
+	if err := json.Unmarshal(body, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if b.Character.Name != "nokka" || b.Character.Class != "Sorceress" {
+		t.Errorf("unexpected character %+v", b.Character)
+	}
+
+	if b.Mercenary == nil || len(b.Mercenary.Items) != 1 || b.Mercenary.Items[0].Name != "Insight" {
+		t.Errorf("unexpected mercenary %+v", b.Mercenary)
+	}
+}
@@ -0,0 +1,64 @@
+package export
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+	"github.com/nokka/d2-armory-api/internal/gear"
+	"github.com/nokka/d2s"
+)
+
+// tooltips exports the equipped items and charms as in game item descriptions.
+func tooltips(c *domain.Character) []byte {
+	var buf bytes.Buffer
+
+	for i, item := range gear.Active(c.D2s.Items, c.D2s.Header.ActiveArms) {
+		if i > 0 {
+			buf.WriteString("\n")
+		}
+
+		tooltip(&buf, item)
+	}
+
+	return buf.Bytes()
+}
+
+// tooltip writes the description of a single item the way the game shows it.
+func tooltip(buf *bytes.Buffer, item d2s.Item) {
+	fmt.Fprintf(buf, "[%s]\n", gear.Location(item))
+
+	name := gear.ItemName(item)
+	fmt.Fprintln(buf, name)
+	if name != item.TypeName {
+		fmt.Fprintln(buf, item.TypeName)
+	}
+
+	if item.DefenseRating > 0 {
+		fmt.Fprintf(buf, "Defense: %d\n", item.DefenseRating)
+	}
+
+	if item.MaxDurability > 0 {
+		fmt.Fprintf(buf, "Durability: %d of %d\n", item.CurrentDurability, item.MaxDurability)
+	}
+
+	for _, p := range properties(item) {
+		fmt.Fprintln(buf, p)
+	}
+
+	for _, s := range item.SocketedItems {
+		for _, p := range properties(s) {
+			fmt.Fprintln(buf, p)
+		}
+	}
+
+	if item.Ethereal == 1 {
+		if item.TotalNrOfSockets > 0 {
+			fmt.Fprintf(buf, "Ethereal (Cannot be Repaired), Socketed (%d)\n", item.TotalNrOfSockets)
+		} else {
+			fmt.Fprintln(buf, "Ethereal (Cannot be Repaired)")
+		}
+	} else if item.TotalNrOfSockets > 0 {
+		fmt.Fprintf(buf, "Socketed (%d)\n", item.TotalNrOfSockets)
+	}
+}
@@ -0,0 +1,196 @@
+// Package gear derives stats from the items characters and mercenaries wear.
+package gear
+
+import (
+	"github.com/nokka/d2-armory-api/internal/domain"
+	"github.com/nokka/d2s"
+)
+
+// Item location and equipped slot IDs in the d2s format.
+const (
+	locationStored   = 0
+	locationEquipped = 1
+	locationBelt     = 2
+	locationCursor   = 4
+	locationSocketed = 6
+	storedInventory  = 1
+
+	slotRightHand     = 4
+	slotLeftHand      = 5
+	slotRightHandSwap = 11
+	slotLeftHandSwap  = 12
+)
+
+// WeaponSetPrimary is the active arms of characters wielding their primary weapons,
+// and of mercenaries which have no weapon swap.
+const WeaponSetPrimary = 0
+
+// Attribute IDs of the magical properties used to derive stats.
+const (
+	AttrFireResist           = 39
+	AttrLightningResist      = 41
+	AttrColdResist           = 43
+	AttrPoisonResist         = 45
+	AttrLifeSteal            = 60
+	AttrManaSteal            = 62
+	AttrGoldFind             = 79
+	AttrMagicFind            = 80
+	AttrClassSkills          = 83
+	AttrIncreasedAttackSpeed = 93
+	AttrFasterRunWalk        = 96
+	AttrOSkill               = 97
+	AttrFasterHitRecovery    = 99
+	AttrFasterBlockRate      = 102
+	AttrFasterCastRate       = 105
+	AttrSingleSkill          = 107
+	AttrAllSkills            = 127
+	AttrCrushingBlow         = 136
+	AttrDeadlyStrike         = 141
+	AttrAura                 = 151
+	AttrSkillTab             = 188
+)
+
+// Attribute is a single magical property of an item.
+type Attribute struct {
+	ID     uint64  `json:"id"`
+	Name   string  `json:"name"`
+	Values []int64 `json:"values"`
+}
+
+// Equipped returns the equipped items, only the weapons of the active weapon set,
+// activeArms of the header, are included since they're the ones granting their
+// properties. The weapons of the secondary set are returned in the hand slots when
+// it's the active one, the way they're wielded.
+func Equipped(items []d2s.Item, activeArms uint32) []d2s.Item {
+	swapped := activeArms != WeaponSetPrimary
+
+	equipped := make([]d2s.Item, 0)
+	for _, item := range items {
+		if item.LocationID != locationEquipped {
+			continue
+		}
+
+		switch item.EquippedID {
+		case slotRightHand, slotLeftHand:
+			if swapped {
+				continue
+			}
+		case slotRightHandSwap:
+			if !swapped {
+				continue
+			}
+			item.EquippedID = slotRightHand
+		case slotLeftHandSwap:
+			if !swapped {
+				continue
+			}
+			item.EquippedID = slotLeftHand
+		}
+
+		equipped = append(equipped, item)
+	}
+
+	return equipped
+}
+
+// charmTypes are the item types of charms, granting their properties from the inventory.
+var charmTypes = map[string]struct{}{
+	"cm1": {},
+	"cm2": {},
+	"cm3": {},
+}
+
+// Active returns the items granting their properties, the equipped items of the
+// active weapon set and the charms carried in the inventory.
+func Active(items []d2s.Item, activeArms uint32) []d2s.Item {
+	active := Equipped(items, activeArms)
+	for _, item := range items {
+		if _, ok := charmTypes[item.Type]; !ok {
+			continue
+		}
+
+		if item.LocationID == locationStored && item.AltPositionID == storedInventory {
+			active = append(active, item)
+		}
+	}
+
+	return active
+}
+
+// Attributes returns every magical property of the item, including its
+// runeword and the items socketed into it.
+func Attributes(item d2s.Item) []Attribute {
+	attrs := make([]Attribute, 0, len(item.MagicAttributes)+len(item.RunewordAttributes))
+
+	for _, a := range item.MagicAttributes {
+		attrs = append(attrs, Attribute{ID: a.ID, Name: a.Name, Values: a.Values})
+	}
+
+	for _, a := range item.RunewordAttributes {
+		attrs = append(attrs, Attribute{ID: a.ID, Name: a.Name, Values: a.Values})
+	}
+
+	for _, socketed := range item.SocketedItems {
+		attrs = append(attrs, Attributes(socketed)...)
+	}
+
+	return attrs
+}
+
+// AllAttributes returns the magical properties of all the items.
+func AllAttributes(items []d2s.Item) []Attribute {
+	attrs := make([]Attribute, 0)
+	for _, item := range items {
+		attrs = append(attrs, Attributes(item)...)
+	}
+
+	return attrs
+}
+
+// Sum returns the sum of the first value of every attribute with the ID.
+func Sum(attrs []Attribute, id uint64) int64 {
+	var sum int64
+	for _, a := range attrs {
+		if a.ID == id && len(a.Values) > 0 {
+			sum += a.Values[0]
+		}
+	}
+
+	return sum
+}
+
+// ResistancesOf returns the resistances granted by the attributes.
+func ResistancesOf(attrs []Attribute) domain.Resistances {
+	return domain.Resistances{
+		Fire:      Sum(attrs, AttrFireResist),
+		Cold:      Sum(attrs, AttrColdResist),
+		Lightning: Sum(attrs, AttrLightningResist),
+		Poison:    Sum(attrs, AttrPoisonResist),
+	}
+}
+
+// AurasOf returns the auras granted by the attributes, only the highest
+// level of each aura applies.
+func AurasOf(attrs []Attribute) []domain.Aura {
+	auras := make([]domain.Aura, 0)
+	index := make(map[int]int)
+
+	for _, a := range attrs {
+		if a.ID != AttrAura || len(a.Values) < 2 {
+			continue
+		}
+
+		skill, level := int(a.Values[0]), int(a.Values[1])
+		if i, ok := index[skill]; ok {
+			if level > auras[i].Level {
+				auras[i].Level = level
+			}
+			continue
+		}
+
+		index[skill] = len(auras)
+		auras = append(auras, domain.Aura{SkillID: skill, Name: SkillName(skill), Level: level})
+	}
+
+	return auras
+}
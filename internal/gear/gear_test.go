@@ -0,0 +1,112 @@
+package gear
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/nokka/d2s"
+)
+
+func TestEquipped(t *testing.T) {
+	items := []d2s.Item{
+		{Type: "uap", LocationID: locationEquipped, EquippedID: 1},
+		{Type: "7cr", LocationID: locationEquipped, EquippedID: slotRightHand},
+		{Type: "uit", LocationID: locationEquipped, EquippedID: slotLeftHand},
+		{Type: "obf", LocationID: locationEquipped, EquippedID: slotRightHandSwap},
+		{Type: "ne9", LocationID: locationEquipped, EquippedID: slotLeftHandSwap},
+		{Type: "r33", LocationID: locationStored, AltPositionID: storedInventory},
+		{Type: "cm3", LocationID: locationStored, AltPositionID: storedInventory},
+	}
+
+	type slot struct {
+		Type string
+		ID   uint64
+	}
+
+	slots := func(items []d2s.Item) []slot {
+		s := make([]slot, 0, len(items))
+		for _, item := range items {
+			s = append(s, slot{Type: item.Type, ID: item.EquippedID})
+		}
+		return s
+	}
+
+	tests := []struct {
+		name       string
+		activeArms uint32
+		equipped   []slot
+		active     []slot
+	}{
+		{
+			name:       "primary weapons",
+			activeArms: WeaponSetPrimary,
+			equipped:   []slot{{"uap", 1}, {"7cr", slotRightHand}, {"uit", slotLeftHand}},
+			active:     []slot{{"uap", 1}, {"7cr", slotRightHand}, {"uit", slotLeftHand}, {"cm3", 0}},
+		},
+		{
+			name:       "weapon swap",
+			activeArms: 1,
+			equipped:   []slot{{"uap", 1}, {"obf", slotRightHand}, {"ne9", slotLeftHand}},
+			active:     []slot{{"uap", 1}, {"obf", slotRightHand}, {"ne9", slotLeftHand}, {"cm3", 0}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := slots(Equipped(items, tt.activeArms)); !reflect.DeepEqual(got, tt.equipped) {
+				t.Errorf("Equipped() = %v, want %v", got, tt.equipped)
+			}
+
+			if got := slots(Active(items, tt.activeArms)); !reflect.DeepEqual(got, tt.active) {
+				t.Errorf("Active() = %v, want %v", got, tt.active)
+			}
+		})
+	}
+
+	// Wielded swap weapons are located in the hands.
+	if got := Location(Equipped(items, 1)[1]); got != slotNames[slotRightHand] {
+		t.Errorf("Location() = %q, want %q", got, slotNames[slotRightHand])
+	}
+
+	// The items of the caller are left untouched.
+	if items[3].EquippedID != slotRightHandSwap || items[4].EquippedID != slotLeftHandSwap {
+		t.Error("expected the swap weapons of the caller to keep their slots")
+	}
+}
+
+func TestResistancesOf(t *testing.T) {
+	attrs := []Attribute{
+		{ID: AttrFireResist, Values: []int64{30}},
+		{ID: AttrFireResist, Values: []int64{-10}},
+		{ID: AttrColdResist, Values: []int64{15}},
+		{ID: AttrLightningResist, Values: []int64{}},
+		{ID: AttrMagicFind, Values: []int64{50}},
+	}
+
+	got := ResistancesOf(attrs)
+	if got.Fire != 20 || got.Cold != 15 || got.Lightning != 0 || got.Poison != 0 {
+		t.Errorf("ResistancesOf() = %+v", got)
+	}
+}
+
+func TestAurasOf(t *testing.T) {
+	attrs := []Attribute{
+		{ID: AttrAura, Values: []int64{120, 12}},
+		{ID: AttrAura, Values: []int64{120, 17}},
+		{ID: AttrAura, Values: []int64{123, 1}},
+		{ID: AttrAura, Values: []int64{123}},
+	}
+
+	got := AurasOf(attrs)
+	if len(got) != 2 {
+		t.Fatalf("expected 2 auras, got = %+v", got)
+	}
+
+	if got[0].SkillID != 120 || got[0].Level != 17 {
+		t.Errorf("expected only the highest level of an aura to apply, got = %+v", got[0])
+	}
+
+	if got[1].SkillID != 123 || got[1].Level != 1 {
+		t.Errorf("unexpected aura = %+v", got[1])
+	}
+}
@@ -0,0 +1,21 @@
+// Package geartest provides item fixtures for the tests of packages deriving stats from gear.
+package geartest
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/nokka/d2s"
+)
+
+// Item builds an item the way it's stored as JSON, the attribute type of d2s is unexported.
+func Item(t testing.TB, doc string) d2s.Item {
+	t.Helper()
+
+	var i d2s.Item
+	if err := json.Unmarshal([]byte(doc), &i); err != nil {
+		t.Fatal(err)
+	}
+
+	return i
+}
@@ -0,0 +1,162 @@
+package gear
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/nokka/d2s"
+)
+
+// classNames are the classes in the order of the class numbers of d2s.
+var classNames = []string{
+	"Amazon",
+	"Sorceress",
+	"Necromancer",
+	"Paladin",
+	"Barbarian",
+	"Druid",
+	"Assassin",
+}
+
+// tabNames are the skill tabs of each class, in the order items refer to them.
+var tabNames = [][3]string{
+	{"Bow and Crossbow", "Passive and Magic", "Javelin and Spear"},
+	{"Fire", "Lightning", "Cold"},
+	{"Curses", "Poison and Bone", "Summoning"},
+	{"Combat", "Offensive Auras", "Defensive Auras"},
+	{"Combat", "Combat Masteries", "Warcries"},
+	{"Summoning", "Shape Shifting", "Elemental"},
+	{"Traps", "Shadow Disciplines", "Martial Arts"},
+}
+
+// qualityNames are the item qualities of d2s.
+var qualityNames = map[uint64]string{
+	1: "Low Quality",
+	2: "Normal",
+	3: "Superior",
+	4: "Magic",
+	5: "Set",
+	6: "Rare",
+	7: "Unique",
+	8: "Crafted",
+}
+
+// ClassName returns the name of the class with the d2s class number.
+func ClassName(class int) string {
+	if class < 0 || class >= len(classNames) {
+		return ""
+	}
+
+	return classNames[class]
+}
+
+// TabName returns the name of the skill tab of the class.
+func TabName(class int, tab int) string {
+	if class < 0 || class >= len(tabNames) || tab < 0 || tab > 2 {
+		return ""
+	}
+
+	return tabNames[class][tab]
+}
+
+// QualityName returns the name of the item quality.
+func QualityName(quality uint64) string {
+	return qualityNames[quality]
+}
+
+// ItemName returns the name of the item as shown in game.
+func ItemName(item d2s.Item) string {
+	switch {
+	case item.RunewordName != "":
+		return item.RunewordName
+	case item.UniqueName != "":
+		return item.UniqueName
+	case item.SetName != "":
+		return item.SetName
+	case item.RareName != "":
+		return strings.TrimSpace(item.RareName + " " + item.RareName2)
+	case item.MagicPrefixName != "" || item.MagicSuffixName != "":
+		return strings.TrimSpace(item.MagicPrefixName + " " + item.TypeName + " " + item.MagicSuffixName)
+	}
+
+	return item.TypeName
+}
+
+// Describe returns the description of the attribute as shown in game, with
+// the values in place of the placeholders of its name.
+func Describe(a Attribute) string {
+	values := make([]string, len(a.Values))
+	for i, v := range a.Values {
+		values[i] = strconv.FormatInt(v, 10)
+	}
+
+	// Some values refer to classes, skills and skill tabs rather than being shown as is.
+	switch a.ID {
+	case AttrClassSkills:
+		if len(values) > 0 {
+			values[0] = ClassName(int(a.Values[0]))
+		}
+	case AttrOSkill, AttrSingleSkill, AttrAura:
+		if len(values) > 0 {
+			values[0] = SkillName(int(a.Values[0]))
+		}
+	case AttrSkillTab:
+		if len(values) > 1 {
+			class := int(a.Values[1]) & 0x07
+			values[0] = TabName(class, int(a.Values[0]))
+			values[1] = ClassName(class)
+		}
+	}
+
+	desc := a.Name
+	for i, v := range values {
+		desc = strings.Replace(desc, fmt.Sprintf("{%d}", i), v, -1)
+	}
+
+	return desc
+}
+
+// slotNames are the equipped slots of d2s.
+var slotNames = map[uint64]string{
+	1:  "head",
+	2:  "neck",
+	3:  "torso",
+	4:  "right_hand",
+	5:  "left_hand",
+	6:  "right_ring",
+	7:  "left_ring",
+	8:  "belt",
+	9:  "feet",
+	10: "gloves",
+	11: "right_hand_swap",
+	12: "left_hand_swap",
+}
+
+// storageNames are where stored items are kept.
+var storageNames = map[uint64]string{
+	1: "inventory",
+	4: "cube",
+	5: "stash",
+}
+
+// Location returns where the item is, the equipped slot for equipped items.
+func Location(item d2s.Item) string {
+	switch item.LocationID {
+	case locationStored:
+		if name, ok := storageNames[item.AltPositionID]; ok {
+			return name
+		}
+		return "stored"
+	case locationEquipped:
+		return slotNames[item.EquippedID]
+	case locationBelt:
+		return "belt"
+	case locationCursor:
+		return "cursor"
+	case locationSocketed:
+		return "socketed"
+	}
+
+	return ""
+}
@@ -0,0 +1,237 @@
+package grail
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+	"github.com/nokka/d2s"
+)
+
+//go:generate moq -out ./service_mocks.go . grailRepository
+
+// grailRepository is the interface representation of the data layer
+// the service depend on.
+type grailRepository interface {
+	Record(ctx context.Context, entries []domain.GrailEntry) error
+	Find(ctx context.Context, account string, realm string) ([]domain.GrailEntry, error)
+}
+
+// Totals of each kind of item in the game, the completion is measured against them.
+const (
+	totalUniques   = 381
+	totalSets      = 127
+	totalRunes     = 33
+	totalRunewords = 78
+)
+
+// Service tracks the Holy Grail of accounts.
+type Service struct {
+	repository grailRepository
+	now        func() time.Time
+}
+
+// CharacterPersisted records the items of the character the account hasn't found
+// before, items stay on the grail once they're traded away. Characters are only
+// tracked when the account and realm they belong to is known.
+func (s Service) CharacterPersisted(ctx context.Context, previous *domain.Character, current *domain.Character) error {
+	if current == nil || current.D2s == nil || current.Info == nil || current.Info.Account == "" {
+		return nil
+	}
+
+	found := Found(current.D2s)
+	if len(found) == 0 {
+		return nil
+	}
+
+	now := s.now().UTC().Truncate(time.Millisecond)
+	account := domain.NormalizeName(current.Info.Account)
+
+	entries := make([]domain.GrailEntry, 0, len(found))
+	for _, f := range found {
+		entries = append(entries, domain.GrailEntry{
+			Account:   account,
+			Realm:     current.Info.Realm,
+			Kind:      f.Kind,
+			Name:      f.Name,
+			Character: current.ID,
+			FoundAt:   now,
+		})
+	}
+
+	return s.repository.Record(ctx, entries)
+}
+
+// Get will get the Holy Grail of the account on the realm, or on every realm
+// when no realm is given. Characters in exclude are left out as finders.
+func (s Service) Get(ctx context.Context, account string, realm string, exclude map[string]struct{}) (*domain.Grail, error) {
+	account = domain.NormalizeName(account)
+	if account == "" {
+		return nil, fmt.Errorf("account is required: %w", domain.ErrRequest)
+	}
+
+	entries, err := s.repository.Find(ctx, account, realm)
+	if err != nil {
+		return nil, err
+	}
+
+	// The first find of each item, an item may have been found on several realms.
+	first := make(map[string]domain.GrailEntry, len(entries))
+	for _, e := range entries {
+		if _, ok := exclude[e.Character]; ok {
+			e.Character = ""
+		}
+
+		key := e.Kind + "/" + e.Name
+		if f, ok := first[key]; !ok || e.FoundAt.Before(f.FoundAt) {
+			first[key] = e
+		}
+	}
+
+	grail := &domain.Grail{
+		Account:   account,
+		Realm:     realm,
+		Uniques:   category(totalUniques),
+		Sets:      category(totalSets),
+		Runes:     category(totalRunes),
+		Runewords: category(totalRunewords),
+	}
+
+	categories := map[string]*domain.GrailCategory{
+		domain.GrailUnique:   &grail.Uniques,
+		domain.GrailSet:      &grail.Sets,
+		domain.GrailRune:     &grail.Runes,
+		domain.GrailRuneword: &grail.Runewords,
+	}
+
+	for _, e := range first {
+		c, ok := categories[e.Kind]
+		if !ok {
+			continue
+		}
+
+		c.Items = append(c.Items, e)
+	}
+
+	for _, c := range categories {
+		sort.Slice(c.Items, func(i, j int) bool {
+			return c.Items[i].FoundAt.Before(c.Items[j].FoundAt) ||
+				(c.Items[i].FoundAt.Equal(c.Items[j].FoundAt) && c.Items[i].Name < c.Items[j].Name)
+		})
+
+		c.Found = len(c.Items)
+		c.Completion = completion(c.Found, c.Total)
+
+		grail.Found += c.Found
+		grail.Total += c.Total
+	}
+
+	grail.Completion = completion(grail.Found, grail.Total)
+
+	return grail, nil
+}
+
+// Item is an item tracked by the Holy Grail.
+type Item struct {
+	Kind string
+	Name string
+}
+
+// Found returns the distinct grail items of the character, wherever they are,
+// including the items socketed into others and the items of the mercenary.
+func Found(c *d2s.Character) []Item {
+	seen := make(map[Item]struct{})
+
+	var walk func(items []d2s.Item)
+	walk = func(items []d2s.Item) {
+		for _, item := range items {
+			for _, i := range grailItems(item) {
+				seen[i] = struct{}{}
+			}
+
+			walk(item.SocketedItems)
+		}
+	}
+
+	walk(c.Items)
+	walk(c.CorpseItems)
+	walk(c.MercItems)
+	if c.GolemItem != nil {
+		walk([]d2s.Item{*c.GolemItem})
+	}
+
+	found := make([]Item, 0, len(seen))
+	for i := range seen {
+		found = append(found, i)
+	}
+
+	sort.Slice(found, func(i, j int) bool {
+		if found[i].Kind != found[j].Kind {
+			return found[i].Kind < found[j].Kind
+		}
+		return found[i].Name < found[j].Name
+	})
+
+	return found
+}
+
+// grailItems returns what the item counts as, a runeword is counted as well
+// as the runes socketed into it.
+func grailItems(item d2s.Item) []Item {
+	var items []Item
+
+	switch {
+	case item.UniqueName != "":
+		items = append(items, Item{Kind: domain.GrailUnique, Name: item.UniqueName})
+	case item.SetName != "":
+		items = append(items, Item{Kind: domain.GrailSet, Name: item.SetName})
+	case isRune(item.Type):
+		items = append(items, Item{Kind: domain.GrailRune, Name: item.TypeName})
+	}
+
+	if item.RunewordName != "" {
+		items = append(items, Item{Kind: domain.GrailRuneword, Name: item.RunewordName})
+	}
+
+	return items
+}
+
+// isRune returns true for the item codes of runes, r01 (El) to r33 (Zod).
+func isRune(code string) bool {
+	code = strings.TrimSpace(code)
+	if len(code) != 3 || code[0] != 'r' {
+		return false
+	}
+
+	n := int(code[1]-'0')*10 + int(code[2]-'0')
+
+	return code[1] >= '0' && code[1] <= '9' && code[2] >= '0' && code[2] <= '9' && n >= 1 && n <= totalRunes
+}
+
+func category(total int) domain.GrailCategory {
+	return domain.GrailCategory{
+		Total: total,
+		Items: make([]domain.GrailEntry, 0),
+	}
+}
+
+// completion returns the percentage found, with two decimals.
+func completion(found int, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+
+	return math.Round(float64(found)/float64(total)*10000) / 100
+}
+
+// NewService constructs a new grail service with all the dependencies.
+func NewService(repository grailRepository) *Service {
+	return &Service{
+		repository: repository,
+		now:        time.Now,
+	}
+}
@@ -0,0 +1,135 @@
+package grail
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+	"github.com/nokka/d2s"
+)
+
+func TestFound(t *testing.T) {
+	c := &d2s.Character{
+		Items: []d2s.Item{
+			{Type: "uap", UniqueName: "Harlequin Crest"},
+			{Type: "uui", RunewordName: "Enigma", SocketedItems: []d2s.Item{
+				{Type: "r31", TypeName: "Jah Rune"},
+				{Type: "r06", TypeName: "Ith Rune"},
+				{Type: "r30", TypeName: "Ber Rune"},
+			}},
+			{Type: "r31", TypeName: "Jah Rune"},
+			{Type: "rin", TypeName: "Ring"},
+			{Type: "rvl", TypeName: "Full Rejuvenation Potion"},
+		},
+		MercItems: []d2s.Item{
+			{Type: "xar", SetName: "Tal Rasha's Guardianship"},
+		},
+	}
+
+	expected := []Item{
+		{Kind: domain.GrailRune, Name: "Ber Rune"},
+		{Kind: domain.GrailRune, Name: "Ith Rune"},
+		{Kind: domain.GrailRune, Name: "Jah Rune"},
+		{Kind: domain.GrailRuneword, Name: "Enigma"},
+		{Kind: domain.GrailSet, Name: "Tal Rasha's Guardianship"},
+		{Kind: domain.GrailUnique, Name: "Harlequin Crest"},
+	}
+
+	if found := Found(c); !reflect.DeepEqual(found, expected) {
+		t.Errorf("expected %v, got %v", expected, found)
+	}
+}
+
+func TestCharacterPersisted(t *testing.T) {
+	now := time.Date(2021, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	repository := &grailRepositoryMock{
+		RecordFunc: func(ctx context.Context, entries []domain.GrailEntry) error {
+			return nil
+		},
+	}
+
+	s := NewService(repository)
+	s.now = func() time.Time { return now }
+
+	items := []d2s.Item{{Type: "uap", UniqueName: "Harlequin Crest"}}
+
+	// Without realm metadata the owning account isn't known.
+	err := s.CharacterPersisted(context.Background(), nil, &domain.Character{ID: "nokka", D2s: &d2s.Character{Items: items}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(repository.RecordCalls()) != 0 {
+		t.Fatalf("expected nothing to be recorded without an account")
+	}
+
+	err = s.CharacterPersisted(context.Background(), nil, &domain.Character{
+		ID:   "nokka",
+		D2s:  &d2s.Character{Items: items},
+		Info: &domain.CharacterInfo{Account: "Nokka", Realm: "Slashdiablo"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []domain.GrailEntry{
+		{Account: "nokka", Realm: "Slashdiablo", Kind: domain.GrailUnique, Name: "Harlequin Crest", Character: "nokka", FoundAt: now},
+	}
+
+	calls := repository.RecordCalls()
+	if len(calls) != 1 || !reflect.DeepEqual(calls[0].Entries, expected) {
+		t.Errorf("expected %v to be recorded, got %v", expected, calls)
+	}
+}
+
+func TestGetGrail(t *testing.T) {
+	first := time.Date(2021, 5, 1, 12, 0, 0, 0, time.UTC)
+	later := first.Add(time.Hour)
+
+	repository := &grailRepositoryMock{
+		FindFunc: func(ctx context.Context, account string, realm string) ([]domain.GrailEntry, error) {
+			return []domain.GrailEntry{
+				{Account: account, Realm: "east", Kind: domain.GrailUnique, Name: "Harlequin Crest", Character: "nokka", FoundAt: later},
+				{Account: account, Realm: "west", Kind: domain.GrailUnique, Name: "Harlequin Crest", Character: "hidden", FoundAt: first},
+				{Account: account, Realm: "east", Kind: domain.GrailRune, Name: "Jah Rune", Character: "nokka", FoundAt: first},
+				{Account: account, Realm: "east", Kind: domain.GrailRuneword, Name: "Enigma", Character: "nokka", FoundAt: later},
+			}, nil
+		},
+	}
+
+	s := NewService(repository)
+
+	grail, err := s.Get(context.Background(), "Nokka", "", map[string]struct{}{"hidden": {}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if grail.Found != 3 || grail.Total != 619 || grail.Completion != 0.48 {
+		t.Errorf("unexpected totals %d of %d, %v%%", grail.Found, grail.Total, grail.Completion)
+	}
+
+	if grail.Uniques.Found != 1 || grail.Uniques.Completion != 0.26 {
+		t.Errorf("unexpected uniques %d, %v%%", grail.Uniques.Found, grail.Uniques.Completion)
+	}
+
+	// The first find counts, hidden characters aren't shown as finders.
+	if u := grail.Uniques.Items[0]; !u.FoundAt.Equal(first) || u.Character != "" || u.Realm != "west" {
+		t.Errorf("unexpected first find %+v", u)
+	}
+
+	if grail.Runes.Completion != 3.03 || grail.Runewords.Completion != 1.28 || grail.Sets.Found != 0 {
+		t.Errorf("unexpected categories %+v", grail)
+	}
+
+	if calls := repository.FindCalls(); calls[0].Account != "nokka" {
+		t.Errorf("expected the account to be normalized, got %s", calls[0].Account)
+	}
+
+	if _, err := s.Get(context.Background(), "", "", nil); !errors.Is(err, domain.ErrRequest) {
+		t.Errorf("expected error %v, got %v", domain.ErrRequest, err)
+	}
+}
@@ -0,0 +1,288 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// Cost of the fields, every field costs defaultCost unless it fetches something.
+const (
+	defaultCost = 1
+	loadCost    = 10
+)
+
+// defaultListSize is the number of characters listed when no limit is given.
+const defaultListSize = 20
+
+// fieldCost is the cost of a field, the cost of the selections of list fields
+// is multiplied by the number of elements the list is expected to hold.
+type fieldCost struct {
+	cost int
+
+	// size is the expected number of elements.
+	size int
+
+	// sizeArg is the argument limiting the number of elements, when there is one.
+	sizeArg string
+}
+
+// fieldCosts are the costs of the fields that don't cost defaultCost, by type and field name.
+var fieldCosts = map[string]fieldCost{
+	"Query.character":                      {cost: loadCost},
+	"Query.account":                        {cost: loadCost},
+	"Query.characters":                     {cost: loadCost, size: defaultListSize, sizeArg: "limit"},
+	"CharacterSummary.character":           {cost: loadCost},
+	"Character.account":                    {cost: loadCost},
+	"Character.statistics":                 {cost: loadCost},
+	"Character.items":                      {cost: defaultCost, size: 50},
+	"Character.skills":                     {cost: defaultCost, size: 30},
+	"Account.characters":                   {cost: defaultCost, size: 16},
+	"Mercenary.items":                      {cost: defaultCost, size: 4},
+	"Mercenary.auras":                      {cost: defaultCost, size: 2},
+	"Item.socketed":                        {cost: defaultCost, size: 6},
+	"DifficultyStatistics.areas":           {cost: defaultCost, size: 8},
+	"DifficultyStatistics.specialMonsters": {cost: defaultCost, size: 8},
+
+	// The lists of the introspection types, sized after the schema.
+	"__Schema.types":       {cost: defaultCost, size: 40},
+	"__Schema.directives":  {cost: defaultCost, size: 4},
+	"__Type.fields":        {cost: defaultCost, size: 20},
+	"__Type.inputFields":   {cost: defaultCost, size: 10},
+	"__Type.enumValues":    {cost: defaultCost, size: 20},
+	"__Type.interfaces":    {cost: defaultCost, size: 2},
+	"__Type.possibleTypes": {cost: defaultCost, size: 4},
+	"__Field.args":         {cost: defaultCost, size: 4},
+	"__Directive.args":     {cost: defaultCost, size: 4},
+}
+
+// Limits of the introspection fields. They're resolved from the schema alone, so
+// they have a budget of their own that's large enough for the introspection query
+// of the usual tools, but not for the types nested within each other without end.
+const (
+	maxIntrospectionCost  = 250000
+	maxIntrospectionDepth = 15
+)
+
+// metaFields are the introspection fields of the query type, by name.
+var metaFields = map[string]*graphql.FieldDefinition{
+	"__schema": graphql.SchemaMetaFieldDef,
+	"__type":   graphql.TypeMetaFieldDef,
+}
+
+// usage is the cost and depth of an operation, with the introspection fields
+// counted on their own.
+type usage struct {
+	cost               int
+	depth              int
+	introspectionCost  int
+	introspectionDepth int
+}
+
+// analyze returns the cost and depth of the operation.
+func analyze(schema *graphql.Schema, doc *ast.Document, operationName string, variables map[string]interface{}) (usage, error) {
+	var operation *ast.OperationDefinition
+	fragments := make(map[string]*ast.FragmentDefinition)
+
+	for _, def := range doc.Definitions {
+		switch def := def.(type) {
+		case *ast.OperationDefinition:
+			if operationName != "" && (def.Name == nil || def.Name.Value != operationName) {
+				continue
+			}
+
+			if operation != nil {
+				return usage{}, errors.New("operation name is required when the query holds several operations")
+			}
+
+			operation = def
+		case *ast.FragmentDefinition:
+			fragments[def.Name.Value] = def
+		}
+	}
+
+	if operation == nil {
+		return usage{}, fmt.Errorf("unknown operation %s", operationName)
+	}
+
+	if operation.Operation != ast.OperationTypeQuery {
+		return usage{}, fmt.Errorf("%s operations aren't supported", operation.Operation)
+	}
+
+	a := &analyzer{
+		fragments: fragments,
+		variables: make(map[string]interface{}, len(variables)),
+	}
+
+	for name, v := range variables {
+		a.variables[name] = v
+	}
+
+	// Variables left out of the request take their default value.
+	for _, def := range operation.VariableDefinitions {
+		if _, ok := a.variables[def.Variable.Name.Value]; ok {
+			continue
+		}
+
+		if v, ok := def.DefaultValue.(*ast.IntValue); ok {
+			a.variables[def.Variable.Name.Value] = v.Value
+		}
+	}
+
+	a.usage.cost, a.usage.depth = a.selectionSet(schema.QueryType(), operation.SelectionSet)
+
+	return a.usage, nil
+}
+
+// analyzer walks the selections of an operation.
+type analyzer struct {
+	fragments map[string]*ast.FragmentDefinition
+	variables map[string]interface{}
+
+	// usage holds the introspection fields met along the way.
+	usage usage
+}
+
+// selectionSet returns the cost and depth of the selections of the parent type.
+func (a *analyzer) selectionSet(parent *graphql.Object, set *ast.SelectionSet) (cost int, depth int) {
+	if set == nil {
+		return 0, 0
+	}
+
+	for _, selection := range set.Selections {
+		var c, d int
+
+		switch s := selection.(type) {
+		case *ast.Field:
+			c, d = a.field(parent, s)
+		case *ast.FragmentSpread:
+			if f, ok := a.fragments[s.Name.Value]; ok {
+				c, d = a.selectionSet(parent, f.SelectionSet)
+			}
+		case *ast.InlineFragment:
+			c, d = a.selectionSet(parent, s.SelectionSet)
+		}
+
+		cost = addCost(cost, c)
+		if d > depth {
+			depth = d
+		}
+	}
+
+	return cost, depth
+}
+
+// field returns the cost and depth of the field and its selections, the
+// introspection fields of the query type are added to the usage instead.
+func (a *analyzer) field(parent *graphql.Object, field *ast.Field) (cost int, depth int) {
+	name := field.Name.Value
+
+	if meta, ok := metaFields[name]; ok {
+		cost, depth := a.selections(parent, meta, field)
+
+		a.usage.introspectionCost = addCost(a.usage.introspectionCost, cost)
+		if depth > a.usage.introspectionDepth {
+			a.usage.introspectionDepth = depth
+		}
+
+		return 0, 0
+	}
+
+	def, ok := parent.Fields()[name]
+	if !ok {
+		// Such as __typename.
+		return defaultCost, 1
+	}
+
+	return a.selections(parent, def, field)
+}
+
+// selections returns the cost and depth of the field defined by def and its selections.
+func (a *analyzer) selections(parent *graphql.Object, def *graphql.FieldDefinition, field *ast.Field) (cost int, depth int) {
+	name := field.Name.Value
+
+	fc, ok := fieldCosts[parent.Name()+"."+name]
+	if !ok {
+		fc = fieldCost{cost: defaultCost}
+	}
+
+	var childCost, childDepth int
+	if obj, ok := graphql.GetNamed(def.Type).(*graphql.Object); ok {
+		childCost, childDepth = a.selectionSet(obj, field.SelectionSet)
+	}
+
+	return addCost(fc.cost, mulCost(a.size(fc, field), childCost)), childDepth + 1
+}
+
+// size returns the number of elements the field is expected to hold.
+func (a *analyzer) size(fc fieldCost, field *ast.Field) int {
+	size := fc.size
+	if size == 0 {
+		size = 1
+	}
+
+	if fc.sizeArg == "" {
+		return size
+	}
+
+	for _, arg := range field.Arguments {
+		if arg.Name.Value != fc.sizeArg {
+			continue
+		}
+
+		switch v := arg.Value.(type) {
+		case *ast.IntValue:
+			size = atoi(v.Value, size)
+		case *ast.Variable:
+			switch n := a.variables[v.Name.Value].(type) {
+			case float64:
+				size = int(n)
+			case int:
+				size = n
+			case string:
+				size = atoi(n, size)
+			}
+		}
+	}
+
+	if size < 0 {
+		return 0
+	}
+
+	return size
+}
+
+// costCeiling is where costs stop adding up, far beyond any limit, to keep the
+// costs of lists nested within each other from overflowing.
+const costCeiling = 1 << 40
+
+// addCost returns the sum of the costs, at most costCeiling.
+func addCost(a int, b int) int {
+	if a+b > costCeiling {
+		return costCeiling
+	}
+
+	return a + b
+}
+
+// mulCost returns the product of the costs, at most costCeiling.
+func mulCost(a int, b int) int {
+	if a != 0 && b > costCeiling/a {
+		return costCeiling
+	}
+
+	return a * b
+}
+
+// atoi returns the integer of s, def if it isn't one.
+func atoi(s string, def int) int {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return def
+	}
+
+	return n
+}
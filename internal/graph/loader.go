@@ -0,0 +1,95 @@
+package graph
+
+import (
+	"context"
+	"sync"
+)
+
+// maxConcurrentLoads is how many keys of a batch are fetched at the same time.
+const maxConcurrentLoads = 8
+
+// fetchFunc fetches the value of a single key.
+type fetchFunc func(ctx context.Context, key string) (interface{}, error)
+
+// loader batches and caches the lookups made while resolving a single query. Keys
+// are queued as the fields referring to them are resolved, and the whole queue is
+// fetched at once when the first of the values is needed, so a list of characters
+// is fetched together rather than one at a time.
+type loader struct {
+	fetch fetchFunc
+
+	mu      sync.Mutex
+	cache   map[string]*pending
+	pending []string
+}
+
+// pending is the value of a key, done is closed once it has been fetched.
+type pending struct {
+	done  chan struct{}
+	value interface{}
+	err   error
+}
+
+// Load queues the key and returns a thunk resolving its value, the thunk is
+// what the executor calls once the sibling fields have been resolved.
+func (l *loader) Load(ctx context.Context, key string) func() (interface{}, error) {
+	l.mu.Lock()
+	p, ok := l.cache[key]
+	if !ok {
+		p = &pending{done: make(chan struct{})}
+		l.cache[key] = p
+		l.pending = append(l.pending, key)
+	}
+	l.mu.Unlock()
+
+	return func() (interface{}, error) {
+		l.dispatch(ctx)
+		<-p.done
+
+		return p.value, p.err
+	}
+}
+
+// dispatch fetches every queued key.
+func (l *loader) dispatch(ctx context.Context) {
+	l.mu.Lock()
+	keys := l.pending
+	l.pending = nil
+
+	batch := make([]*pending, len(keys))
+	for i, key := range keys {
+		batch[i] = l.cache[key]
+	}
+	l.mu.Unlock()
+
+	if len(keys) == 0 {
+		return
+	}
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, maxConcurrentLoads)
+
+	for i := range keys {
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func(key string, p *pending) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			p.value, p.err = l.fetch(ctx, key)
+			close(p.done)
+		}(keys[i], batch[i])
+	}
+
+	wg.Wait()
+}
+
+func newLoader(fetch fetchFunc) *loader {
+	return &loader{
+		fetch: fetch,
+		cache: make(map[string]*pending),
+	}
+}
@@ -0,0 +1,524 @@
+package graph
+
+import (
+	"sort"
+	"time"
+
+	"github.com/graphql-go/graphql"
+	"github.com/nokka/d2-armory-api/internal/domain"
+	"github.com/nokka/d2-armory-api/internal/gear"
+	"github.com/nokka/d2-armory-api/internal/mercenary"
+	"github.com/nokka/d2s"
+)
+
+// areaStats are the statistics of a single area.
+type areaStats struct {
+	Name        string
+	Kills       uint
+	Time        uint
+	UniqueKills uint
+	ChampKills  uint
+}
+
+// monsterKills are the kills of a single special monster.
+type monsterKills struct {
+	Name  string
+	Kills int
+}
+
+// newSchema builds the schema, the relationships between the types are resolved
+// through the loaders of the request.
+func newSchema() (graphql.Schema, error) {
+	itemType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Item",
+		Description: "An item of a character or mercenary.",
+		Fields: graphql.Fields{
+			"name": itemField(graphql.NewNonNull(graphql.String), "The name as shown in game.", func(i d2s.Item) interface{} {
+				return gear.ItemName(i)
+			}),
+			"type": itemField(graphql.NewNonNull(graphql.String), "The item code.", func(i d2s.Item) interface{} {
+				return i.Type
+			}),
+			"typeName": itemField(graphql.NewNonNull(graphql.String), "The name of the base item.", func(i d2s.Item) interface{} {
+				return i.TypeName
+			}),
+			"quality": itemField(graphql.String, "", func(i d2s.Item) interface{} {
+				return gear.QualityName(i.Quality)
+			}),
+			"location": itemField(graphql.String, "Where the item is, the equipped slot for equipped items.", func(i d2s.Item) interface{} {
+				return gear.Location(i)
+			}),
+			"level": itemField(graphql.Int, "", func(i d2s.Item) interface{} {
+				return i.Level
+			}),
+			"ethereal": itemField(graphql.NewNonNull(graphql.Boolean), "", func(i d2s.Item) interface{} {
+				return i.Ethereal != 0
+			}),
+			"sockets": itemField(graphql.NewNonNull(graphql.Int), "", func(i d2s.Item) interface{} {
+				return i.TotalNrOfSockets
+			}),
+			"defense": itemField(graphql.Int, "", func(i d2s.Item) interface{} {
+				return i.DefenseRating
+			}),
+			"quantity": itemField(graphql.Int, "", func(i d2s.Item) interface{} {
+				return i.Quantity
+			}),
+			"properties": itemField(graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.String))), "The magical properties as shown in game, including those of socketed items.", func(i d2s.Item) interface{} {
+				attrs := gear.Attributes(i)
+
+				properties := make([]string, 0, len(attrs))
+				for _, a := range attrs {
+					properties = append(properties, gear.Describe(a))
+				}
+
+				return properties
+			}),
+		},
+	})
+
+	itemType.AddFieldConfig("socketed", itemField(graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(itemType))), "The items socketed into the item.", func(i d2s.Item) interface{} {
+		return i.SocketedItems
+	}))
+
+	itemList := graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(itemType)))
+
+	attributesType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Attributes",
+		Description: "The attributes of a character, without the bonuses of items.",
+		Fields: graphql.Fields{
+			"strength":          &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"dexterity":         &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"vitality":          &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"energy":            &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"unusedStats":       &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"unusedSkillPoints": &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"currentHP":         &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"maxHP":             &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"currentMana":       &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"maxMana":           &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"currentStamina":    &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"maxStamina":        &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"gold":              &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"stashedGold":       &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+		},
+	})
+
+	skillType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Skill",
+		Description: "The points spent in a skill.",
+		Fields: graphql.Fields{
+			"id":     &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"name":   &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"points": &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+		},
+	})
+
+	resistancesType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Resistances",
+		Description: "The resistances granted by items.",
+		Fields: graphql.Fields{
+			"fire":      &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"cold":      &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"lightning": &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"poison":    &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+		},
+	})
+
+	auraType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Aura",
+		Description: "An aura granted by an item.",
+		Fields: graphql.Fields{
+			"skillId": &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"name":    &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"level":   &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+		},
+	})
+
+	mercenaryType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Mercenary",
+		Description: "The hireling of a character.",
+		Fields: graphql.Fields{
+			"class":       &graphql.Field{Type: graphql.String},
+			"variant":     &graphql.Field{Type: graphql.String},
+			"act":         &graphql.Field{Type: graphql.Int},
+			"difficulty":  &graphql.Field{Type: graphql.String},
+			"level":       &graphql.Field{Type: graphql.Int},
+			"experience":  &graphql.Field{Type: graphql.NewNonNull(graphql.Float)},
+			"dead":        &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean)},
+			"items":       &graphql.Field{Type: itemList},
+			"resistances": &graphql.Field{Type: graphql.NewNonNull(resistancesType)},
+			"auras":       &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(auraType)))},
+		},
+	})
+
+	areaType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "AreaStatistics",
+		Description: "The kills and time spent in an area.",
+		Fields: graphql.Fields{
+			"name":        &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"kills":       &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"time":        &graphql.Field{Type: graphql.NewNonNull(graphql.Int), Description: "Seconds spent in the area."},
+			"uniqueKills": &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"champKills":  &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+		},
+	})
+
+	monsterType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "MonsterKills",
+		Description: "The kills of a special monster.",
+		Fields: graphql.Fields{
+			"name":  &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"kills": &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+		},
+	})
+
+	difficultyType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "DifficultyStatistics",
+		Description: "The statistics of a single difficulty.",
+		Fields: graphql.Fields{
+			"totalKills":       &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"totalUniqueKills": &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"totalChampKills":  &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"areas": &graphql.Field{
+				Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(areaType))),
+				Description: "The areas the most time was spent in, longest first.",
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return areasOf(p.Source.(domain.Stats)), nil
+				},
+			},
+			"specialMonsters": &graphql.Field{
+				Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(monsterType))),
+				Description: "The special monsters killed the most, most kills first.",
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return monstersOf(p.Source.(domain.Stats)), nil
+				},
+			},
+		},
+	})
+
+	statisticsType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Statistics",
+		Description: "The statistics posted for a character.",
+		Fields: graphql.Fields{
+			"normal":    &graphql.Field{Type: graphql.NewNonNull(difficultyType)},
+			"nightmare": &graphql.Field{Type: graphql.NewNonNull(difficultyType)},
+			"hell":      &graphql.Field{Type: graphql.NewNonNull(difficultyType)},
+		},
+	})
+
+	accountStatsType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "AccountDifficultyStatistics",
+		Description: "The statistics of a single difficulty of all characters on an account combined.",
+		Fields: graphql.Fields{
+			"totalKills":       &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"totalUniqueKills": &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"totalChampKills":  &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+		},
+	})
+
+	accountStatisticsType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "AccountStatistics",
+		Description: "The statistics of all characters on an account combined.",
+		Fields: graphql.Fields{
+			"normal":    &graphql.Field{Type: graphql.NewNonNull(accountStatsType)},
+			"nightmare": &graphql.Field{Type: graphql.NewNonNull(accountStatsType)},
+			"hell":      &graphql.Field{Type: graphql.NewNonNull(accountStatsType)},
+		},
+	})
+
+	characterType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Character",
+		Description: "A Diablo II character.",
+		Fields: graphql.Fields{
+			"name": characterField(graphql.NewNonNull(graphql.String), "", func(c *domain.Character) interface{} {
+				return c.ID
+			}),
+			"class": characterField(graphql.NewNonNull(graphql.String), "", func(c *domain.Character) interface{} {
+				return gear.ClassName(int(c.D2s.Header.Class))
+			}),
+			"level": characterField(graphql.NewNonNull(graphql.Int), "", func(c *domain.Character) interface{} {
+				return c.D2s.Header.Level
+			}),
+			"experience": characterField(graphql.NewNonNull(graphql.Float), "", func(c *domain.Character) interface{} {
+				return float64(c.D2s.Attributes.Experience)
+			}),
+			"hardcore": characterField(graphql.NewNonNull(graphql.Boolean), "", func(c *domain.Character) interface{} {
+				return c.D2s.Header.Status.Readable().Hardcore
+			}),
+			"dead": characterField(graphql.NewNonNull(graphql.Boolean), "Whether the character has died, hardcore characters that died can't be played.", func(c *domain.Character) interface{} {
+				return c.D2s.Header.Status.Readable().Died
+			}),
+			"expansion": characterField(graphql.NewNonNull(graphql.Boolean), "", func(c *domain.Character) interface{} {
+				return c.D2s.Header.Status.Readable().Expansion
+			}),
+			"ladder": characterField(graphql.NewNonNull(graphql.Boolean), "", func(c *domain.Character) interface{} {
+				return c.D2s.Header.Status.Readable().Ladder
+			}),
+			"realm": characterField(graphql.String, "Only known when the armory runs next to a PvPGN realm.", func(c *domain.Character) interface{} {
+				if c.Info == nil {
+					return nil
+				}
+				return c.Info.Realm
+			}),
+			"lastPlayed": characterField(graphql.NewNonNull(graphql.DateTime), "", func(c *domain.Character) interface{} {
+				return time.Unix(int64(c.D2s.Header.LastPlayed), 0).UTC()
+			}),
+			"lastParsed": characterField(graphql.NewNonNull(graphql.DateTime), "", func(c *domain.Character) interface{} {
+				return c.LastParsed
+			}),
+			"attributes": characterField(graphql.NewNonNull(attributesType), "", func(c *domain.Character) interface{} {
+				return c.D2s.Attributes
+			}),
+			"skills": characterField(graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(skillType))), "The points spent in each skill, without the bonuses of items.", func(c *domain.Character) interface{} {
+				return c.D2s.Skills
+			}),
+			"items": &graphql.Field{
+				Type:        itemList,
+				Description: "The items of the character, optionally only those in the location.",
+				Args: graphql.FieldConfigArgument{
+					"location": &graphql.ArgumentConfig{
+						Type:        graphql.String,
+						Description: "The location of the items such as inventory, stash, cube or an equipped slot like head.",
+					},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					items := p.Source.(*domain.Character).D2s.Items
+
+					location, ok := p.Args["location"].(string)
+					if !ok {
+						return items, nil
+					}
+
+					in := make([]d2s.Item, 0)
+					for _, item := range items {
+						if gear.Location(item) == location {
+							in = append(in, item)
+						}
+					}
+
+					return in, nil
+				},
+			},
+			"mercenary": characterField(mercenaryType, "", func(c *domain.Character) interface{} {
+				return mercenary.Of(c.D2s)
+			}),
+			"statistics": &graphql.Field{
+				Type:        statisticsType,
+				Description: "The statistics posted for the character, null until any have been posted.",
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					c := p.Source.(*domain.Character)
+					return requestOf(p.Context).statistics.Load(p.Context, c.ID), nil
+				},
+			},
+		},
+	})
+
+	summaryType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "CharacterSummary",
+		Description: "A light weight description of a character, the class and level are only known once it has been parsed.",
+		Fields: graphql.Fields{
+			"name":       &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"class":      &graphql.Field{Type: graphql.String},
+			"level":      &graphql.Field{Type: graphql.Int},
+			"lastSaved":  &graphql.Field{Type: graphql.DateTime},
+			"lastParsed": &graphql.Field{Type: graphql.DateTime},
+			"character": &graphql.Field{
+				Type:        characterType,
+				Description: "The full character, parsing it if it hasn't been parsed recently.",
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					summary := p.Source.(domain.CharacterSummary)
+					return requestOf(p.Context).characters.Load(p.Context, domain.NormalizeName(summary.Name)), nil
+				},
+			},
+		},
+	})
+
+	summaryList := graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(summaryType)))
+
+	accountType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Account",
+		Description: "An account and the characters that belong to it.",
+		Fields: graphql.Fields{
+			"name":       &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"characters": &graphql.Field{Type: summaryList},
+			"statistics": &graphql.Field{Type: graphql.NewNonNull(accountStatisticsType)},
+		},
+	})
+
+	characterType.AddFieldConfig("account", &graphql.Field{
+		Type:        accountType,
+		Description: "The account the character belongs to, null if it isn't known.",
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			c := p.Source.(*domain.Character)
+			r := requestOf(p.Context)
+
+			if c.Info != nil && c.Info.Account != "" {
+				return r.accounts.Load(p.Context, domain.NormalizeName(c.Info.Account)), nil
+			}
+
+			// Without realm info the account is only known from the posted statistics.
+			stats := r.statistics.Load(p.Context, c.ID)
+
+			return func() (interface{}, error) {
+				v, err := stats()
+				if err != nil || v == nil {
+					return nil, err
+				}
+
+				account := v.(*domain.CharacterStatistics).Account
+				if account == "" {
+					return nil, nil
+				}
+
+				return r.accounts.Load(p.Context, domain.NormalizeName(account))()
+			}, nil
+		},
+	})
+
+	listType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "CharacterList",
+		Description: "A single page of characters.",
+		Fields: graphql.Fields{
+			"total":      &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"offset":     &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"limit":      &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"characters": &graphql.Field{Type: summaryList},
+		},
+	})
+
+	sortEnum := graphql.NewEnum(graphql.EnumConfig{
+		Name:        "CharacterSort",
+		Description: "The orders characters can be listed in.",
+		Values: graphql.EnumValueConfigMap{
+			"LAST_SAVED": &graphql.EnumValueConfig{Value: domain.SortLastSaved},
+			"NAME":       &graphql.EnumValueConfig{Value: domain.SortName},
+		},
+	})
+
+	orderEnum := graphql.NewEnum(graphql.EnumConfig{
+		Name: "Order",
+		Values: graphql.EnumValueConfigMap{
+			"ASC":  &graphql.EnumValueConfig{Value: "asc"},
+			"DESC": &graphql.EnumValueConfig{Value: "desc"},
+		},
+	})
+
+	queryType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"character": &graphql.Field{
+				Type: characterType,
+				Args: graphql.FieldConfigArgument{
+					"name": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					name := domain.NormalizeName(p.Args["name"].(string))
+					return requestOf(p.Context).characters.Load(p.Context, name), nil
+				},
+			},
+			"characters": &graphql.Field{
+				Type:        graphql.NewNonNull(listType),
+				Description: "The characters available, the most recently saved first unless ordered otherwise.",
+				Args: graphql.FieldConfigArgument{
+					"offset": &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: 0},
+					"limit":  &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: defaultListSize},
+					"sort":   &graphql.ArgumentConfig{Type: sortEnum, DefaultValue: domain.SortLastSaved},
+					"order": &graphql.ArgumentConfig{
+						Type:        orderEnum,
+						Description: "Defaults to descending, except when sorted by name.",
+					},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					r := requestOf(p.Context)
+
+					sortBy := p.Args["sort"].(string)
+					order, _ := p.Args["order"].(string)
+
+					return r.service.characters.List(p.Context, domain.ListOptions{
+						Offset:     p.Args["offset"].(int),
+						Limit:      p.Args["limit"].(int),
+						Sort:       sortBy,
+						Descending: order == "desc" || (order == "" && sortBy != domain.SortName),
+						Exclude:    r.access.Hidden,
+					})
+				},
+			},
+			"account": &graphql.Field{
+				Type: accountType,
+				Args: graphql.FieldConfigArgument{
+					"name": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					name := domain.NormalizeName(p.Args["name"].(string))
+					return requestOf(p.Context).accounts.Load(p.Context, name), nil
+				},
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{
+		Query: queryType,
+	})
+}
+
+// itemField is a field resolved from an item.
+func itemField(t graphql.Output, description string, resolve func(item d2s.Item) interface{}) *graphql.Field {
+	return &graphql.Field{
+		Type:        t,
+		Description: description,
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			return resolve(p.Source.(d2s.Item)), nil
+		},
+	}
+}
+
+// characterField is a field resolved from a character.
+func characterField(t graphql.Output, description string, resolve func(c *domain.Character) interface{}) *graphql.Field {
+	return &graphql.Field{
+		Type:        t,
+		Description: description,
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			return resolve(p.Source.(*domain.Character)), nil
+		},
+	}
+}
+
+// areasOf returns the area statistics, the longest time spent first.
+func areasOf(stats domain.Stats) []areaStats {
+	areas := make([]areaStats, 0, len(stats.Area))
+	for name, a := range stats.Area {
+		areas = append(areas, areaStats{
+			Name:        name,
+			Kills:       a.Kills,
+			Time:        a.Time,
+			UniqueKills: a.UniqueKills,
+			ChampKills:  a.ChampKills,
+		})
+	}
+
+	sort.Slice(areas, func(i, j int) bool {
+		if areas[i].Time != areas[j].Time {
+			return areas[i].Time > areas[j].Time
+		}
+		return areas[i].Name < areas[j].Name
+	})
+
+	return areas
+}
+
+// monstersOf returns the special monster kills, the most kills first.
+func monstersOf(stats domain.Stats) []monsterKills {
+	monsters := make([]monsterKills, 0, len(stats.Special))
+	for name, kills := range stats.Special {
+		monsters = append(monsters, monsterKills{Name: name, Kills: kills})
+	}
+
+	sort.Slice(monsters, func(i, j int) bool {
+		if monsters[i].Kills != monsters[j].Kills {
+			return monsters[i].Kills > monsters[j].Kills
+		}
+		return monsters[i].Name < monsters[j].Name
+	})
+
+	return monsters
+}
@@ -0,0 +1,190 @@
+// Package graph serves characters, their items and statistics over GraphQL.
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/nokka/d2-armory-api/internal/domain"
+)
+
+//go:generate moq -out ./service_mocks.go . characterService statisticsService accountService
+
+// characterService is the interface representation of the characters
+// the queries are resolved with.
+type characterService interface {
+	Parse(ctx context.Context, name string) (*domain.Character, error)
+	List(ctx context.Context, opts domain.ListOptions) (*domain.CharacterList, error)
+}
+
+// statisticsService is the interface representation of the statistics
+// the queries are resolved with.
+type statisticsService interface {
+	GetCharacter(ctx context.Context, name string) (*domain.CharacterStatistics, error)
+}
+
+// accountService is the interface representation of the accounts
+// the queries are resolved with.
+type accountService interface {
+	Get(ctx context.Context, name string, exclude map[string]struct{}) (*domain.Account, error)
+}
+
+// Request is a single GraphQL query.
+type Request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// Access decides which characters a query can see.
+type Access struct {
+	// Authorize returns an error if the character isn't visible to the query,
+	// every character is visible without it.
+	Authorize func(name string) error
+
+	// Hidden holds the characters to leave out of listings.
+	Hidden map[string]struct{}
+}
+
+// Service executes GraphQL queries.
+type Service struct {
+	schema     graphql.Schema
+	characters characterService
+	statistics statisticsService
+	accounts   accountService
+	maxCost    int
+	maxDepth   int
+}
+
+// Execute parses, validates and executes the query. Queries costing more than
+// the max cost or nested deeper than the max depth are rejected before they're executed,
+// the returned result has no data when the query was rejected.
+func (s *Service) Execute(ctx context.Context, req Request, access Access) *graphql.Result {
+	doc, err := parser.Parse(parser.ParseParams{
+		Source: req.Query,
+	})
+	if err != nil {
+		return &graphql.Result{Errors: gqlerrors.FormatErrors(err)}
+	}
+
+	validation := graphql.ValidateDocument(&s.schema, doc, nil)
+	if !validation.IsValid {
+		return &graphql.Result{Errors: validation.Errors}
+	}
+
+	u, err := analyze(&s.schema, doc, req.OperationName, req.Variables)
+	if err != nil {
+		return &graphql.Result{Errors: gqlerrors.FormatErrors(err)}
+	}
+
+	if u.depth > s.maxDepth {
+		return &graphql.Result{Errors: gqlerrors.FormatErrors(
+			fmt.Errorf("query depth %d exceeds the max depth of %d", u.depth, s.maxDepth),
+		)}
+	}
+
+	if u.cost > s.maxCost {
+		return &graphql.Result{Errors: gqlerrors.FormatErrors(
+			fmt.Errorf("query cost %d exceeds the max cost of %d", u.cost, s.maxCost),
+		)}
+	}
+
+	if u.introspectionDepth > maxIntrospectionDepth {
+		return &graphql.Result{Errors: gqlerrors.FormatErrors(
+			fmt.Errorf("introspection depth %d exceeds the max depth of %d", u.introspectionDepth, maxIntrospectionDepth),
+		)}
+	}
+
+	if u.introspectionCost > maxIntrospectionCost {
+		return &graphql.Result{Errors: gqlerrors.FormatErrors(
+			fmt.Errorf("introspection cost %d exceeds the max cost of %d", u.introspectionCost, maxIntrospectionCost),
+		)}
+	}
+
+	return graphql.Execute(graphql.ExecuteParams{
+		Schema:        s.schema,
+		AST:           doc,
+		OperationName: req.OperationName,
+		Args:          req.Variables,
+		Context:       context.WithValue(ctx, requestKey{}, s.newRequest(access)),
+	})
+}
+
+// requestKey is the context key of the request state.
+type requestKey struct{}
+
+// request is the state of a single query, the loaders cache what has been
+// fetched so that a character referred to many times is only fetched once.
+type request struct {
+	service    *Service
+	access     Access
+	characters *loader
+	statistics *loader
+	accounts   *loader
+}
+
+func (s *Service) newRequest(access Access) *request {
+	if access.Authorize == nil {
+		access.Authorize = func(string) error { return nil }
+	}
+
+	return &request{
+		service: s,
+		access:  access,
+		characters: newLoader(func(ctx context.Context, name string) (interface{}, error) {
+			if err := access.Authorize(name); err != nil {
+				return nil, err
+			}
+
+			return s.characters.Parse(ctx, name)
+		}),
+		statistics: newLoader(func(ctx context.Context, name string) (interface{}, error) {
+			stats, err := s.statistics.GetCharacter(ctx, name)
+			if errors.Is(err, domain.ErrNotFound) {
+				// Characters that haven't posted any statistics yet.
+				return nil, nil
+			}
+
+			return stats, err
+		}),
+		accounts: newLoader(func(ctx context.Context, name string) (interface{}, error) {
+			account, err := s.accounts.Get(ctx, name, access.Hidden)
+			if errors.Is(err, domain.ErrNotFound) {
+				return nil, nil
+			}
+
+			return account, err
+		}),
+	}
+}
+
+// requestOf returns the request state of the query.
+func requestOf(ctx context.Context) *request {
+	r, _ := ctx.Value(requestKey{}).(*request)
+	return r
+}
+
+// NewService constructs a new GraphQL service with all the dependencies, queries
+// are limited to the max cost and depth.
+func NewService(characterService characterService, statisticsService statisticsService, accountService accountService, maxCost int, maxDepth int) (*Service, error) {
+	s := &Service{
+		characters: characterService,
+		statistics: statisticsService,
+		accounts:   accountService,
+		maxCost:    maxCost,
+		maxDepth:   maxDepth,
+	}
+
+	schema, err := newSchema()
+	if err != nil {
+		return nil, err
+	}
+
+	s.schema = schema
+
+	return s, nil
+}
@@ -0,0 +1,325 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+	"github.com/nokka/d2s"
+)
+
+// characterOf returns a parsed sorceress with a single item.
+func characterOf(name string, level byte) *domain.Character {
+	return &domain.Character{
+		ID: name,
+		D2s: &d2s.Character{
+			Header: d2s.Header{
+				Class:  1,
+				Level:  level,
+				Status: 0x24,
+			},
+			Attributes: d2s.Attributes{Strength: 35, Experience: 3520485254},
+			Skills:     []d2s.Skill{{ID: 59, Name: "Blizzard", Points: 20}},
+			Items: []d2s.Item{
+				{LocationID: 1, EquippedID: 1, Type: "uap", TypeName: "Shako", UniqueName: "Harlequin Crest", Quality: 7},
+				{LocationID: 0, AltPositionID: 5, Type: "r01", TypeName: "El Rune", Quality: 2},
+			},
+		},
+		Info: &domain.CharacterInfo{Account: "Nokka", Realm: "europe"},
+	}
+}
+
+func TestExecute(t *testing.T) {
+	characters := map[string]*domain.Character{
+		"nokka":  characterOf("nokka", 90),
+		"meanie": characterOf("meanie", 85),
+	}
+
+	tests := []struct {
+		name        string
+		query       string
+		variables   map[string]interface{}
+		access      Access
+		expected    string
+		errors      []string
+		parseCalls  int
+		rejected    bool
+		maxDepth    int
+		statsCalls  int
+		accountCall int
+	}{
+		{
+			name:       "character with items in a location",
+			query:      `{ character(name: "Nokka") { name class level hardcore experience items(location: "head") { name quality location } } }`,
+			expected:   `{"character":{"class":"Sorceress","experience":3520485254,"hardcore":true,"items":[{"location":"head","name":"Harlequin Crest","quality":"Unique"}],"level":90,"name":"nokka"}}`,
+			parseCalls: 1,
+		},
+		{
+			name:       "same character fetched once",
+			query:      `{ a: character(name: "nokka") { name } b: character(name: "NOKKA") { level } }`,
+			expected:   `{"a":{"name":"nokka"},"b":{"level":90}}`,
+			parseCalls: 1,
+		},
+		{
+			name:       "statistics per difficulty",
+			query:      `{ character(name: "nokka") { statistics { hell { totalKills areas { name time } specialMonsters { name kills } } } } }`,
+			expected:   `{"character":{"statistics":{"hell":{"areas":[{"name":"Worldstone Chamber","time":300},{"name":"Chaos Sanctuary","time":120}],"specialMonsters":[{"kills":12,"name":"Baal"}],"totalKills":1000}}}}`,
+			parseCalls: 1,
+			statsCalls: 1,
+		},
+		{
+			name:        "account of a character and its characters",
+			query:       `{ character(name: "nokka") { account { name characters { name character { level } } } } }`,
+			expected:    `{"character":{"account":{"characters":[{"character":{"level":85},"name":"meanie"},{"character":{"level":90},"name":"nokka"}],"name":"nokka"}}}`,
+			parseCalls:  2,
+			accountCall: 1,
+		},
+		{
+			name:       "listed characters",
+			query:      `query List($limit: Int) { characters(limit: $limit) { total characters { character { name } } } }`,
+			variables:  map[string]interface{}{"limit": float64(2)},
+			expected:   `{"characters":{"characters":[{"character":{"name":"meanie"}},{"character":{"name":"nokka"}}],"total":2}}`,
+			parseCalls: 2,
+		},
+		{
+			name:  "hidden character",
+			query: `{ character(name: "nokka") { name } }`,
+			access: Access{
+				Authorize: func(name string) error {
+					return fmt.Errorf("character %s is private: %w", name, domain.ErrNotFound)
+				},
+			},
+			expected: `{"character":null}`,
+			errors:   []string{"character nokka is private"},
+		},
+		{
+			name:     "query too expensive",
+			query:    `{ characters(limit: 100) { characters { character { items { name } } } } }`,
+			errors:   []string{"query cost 6210 exceeds the max cost of 2000"},
+			rejected: true,
+		},
+		{
+			name:     "query too deep",
+			query:    `{ character(name: "nokka") { account { characters { character { account { name } } } } } }`,
+			maxDepth: 4,
+			errors:   []string{"query depth 6 exceeds the max depth of 4"},
+			rejected: true,
+		},
+		{
+			name:     "invalid query",
+			query:    `{ character(name: "nokka") { height } }`,
+			errors:   []string{`Cannot query field "height" on type "Character".`},
+			rejected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mu sync.Mutex
+			parsed := make(map[string]int)
+
+			characterService := &characterServiceMock{
+				ParseFunc: func(ctx context.Context, name string) (*domain.Character, error) {
+					mu.Lock()
+					parsed[name]++
+					mu.Unlock()
+
+					c, ok := characters[name]
+					if !ok {
+						return nil, domain.ErrNotFound
+					}
+					return c, nil
+				},
+				ListFunc: func(ctx context.Context, opts domain.ListOptions) (*domain.CharacterList, error) {
+					return &domain.CharacterList{
+						Total: 2,
+						Limit: opts.Limit,
+						Characters: []domain.CharacterSummary{
+							{Name: "meanie"},
+							{Name: "nokka"},
+						},
+					}, nil
+				},
+			}
+
+			statisticsService := &statisticsServiceMock{
+				GetCharacterFunc: func(ctx context.Context, name string) (*domain.CharacterStatistics, error) {
+					return &domain.CharacterStatistics{
+						Character: name,
+						Hell: domain.Stats{
+							TotalKills: 1000,
+							Special:    map[string]int{"Baal": 12},
+							Area: map[string]domain.AreaStats{
+								"Chaos Sanctuary":    {Time: 120},
+								"Worldstone Chamber": {Time: 300},
+							},
+						},
+					}, nil
+				},
+			}
+
+			accountService := &accountServiceMock{
+				GetFunc: func(ctx context.Context, name string, exclude map[string]struct{}) (*domain.Account, error) {
+					return &domain.Account{
+						Name: name,
+						Characters: []domain.CharacterSummary{
+							{Name: "meanie"},
+							{Name: "nokka"},
+						},
+					}, nil
+				},
+			}
+
+			maxDepth := 10
+			if tt.maxDepth > 0 {
+				maxDepth = tt.maxDepth
+			}
+
+			s, err := NewService(characterService, statisticsService, accountService, 2000, maxDepth)
+			if err != nil {
+				t.Fatalf("didn't expect an error building the schema, got = %v", err)
+			}
+
+			result := s.Execute(context.TODO(), Request{Query: tt.query, Variables: tt.variables}, tt.access)
+
+			if len(result.Errors) != len(tt.errors) {
+				t.Fatalf("expected %d errors, got = %v", len(tt.errors), result.Errors)
+			}
+
+			for i, e := range tt.errors {
+				if !strings.HasPrefix(result.Errors[i].Message, e) {
+					t.Errorf("expected error %q, got = %q", e, result.Errors[i].Message)
+				}
+			}
+
+			if tt.rejected {
+				if result.Data != nil {
+					t.Errorf("expected no data for a rejected query, got = %v", result.Data)
+				}
+
+				if len(characterService.ParseCalls()) != 0 {
+					t.Errorf("expected no characters to be parsed for a rejected query")
+				}
+
+				return
+			}
+
+			data, _ := json.Marshal(result.Data)
+			if string(data) != tt.expected {
+				t.Errorf("expected data %s, got = %s", tt.expected, data)
+			}
+
+			if len(characterService.ParseCalls()) != tt.parseCalls {
+				t.Errorf("expected %d characters to be parsed, got = %d", tt.parseCalls, len(characterService.ParseCalls()))
+			}
+
+			for name, n := range parsed {
+				if n > 1 {
+					t.Errorf("expected %s to be parsed once, was parsed %d times", name, n)
+				}
+			}
+
+			if len(statisticsService.GetCharacterCalls()) != tt.statsCalls {
+				t.Errorf("expected %d statistics lookups, got = %d", tt.statsCalls, len(statisticsService.GetCharacterCalls()))
+			}
+
+			if len(accountService.GetCalls()) != tt.accountCall {
+				t.Errorf("expected %d account lookups, got = %d", tt.accountCall, len(accountService.GetCalls()))
+			}
+		})
+	}
+}
+
+// introspectionQuery is the introspection query of GraphiQL and the usual clients.
+const introspectionQuery = `
+query IntrospectionQuery {
+  __schema {
+    queryType { name }
+    mutationType { name }
+    subscriptionType { name }
+    types { ...FullType }
+    directives { name description locations args { ...InputValue } }
+  }
+}
+
+fragment FullType on __Type {
+  kind name description
+  fields(includeDeprecated: true) {
+    name description
+    args { ...InputValue }
+    type { ...TypeRef }
+    isDeprecated deprecationReason
+  }
+  inputFields { ...InputValue }
+  interfaces { ...TypeRef }
+  enumValues(includeDeprecated: true) { name description isDeprecated deprecationReason }
+  possibleTypes { ...TypeRef }
+}
+
+fragment InputValue on __InputValue {
+  name description
+  type { ...TypeRef }
+  defaultValue
+}
+
+fragment TypeRef on __Type {
+  kind name
+  ofType { kind name ofType { kind name ofType { kind name ofType { kind name ofType { kind name ofType { kind name ofType { kind name } } } } } } }
+}`
+
+func TestExecuteIntrospection(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		err   string
+	}{
+		{
+			name:  "introspection query",
+			query: introspectionQuery,
+		},
+		{
+			name:  "type by name",
+			query: `{ __type(name: "Character") { name fields { name } } }`,
+		},
+		{
+			name:  "types nested within each other",
+			query: `{ __schema { types { fields { type { fields { type { fields { type { fields { name } } } } } } } } } }`,
+			err:   "introspection cost",
+		},
+		{
+			name:  "types nested too deep",
+			query: `{ __type(name: "Character") { ofType { ofType { ofType { ofType { ofType { ofType { ofType { ofType { ofType { ofType { ofType { ofType { ofType { ofType { ofType { name } } } } } } } } } } } } } } } } }`,
+			err:   "introspection depth 17 exceeds the max depth of 15",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := NewService(&characterServiceMock{}, &statisticsServiceMock{}, &accountServiceMock{}, 2000, 10)
+			if err != nil {
+				t.Fatalf("didn't expect an error building the schema, got = %v", err)
+			}
+
+			result := s.Execute(context.TODO(), Request{Query: tt.query}, Access{})
+
+			if tt.err == "" {
+				if len(result.Errors) != 0 || result.Data == nil {
+					t.Fatalf("expected the schema, got errors = %v", result.Errors)
+				}
+				return
+			}
+
+			if len(result.Errors) != 1 || !strings.HasPrefix(result.Errors[0].Message, tt.err) {
+				t.Fatalf("expected error %q, got = %v", tt.err, result.Errors)
+			}
+
+			if result.Data != nil {
+				t.Errorf("expected no data for a rejected query, got = %v", result.Data)
+			}
+		})
+	}
+}
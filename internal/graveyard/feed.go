@@ -0,0 +1,189 @@
+package graveyard
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+)
+
+// feedTitle is the title of the death feeds.
+const feedTitle = "Hardcore graveyard"
+
+// Title returns the headline of the death, e.g. "nokka, level 85 Sorceress, died in The Worldstone Keep (Hell)".
+func Title(g domain.Grave) string {
+	title := fmt.Sprintf("%s, level %d %s, died", g.Character, g.Level, g.Class)
+
+	switch {
+	case g.Area != "" && g.Difficulty != "":
+		title += fmt.Sprintf(" in %s (%s)", g.Area, g.Difficulty)
+	case g.Area != "":
+		title += " in " + g.Area
+	}
+
+	return title
+}
+
+// Summary returns the description of the death, the experience and the gear of the character.
+func Summary(g domain.Grave) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s died at level %d with %d experience.", g.Character, g.Level, g.Experience)
+
+	if len(g.Gear) > 0 {
+		names := make([]string, 0, len(g.Gear))
+		for _, item := range g.Gear {
+			names = append(names, item.Name)
+		}
+
+		fmt.Fprintf(&b, " Wearing %s.", strings.Join(names, ", "))
+	}
+
+	return b.String()
+}
+
+// link returns the link to the character in the armory.
+func link(base string, g domain.Grave) string {
+	return base + "/api/v1/characters?name=" + url.QueryEscape(g.Character)
+}
+
+// guid returns the permanent identifier of the death, a name may be reused by a new character.
+func guid(base string, g domain.Grave) string {
+	return fmt.Sprintf("%s#died-%d", link(base, g), g.DiedAt.Unix())
+}
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Links   []atomLink  `xml:"link"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Rel  string `xml:"rel,attr,omitempty"`
+	Href string `xml:"href,attr"`
+}
+
+type atomEntry struct {
+	ID      string     `xml:"id"`
+	Title   string     `xml:"title"`
+	Updated string     `xml:"updated"`
+	Author  atomAuthor `xml:"author"`
+	Link    atomLink   `xml:"link"`
+	Summary string     `xml:"summary"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+// Atom returns the graves as an Atom feed, base is the absolute URL of the armory
+// and self the URL of the feed itself.
+func Atom(graves []domain.Grave, base string, self string) ([]byte, error) {
+	feed := atomFeed{
+		ID:      self,
+		Title:   feedTitle,
+		Updated: updated(graves).Format(time.RFC3339),
+		Links: []atomLink{
+			{Rel: "self", Href: self},
+			{Rel: "alternate", Href: base + "/api/v1/graveyard"},
+		},
+		Entries: make([]atomEntry, 0, len(graves)),
+	}
+
+	for _, g := range graves {
+		author := g.Account
+		if author == "" {
+			author = g.Character
+		}
+
+		feed.Entries = append(feed.Entries, atomEntry{
+			ID:      guid(base, g),
+			Title:   Title(g),
+			Updated: g.DiedAt.UTC().Format(time.RFC3339),
+			Author:  atomAuthor{Name: author},
+			Link:    atomLink{Href: link(base, g)},
+			Summary: Summary(g),
+		})
+	}
+
+	return marshal(feed)
+}
+
+type rssFeed struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	LastBuildDate string    `xml:"lastBuildDate"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string  `xml:"title"`
+	Link        string  `xml:"link"`
+	Description string  `xml:"description"`
+	GUID        rssGUID `xml:"guid"`
+	PubDate     string  `xml:"pubDate"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+// RSS returns the graves as an RSS 2.0 feed, base is the absolute URL of the armory.
+func RSS(graves []domain.Grave, base string) ([]byte, error) {
+	feed := rssFeed{
+		Version: "2.0",
+		Channel: rssChannel{
+			Title:         feedTitle,
+			Link:          base + "/api/v1/graveyard",
+			Description:   "The hardcore characters that died most recently.",
+			LastBuildDate: updated(graves).Format(time.RFC1123Z),
+			Items:         make([]rssItem, 0, len(graves)),
+		},
+	}
+
+	for _, g := range graves {
+		feed.Channel.Items = append(feed.Channel.Items, rssItem{
+			Title:       Title(g),
+			Link:        link(base, g),
+			Description: Summary(g),
+			GUID:        rssGUID{Value: guid(base, g)},
+			PubDate:     g.DiedAt.UTC().Format(time.RFC1123Z),
+		})
+	}
+
+	return marshal(feed)
+}
+
+// updated returns the time of the most recent death.
+func updated(graves []domain.Grave) time.Time {
+	var latest time.Time
+	for _, g := range graves {
+		if g.DiedAt.After(latest) {
+			latest = g.DiedAt
+		}
+	}
+
+	return latest.UTC()
+}
+
+func marshal(feed interface{}) ([]byte, error) {
+	body, err := xml.MarshalIndent(feed, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte(xml.Header), body...), nil
+}
@@ -0,0 +1,82 @@
+package graveyard
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+)
+
+var graves = []domain.Grave{
+	{
+		Character:  "nokka",
+		Class:      "Sorceress",
+		Level:      85,
+		Experience: 1000000,
+		Difficulty: domain.DifficultyHell,
+		Area:       "Chaos Sanctuary",
+		Gear:       []domain.GraveItem{{Slot: "head", Name: "Harlequin Crest"}},
+		DiedAt:     time.Date(2021, 5, 2, 12, 0, 0, 0, time.UTC),
+	},
+	{
+		Character: "sorc",
+		Class:     "Sorceress",
+		Level:     12,
+		DiedAt:    time.Date(2021, 5, 1, 12, 0, 0, 0, time.UTC),
+	},
+}
+
+func TestTitle(t *testing.T) {
+	expected := "nokka, level 85 Sorceress, died in Chaos Sanctuary (Hell)"
+	if title := Title(graves[0]); title != expected {
+		t.Errorf("expected %s, got %s", expected, title)
+	}
+
+	expected = "sorc, level 12 Sorceress, died"
+	if title := Title(graves[1]); title != expected {
+		t.Errorf("expected %s, got %s", expected, title)
+	}
+}
+
+func TestAtom(t *testing.T) {
+	body, err := Atom(graves, "https://armory.example.com", "https://armory.example.com/api/v1/graveyard/feed.atom")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var feed atomFeed
+	if err := xml.Unmarshal(body, &feed); err != nil {
+		t.Fatalf("expected a valid feed, got = %v", err)
+	}
+
+	if feed.Updated != "2021-05-02T12:00:00Z" || len(feed.Entries) != 2 {
+		t.Fatalf("unexpected feed %+v", feed)
+	}
+
+	entry := feed.Entries[0]
+	if entry.Link.Href != "https://armory.example.com/api/v1/characters?name=nokka" || entry.Title != Title(graves[0]) {
+		t.Errorf("unexpected entry %+v", entry)
+	}
+}
+
+func TestRSS(t *testing.T) {
+	body, err := RSS(graves, "https://armory.example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var feed rssFeed
+	if err := xml.Unmarshal(body, &feed); err != nil {
+		t.Fatalf("expected a valid feed, got = %v", err)
+	}
+
+	if feed.Version != "2.0" || len(feed.Channel.Items) != 2 {
+		t.Fatalf("unexpected feed %+v", feed)
+	}
+
+	item := feed.Channel.Items[1]
+	if item.PubDate != "Sat, 01 May 2021 12:00:00 +0000" || item.GUID.Value == item.Link {
+		t.Errorf("unexpected item %+v", item)
+	}
+}
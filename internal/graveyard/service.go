@@ -0,0 +1,170 @@
+package graveyard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+	"github.com/nokka/d2-armory-api/internal/gear"
+)
+
+//go:generate moq -out ./service_mocks.go . graveyardRepository
+
+// graveyardRepository is the interface representation of the data layer
+// the service depend on.
+type graveyardRepository interface {
+	Bury(ctx context.Context, grave domain.Grave) error
+	Find(ctx context.Context, opts domain.GraveyardOptions) ([]domain.Grave, int, error)
+	SetLastArea(ctx context.Context, area domain.LastArea) error
+	LastArea(ctx context.Context, character string) (*domain.LastArea, error)
+}
+
+// Page size limits when listing graves.
+const (
+	defaultLimit = 20
+	maxLimit     = 100
+)
+
+// Service keeps the graveyard of hardcore characters.
+type Service struct {
+	repository graveyardRepository
+	now        func() time.Time
+}
+
+// CharacterPersisted buries the character if it's a dead hardcore character, graves
+// are only dug once per character, keeping the character as it was the first time
+// it was seen dead. A new character using the name of a buried one is told apart
+// by when it was born.
+func (s Service) CharacterPersisted(ctx context.Context, previous *domain.Character, current *domain.Character) error {
+	if current == nil || current.D2s == nil {
+		return nil
+	}
+
+	status := current.D2s.Header.Status.Readable()
+	if !status.Hardcore || !status.Died {
+		return nil
+	}
+
+	c := current.D2s
+
+	grave := domain.Grave{
+		Character:  current.ID,
+		Born:       current.Born().UTC().Truncate(time.Millisecond),
+		Class:      gear.ClassName(int(c.Header.Class)),
+		Level:      int(c.Header.Level),
+		Experience: c.Attributes.Experience,
+		Gear:       make([]domain.GraveItem, 0),
+		DiedAt:     s.now().UTC().Truncate(time.Millisecond),
+	}
+
+	if current.Info != nil {
+		grave.Account = domain.NormalizeName(current.Info.Account)
+		grave.Realm = current.Info.Realm
+	}
+
+	for _, item := range gear.Equipped(c.Items, c.Header.ActiveArms) {
+		grave.Gear = append(grave.Gear, domain.GraveItem{
+			Slot:    gear.Location(item),
+			Name:    gear.ItemName(item),
+			Type:    item.TypeName,
+			Quality: gear.QualityName(item.Quality),
+		})
+	}
+
+	area, err := s.repository.LastArea(ctx, current.ID)
+	if err != nil && !errors.Is(err, domain.ErrNotFound) {
+		return err
+	}
+
+	if area != nil {
+		grave.Difficulty = area.Difficulty
+		grave.Area = area.Area
+	}
+
+	return s.repository.Bury(ctx, grave)
+}
+
+// StatisticsSubmitted keeps track of where the character was last seen, the area
+// the character spent the most time in during the game the statistics are from.
+func (s Service) StatisticsSubmitted(ctx context.Context, previous *domain.CharacterStatistics, submitted domain.StatisticsRequest) error {
+	area := mostPlayed(submitted.Area)
+	if area == "" {
+		return nil
+	}
+
+	return s.repository.SetLastArea(ctx, domain.LastArea{
+		Character:  domain.NormalizeName(submitted.Character),
+		Difficulty: submitted.Difficulty,
+		Area:       area,
+		SeenAt:     s.now().UTC().Truncate(time.Millisecond),
+	})
+}
+
+// mostPlayed returns the area with the most time spent in it, ties are broken by name.
+func mostPlayed(areas map[string]domain.AreaStats) string {
+	names := make([]string, 0, len(areas))
+	for name, stats := range areas {
+		if stats.Time > 0 {
+			names = append(names, name)
+		}
+	}
+
+	if len(names) == 0 {
+		return ""
+	}
+
+	sort.Slice(names, func(i, j int) bool {
+		if areas[names[i]].Time != areas[names[j]].Time {
+			return areas[names[i]].Time > areas[names[j]].Time
+		}
+		return names[i] < names[j]
+	})
+
+	return names[0]
+}
+
+// List will list the graves, the most recent deaths or the highest levels first.
+func (s Service) List(ctx context.Context, opts domain.GraveyardOptions) (*domain.Graveyard, error) {
+	if opts.Offset < 0 {
+		return nil, fmt.Errorf("offset can't be negative: %w", domain.ErrRequest)
+	}
+
+	if opts.Limit == 0 {
+		opts.Limit = defaultLimit
+	}
+
+	if opts.Limit < 0 || opts.Limit > maxLimit {
+		return nil, fmt.Errorf("limit must be between 1 and %d: %w", maxLimit, domain.ErrRequest)
+	}
+
+	switch opts.Sort {
+	case "":
+		opts.Sort = domain.GraveyardSortRecent
+	case domain.GraveyardSortRecent, domain.GraveyardSortLevel:
+	default:
+		return nil, fmt.Errorf("unknown sort %s: %w", opts.Sort, domain.ErrRequest)
+	}
+
+	graves, total, err := s.repository.Find(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.Graveyard{
+		Total:  total,
+		Offset: opts.Offset,
+		Limit:  opts.Limit,
+		Graves: graves,
+	}, nil
+}
+
+// NewService constructs a new graveyard service with all the dependencies.
+func NewService(repository graveyardRepository) *Service {
+	return &Service{
+		repository: repository,
+		now:        time.Now,
+	}
+}
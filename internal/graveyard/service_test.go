@@ -0,0 +1,267 @@
+package graveyard
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+	"github.com/nokka/d2s"
+)
+
+func TestCharacterPersisted(t *testing.T) {
+	now := time.Date(2021, 5, 1, 12, 0, 0, 0, time.UTC)
+	created := time.Date(2021, 4, 1, 12, 0, 0, 0, time.UTC)
+	firstSeen := time.Date(2021, 4, 2, 12, 0, 0, 0, time.UTC)
+
+	info := &domain.CharacterInfo{Account: "Nokka", Realm: "Slashdiablo", Created: created}
+
+	items := []d2s.Item{
+		{Type: "uap", TypeName: "Shako", UniqueName: "Harlequin Crest", Quality: 7, LocationID: 1, EquippedID: 1},
+		{Type: "r31", TypeName: "Jah Rune", LocationID: 0, AltPositionID: 5},
+	}
+
+	// The status bits are unexported, so headers are built by assigning constants.
+	alive := d2s.Header{Level: 85, Class: d2s.Sorceress}
+	alive.Status = 0x24
+
+	softcoreDead := alive
+	softcoreDead.Status = 0x28
+
+	dead := alive
+	dead.Status = 0x2c
+
+	tests := []struct {
+		name     string
+		header   d2s.Header
+		info     *domain.CharacterInfo
+		area     *domain.LastArea
+		expected *domain.Grave
+	}{
+		{
+			name:   "alive hardcore character",
+			header: alive,
+			info:   info,
+		},
+		{
+			name:   "dead softcore character",
+			header: softcoreDead,
+			info:   info,
+		},
+		{
+			name:   "dead hardcore character",
+			header: dead,
+			info:   info,
+			area:   &domain.LastArea{Character: "nokka", Difficulty: domain.DifficultyHell, Area: "The Worldstone Chamber"},
+			expected: &domain.Grave{
+				Character:  "nokka",
+				Born:       created,
+				Class:      "Sorceress",
+				Level:      85,
+				Experience: 1000000,
+				Account:    "nokka",
+				Realm:      "Slashdiablo",
+				Difficulty: domain.DifficultyHell,
+				Area:       "The Worldstone Chamber",
+				Gear: []domain.GraveItem{
+					{Slot: "head", Name: "Harlequin Crest", Type: "Shako", Quality: "Unique"},
+				},
+				DiedAt: now,
+			},
+		},
+		{
+			name:   "dead hardcore character without statistics",
+			header: dead,
+			info:   info,
+			expected: &domain.Grave{
+				Character:  "nokka",
+				Born:       created,
+				Class:      "Sorceress",
+				Level:      85,
+				Experience: 1000000,
+				Account:    "nokka",
+				Realm:      "Slashdiablo",
+				Gear: []domain.GraveItem{
+					{Slot: "head", Name: "Harlequin Crest", Type: "Shako", Quality: "Unique"},
+				},
+				DiedAt: now,
+			},
+		},
+		{
+			name:   "dead hardcore character without realm metadata",
+			header: dead,
+			expected: &domain.Grave{
+				Character:  "nokka",
+				Born:       firstSeen,
+				Class:      "Sorceress",
+				Level:      85,
+				Experience: 1000000,
+				Gear: []domain.GraveItem{
+					{Slot: "head", Name: "Harlequin Crest", Type: "Shako", Quality: "Unique"},
+				},
+				DiedAt: now,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repository := &graveyardRepositoryMock{
+				BuryFunc: func(ctx context.Context, grave domain.Grave) error {
+					return nil
+				},
+				LastAreaFunc: func(ctx context.Context, character string) (*domain.LastArea, error) {
+					if tt.area == nil {
+						return nil, domain.ErrNotFound
+					}
+					return tt.area, nil
+				},
+			}
+
+			s := NewService(repository)
+			s.now = func() time.Time { return now }
+
+			err := s.CharacterPersisted(context.Background(), nil, &domain.Character{
+				ID: "nokka",
+				D2s: &d2s.Character{
+					Header:     tt.header,
+					Attributes: d2s.Attributes{Experience: 1000000},
+					Items:      items,
+				},
+				Info:      tt.info,
+				FirstSeen: &firstSeen,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			calls := repository.BuryCalls()
+
+			if tt.expected == nil {
+				if len(calls) != 0 {
+					t.Errorf("didn't expect the character to be buried")
+				}
+				return
+			}
+
+			if len(calls) != 1 {
+				t.Fatalf("expected the character to be buried once, got = %d", len(calls))
+			}
+
+			if !reflect.DeepEqual(calls[0].Grave, *tt.expected) {
+				t.Errorf("expected grave %+v, got %+v", *tt.expected, calls[0].Grave)
+			}
+		})
+	}
+}
+
+func TestStatisticsSubmitted(t *testing.T) {
+	repository := &graveyardRepositoryMock{
+		SetLastAreaFunc: func(ctx context.Context, area domain.LastArea) error {
+			return nil
+		},
+	}
+
+	s := NewService(repository)
+
+	// Statistics without time spent anywhere don't tell where the character is.
+	if err := s.StatisticsSubmitted(context.Background(), nil, domain.StatisticsRequest{Character: "nokka"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	err := s.StatisticsSubmitted(context.Background(), nil, domain.StatisticsRequest{
+		Character:  "Nokka",
+		Difficulty: domain.DifficultyHell,
+		Area: map[string]domain.AreaStats{
+			"Chaos Sanctuary":  {Time: 300},
+			"River of Flame":   {Time: 120},
+			"The Pandemonium":  {Time: 300},
+			"Rogue Encampment": {Time: 0},
+			"Worldstone Keep":  {Time: 10},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	calls := repository.SetLastAreaCalls()
+	if len(calls) != 1 {
+		t.Fatalf("expected the last area to be set once, got = %d", len(calls))
+	}
+
+	if calls[0].Area.Character != "nokka" || calls[0].Area.Area != "Chaos Sanctuary" || calls[0].Area.Difficulty != domain.DifficultyHell {
+		t.Errorf("unexpected last area %+v", calls[0].Area)
+	}
+}
+
+func TestList(t *testing.T) {
+	tests := []struct {
+		name          string
+		opts          domain.GraveyardOptions
+		expectedSort  string
+		expectedLimit int
+		expectedError bool
+	}{
+		{
+			name:          "defaults",
+			expectedSort:  domain.GraveyardSortRecent,
+			expectedLimit: defaultLimit,
+		},
+		{
+			name:          "by level",
+			opts:          domain.GraveyardOptions{Sort: domain.GraveyardSortLevel, Limit: 5},
+			expectedSort:  domain.GraveyardSortLevel,
+			expectedLimit: 5,
+		},
+		{
+			name:          "unknown sort",
+			opts:          domain.GraveyardOptions{Sort: "experience"},
+			expectedError: true,
+		},
+		{
+			name:          "limit too large",
+			opts:          domain.GraveyardOptions{Limit: maxLimit + 1},
+			expectedError: true,
+		},
+		{
+			name:          "negative offset",
+			opts:          domain.GraveyardOptions{Offset: -1},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repository := &graveyardRepositoryMock{
+				FindFunc: func(ctx context.Context, opts domain.GraveyardOptions) ([]domain.Grave, int, error) {
+					return []domain.Grave{{Character: "nokka"}}, 1, nil
+				},
+			}
+
+			s := NewService(repository)
+
+			graveyard, err := s.List(context.Background(), tt.opts)
+			if (err != nil) != tt.expectedError {
+				t.Fatalf("got error = %v, expectedError %v", err, tt.expectedError)
+			}
+
+			if tt.expectedError {
+				if !errors.Is(err, domain.ErrRequest) {
+					t.Errorf("expected ErrRequest, got = %v", err)
+				}
+				return
+			}
+
+			opts := repository.FindCalls()[0].Opts
+			if opts.Sort != tt.expectedSort || opts.Limit != tt.expectedLimit {
+				t.Errorf("expected sort %s and limit %d, got %+v", tt.expectedSort, tt.expectedLimit, opts)
+			}
+
+			if graveyard.Total != 1 || len(graveyard.Graves) != 1 {
+				t.Errorf("unexpected graveyard %+v", graveyard)
+			}
+		})
+	}
+}
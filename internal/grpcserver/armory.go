@@ -0,0 +1,213 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+	"github.com/nokka/d2-armory-api/pkg/armorypb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Batch fetch limits.
+const (
+	maxBatchSize       = 100
+	maxConcurrentFetch = 8
+)
+
+// armoryServer implements the armory service on top of the same services as the HTTP server.
+type armoryServer struct {
+	armorypb.UnimplementedArmoryServer
+
+	characterService  characterService
+	statisticsService statisticsService
+	visibilityService visibilityService
+	liveHub           liveHub
+}
+
+func (s *armoryServer) GetCharacter(ctx context.Context, req *armorypb.GetCharacterRequest) (*armorypb.Character, error) {
+	c, err := s.character(ctx, req.GetName(), req.GetToken())
+	if err != nil {
+		return nil, statusOf(err)
+	}
+
+	return characterOf(c), nil
+}
+
+func (s *armoryServer) BatchGetCharacters(ctx context.Context, req *armorypb.BatchGetCharactersRequest) (*armorypb.BatchGetCharactersResponse, error) {
+	names := req.GetNames()
+	if len(names) == 0 || len(names) > maxBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "between 1 and %d names are required", maxBatchSize)
+	}
+
+	results := make([]*armorypb.CharacterResult, len(names))
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, maxConcurrentFetch)
+
+	for i, name := range names {
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func(i int, name string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			result := &armorypb.CharacterResult{Name: name}
+
+			c, err := s.character(ctx, name, req.GetTokens()[name])
+			if err != nil {
+				st := status.Convert(statusOf(err))
+				result.Code = int32(st.Code())
+				result.Message = st.Message()
+			} else {
+				result.Character = characterOf(c)
+			}
+
+			results[i] = result
+		}(i, name)
+	}
+
+	wg.Wait()
+
+	return &armorypb.BatchGetCharactersResponse{Results: results}, nil
+}
+
+func (s *armoryServer) GetStatistics(ctx context.Context, req *armorypb.GetStatisticsRequest) (*armorypb.Statistics, error) {
+	if err := s.authorize(ctx, req.GetCharacter(), req.GetToken()); err != nil {
+		return nil, statusOf(err)
+	}
+
+	stats, err := s.statisticsService.GetCharacter(ctx, req.GetCharacter())
+	if err != nil {
+		return nil, statusOf(err)
+	}
+
+	return statisticsOf(stats), nil
+}
+
+func (s *armoryServer) PostStatistics(ctx context.Context, req *armorypb.PostStatisticsRequest) (*emptypb.Empty, error) {
+	stats := make([]domain.StatisticsRequest, 0, len(req.GetStatistics()))
+	for _, submission := range req.GetStatistics() {
+		stats = append(stats, submissionFrom(submission))
+	}
+
+	if err := s.statisticsService.Parse(ctx, stats); err != nil {
+		return nil, statusOf(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *armoryServer) DeleteStatistics(ctx context.Context, req *armorypb.DeleteStatisticsRequest) (*emptypb.Empty, error) {
+	if err := s.statisticsService.DeleteStats(ctx, req.GetCharacter()); err != nil {
+		return nil, statusOf(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *armoryServer) WatchCharacter(req *armorypb.WatchCharacterRequest, stream armorypb.Armory_WatchCharacterServer) error {
+	if s.liveHub == nil {
+		return status.Error(codes.Unimplemented, "live updates aren't enabled")
+	}
+
+	ctx := stream.Context()
+
+	// The character as it is right now is the first update of every stream.
+	c, err := s.character(ctx, req.GetName(), req.GetToken())
+	if err != nil {
+		return statusOf(err)
+	}
+
+	sub, err := s.liveHub.Subscribe(req.GetName())
+	if err != nil {
+		return statusOf(err)
+	}
+	defer s.liveHub.Unsubscribe(sub)
+
+	if err := stream.Send(updateOf(domain.LiveUpdate{Type: domain.LiveCharacter, Character: c})); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case update, ok := <-sub.Updates():
+			if !ok {
+				// The server is shutting down, the client should reconnect.
+				return status.Error(codes.Unavailable, "live updates are shutting down")
+			}
+
+			// The character might have been made private since the stream was opened.
+			if err := s.authorize(ctx, sub.Character(), req.GetToken()); err != nil {
+				return statusOf(err)
+			}
+
+			if err := stream.Send(updateOf(update)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// character gets the character if it's visible with the token.
+func (s *armoryServer) character(ctx context.Context, name string, token string) (*domain.Character, error) {
+	if err := s.authorize(ctx, name, token); err != nil {
+		return nil, err
+	}
+
+	return s.characterService.Parse(ctx, name)
+}
+
+// authorize returns an error if the character isn't visible with the token,
+// private characters are visible with their share token.
+func (s *armoryServer) authorize(ctx context.Context, name string, token string) error {
+	if s.visibilityService == nil {
+		return nil
+	}
+
+	return s.visibilityService.Authorize(ctx, name, token)
+}
+
+// statusOf converts the error to a gRPC status error with the code of the domain error.
+// The detail of server errors is of no use to the client and may leak internals, so
+// it's logged and the client is only told the code.
+func statusOf(err error) error {
+	var code codes.Code
+
+	switch {
+	case errors.Is(err, domain.ErrRequest), errors.Is(err, domain.ErrInvalidArgument):
+		code = codes.InvalidArgument
+	case errors.Is(err, domain.ErrNotFound), errors.Is(err, domain.ErrGone):
+		code = codes.NotFound
+	case errors.Is(err, domain.ErrUnavailable), errors.Is(err, domain.ErrTemporary):
+		code = codes.Unavailable
+	case errors.Is(err, domain.ErrConflict):
+		code = codes.AlreadyExists
+	case errors.Is(err, context.Canceled):
+		code = codes.Canceled
+	case errors.Is(err, context.DeadlineExceeded):
+		code = codes.DeadlineExceeded
+	default:
+		code = codes.Internal
+	}
+
+	switch code {
+	case codes.Internal, codes.Unavailable:
+		log.Printf("grpc %s error: %s", code, err)
+		return status.Error(code, serverErrorMessage)
+	}
+
+	return status.Error(code, err.Error())
+}
+
+// serverErrorMessage is the message of server errors, the detail is only logged.
+const serverErrorMessage = "the request couldn't be completed"
@@ -0,0 +1,59 @@
+package grpcserver
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// bearerPrefix is the scheme of the authorization metadata.
+const bearerPrefix = "Bearer "
+
+// authenticator requires the token of the server in the authorization
+// metadata of every call, as "Bearer <token>".
+type authenticator struct {
+	token string
+}
+
+// Unary authenticates unary calls.
+func (a authenticator) Unary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := a.authenticate(ctx); err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+// Stream authenticates streaming calls.
+func (a authenticator) Stream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := a.authenticate(ss.Context()); err != nil {
+		return err
+	}
+
+	return handler(srv, ss)
+}
+
+func (a authenticator) authenticate(ctx context.Context) error {
+	// Without a token every call is unauthenticated, rather than every call being allowed.
+	if a.token == "" {
+		return status.Error(codes.Unauthenticated, "authentication isn't configured")
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, v := range md.Get("authorization") {
+		if !strings.HasPrefix(v, bearerPrefix) {
+			continue
+		}
+
+		if subtle.ConstantTimeCompare([]byte(strings.TrimPrefix(v, bearerPrefix)), []byte(a.token)) == 1 {
+			return nil
+		}
+	}
+
+	return status.Error(codes.Unauthenticated, "invalid or missing token")
+}
@@ -0,0 +1,240 @@
+package grpcserver
+
+import (
+	"sort"
+	"time"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+	"github.com/nokka/d2-armory-api/internal/gear"
+	"github.com/nokka/d2-armory-api/internal/mercenary"
+	"github.com/nokka/d2-armory-api/pkg/armorypb"
+	"github.com/nokka/d2s"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// difficulties maps the difficulties of the statistics to their protobuf enum.
+var difficulties = map[string]armorypb.Difficulty{
+	domain.DifficultyNormal:    armorypb.Difficulty_DIFFICULTY_NORMAL,
+	domain.DifficultyNightmare: armorypb.Difficulty_DIFFICULTY_NIGHTMARE,
+	domain.DifficultyHell:      armorypb.Difficulty_DIFFICULTY_HELL,
+}
+
+// characterOf converts the character to its protobuf message.
+func characterOf(c *domain.Character) *armorypb.Character {
+	h := c.D2s.Header
+	status := h.Status.Readable()
+	attrs := c.D2s.Attributes
+
+	msg := &armorypb.Character{
+		Name:       c.ID,
+		Class:      gear.ClassName(int(h.Class)),
+		Level:      int32(h.Level),
+		Experience: attrs.Experience,
+		Hardcore:   status.Hardcore,
+		Dead:       status.Died,
+		Expansion:  status.Expansion,
+		Ladder:     status.Ladder,
+		LastPlayed: timestamppb.New(time.Unix(int64(h.LastPlayed), 0)),
+		LastParsed: timestamppb.New(c.LastParsed),
+		Attributes: &armorypb.Attributes{
+			Strength:          uint32(attrs.Strength),
+			Dexterity:         uint32(attrs.Dexterity),
+			Vitality:          uint32(attrs.Vitality),
+			Energy:            uint32(attrs.Energy),
+			UnusedStats:       uint32(attrs.UnusedStats),
+			UnusedSkillPoints: uint32(attrs.UnusedSkillPoints),
+			CurrentHp:         uint32(attrs.CurrentHP),
+			MaxHp:             uint32(attrs.MaxHP),
+			CurrentMana:       uint32(attrs.CurrentMana),
+			MaxMana:           uint32(attrs.MaxMana),
+			CurrentStamina:    uint32(attrs.CurrentStamina),
+			MaxStamina:        uint32(attrs.MaxStamina),
+			Gold:              uint32(attrs.Gold),
+			StashedGold:       uint32(attrs.StashedGold),
+		},
+		Skills: make([]*armorypb.Skill, 0, len(c.D2s.Skills)),
+		Items:  itemsOf(c.D2s.Items),
+	}
+
+	if c.Info != nil {
+		msg.Account = c.Info.Account
+		msg.Realm = c.Info.Realm
+	}
+
+	for _, s := range c.D2s.Skills {
+		msg.Skills = append(msg.Skills, &armorypb.Skill{
+			Id:     int32(s.ID),
+			Name:   s.Name,
+			Points: int32(s.Points),
+		})
+	}
+
+	if merc := mercenary.Of(c.D2s); merc != nil {
+		msg.Mercenary = &armorypb.Mercenary{
+			Class:      merc.Class,
+			Variant:    merc.Variant,
+			Act:        int32(merc.Act),
+			Difficulty: merc.Difficulty,
+			Level:      int32(merc.Level),
+			Experience: merc.Experience,
+			Dead:       merc.Dead,
+			Items:      itemsOf(merc.Items),
+		}
+	}
+
+	return msg
+}
+
+// itemsOf converts the items to their protobuf messages.
+func itemsOf(items []d2s.Item) []*armorypb.Item {
+	msgs := make([]*armorypb.Item, 0, len(items))
+	for _, item := range items {
+		attrs := gear.Attributes(item)
+
+		properties := make([]string, 0, len(attrs))
+		for _, a := range attrs {
+			properties = append(properties, gear.Describe(a))
+		}
+
+		msgs = append(msgs, &armorypb.Item{
+			Name:       gear.ItemName(item),
+			Type:       item.Type,
+			TypeName:   item.TypeName,
+			Quality:    gear.QualityName(item.Quality),
+			Location:   gear.Location(item),
+			Level:      int32(item.Level),
+			Ethereal:   item.Ethereal != 0,
+			Sockets:    int32(item.TotalNrOfSockets),
+			Defense:    int32(item.DefenseRating),
+			Quantity:   int32(item.Quantity),
+			Properties: properties,
+			Socketed:   itemsOf(item.SocketedItems),
+		})
+	}
+
+	return msgs
+}
+
+// statisticsOf converts the statistics to their protobuf message.
+func statisticsOf(stats *domain.CharacterStatistics) *armorypb.Statistics {
+	return &armorypb.Statistics{
+		Character: stats.Character,
+		Account:   stats.Account,
+		Normal:    difficultyOf(stats.Normal),
+		Nightmare: difficultyOf(stats.Nightmare),
+		Hell:      difficultyOf(stats.Hell),
+	}
+}
+
+// difficultyOf converts the statistics of a single difficulty to their protobuf message.
+func difficultyOf(stats domain.Stats) *armorypb.DifficultyStatistics {
+	return &armorypb.DifficultyStatistics{
+		TotalKills:       int32(stats.TotalKills),
+		TotalUniqueKills: int32(stats.TotalUniqueKills),
+		TotalChampKills:  int32(stats.TotalChampKills),
+		Areas:            areasOf(stats.Area),
+		SpecialMonsters:  monstersOf(stats.Special),
+	}
+}
+
+// areasOf converts the area statistics, the longest time spent first.
+func areasOf(areas map[string]domain.AreaStats) []*armorypb.AreaStatistics {
+	msgs := make([]*armorypb.AreaStatistics, 0, len(areas))
+	for name, a := range areas {
+		msgs = append(msgs, &armorypb.AreaStatistics{
+			Name:        name,
+			Kills:       uint32(a.Kills),
+			Time:        uint32(a.Time),
+			UniqueKills: uint32(a.UniqueKills),
+			ChampKills:  uint32(a.ChampKills),
+		})
+	}
+
+	sort.Slice(msgs, func(i, j int) bool {
+		if msgs[i].Time != msgs[j].Time {
+			return msgs[i].Time > msgs[j].Time
+		}
+		return msgs[i].Name < msgs[j].Name
+	})
+
+	return msgs
+}
+
+// monstersOf converts the special monster kills, the most kills first.
+func monstersOf(special map[string]int) []*armorypb.MonsterKills {
+	msgs := make([]*armorypb.MonsterKills, 0, len(special))
+	for name, kills := range special {
+		msgs = append(msgs, &armorypb.MonsterKills{Name: name, Kills: int32(kills)})
+	}
+
+	sort.Slice(msgs, func(i, j int) bool {
+		if msgs[i].Kills != msgs[j].Kills {
+			return msgs[i].Kills > msgs[j].Kills
+		}
+		return msgs[i].Name < msgs[j].Name
+	})
+
+	return msgs
+}
+
+// submissionOf converts the submitted statistics to their protobuf message.
+func submissionOf(stats *domain.StatisticsRequest) *armorypb.StatisticsSubmission {
+	return &armorypb.StatisticsSubmission{
+		Account:          stats.Account,
+		Character:        stats.Character,
+		Difficulty:       difficulties[stats.Difficulty],
+		TotalKills:       int32(stats.TotalKills),
+		TotalUniqueKills: int32(stats.TotalUniqueKills),
+		TotalChampKills:  int32(stats.TotalChampKills),
+		Areas:            areasOf(stats.Area),
+		SpecialMonsters:  monstersOf(stats.Special),
+	}
+}
+
+// submissionFrom converts the protobuf message to the submitted statistics,
+// an unspecified difficulty is left empty for the statistics service to reject.
+func submissionFrom(msg *armorypb.StatisticsSubmission) domain.StatisticsRequest {
+	stats := domain.StatisticsRequest{
+		Account:          msg.GetAccount(),
+		Character:        msg.GetCharacter(),
+		TotalKills:       int(msg.GetTotalKills()),
+		TotalUniqueKills: int(msg.GetTotalUniqueKills()),
+		TotalChampKills:  int(msg.GetTotalChampKills()),
+		Special:          make(map[string]int, len(msg.GetSpecialMonsters())),
+		Area:             make(map[string]domain.AreaStats, len(msg.GetAreas())),
+	}
+
+	for name, d := range difficulties {
+		if d == msg.GetDifficulty() {
+			stats.Difficulty = name
+		}
+	}
+
+	for _, m := range msg.GetSpecialMonsters() {
+		stats.Special[m.GetName()] = int(m.GetKills())
+	}
+
+	for _, a := range msg.GetAreas() {
+		stats.Area[a.GetName()] = domain.AreaStats{
+			Kills:       uint(a.GetKills()),
+			Time:        uint(a.GetTime()),
+			UniqueKills: uint(a.GetUniqueKills()),
+			ChampKills:  uint(a.GetChampKills()),
+		}
+	}
+
+	return stats
+}
+
+// updateOf converts the live update to its protobuf message.
+func updateOf(update domain.LiveUpdate) *armorypb.CharacterUpdate {
+	if update.Type == domain.LiveStatistics {
+		return &armorypb.CharacterUpdate{
+			Update: &armorypb.CharacterUpdate_Statistics{Statistics: submissionOf(update.Statistics)},
+		}
+	}
+
+	return &armorypb.CharacterUpdate{
+		Update: &armorypb.CharacterUpdate_Character{Character: characterOf(update.Character)},
+	}
+}
@@ -0,0 +1,156 @@
+// Package grpcserver serves characters and statistics over gRPC to internal consumers.
+package grpcserver
+
+import (
+	"context"
+	"log"
+	"net"
+	"sync"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+	"github.com/nokka/d2-armory-api/internal/live"
+	"github.com/nokka/d2-armory-api/pkg/armorypb"
+	"google.golang.org/grpc"
+)
+
+//go:generate moq -out ./server_mocks.go . characterService statisticsService visibilityService liveHub
+
+// characterService represents the functionality we need to get characters.
+type characterService interface {
+	Parse(ctx context.Context, name string) (*domain.Character, error)
+}
+
+// statisticsService represents the functionality we need to get, post and delete statistics.
+type statisticsService interface {
+	GetCharacter(ctx context.Context, character string) (*domain.CharacterStatistics, error)
+	Parse(ctx context.Context, stats []domain.StatisticsRequest) error
+	DeleteStats(ctx context.Context, character string) error
+}
+
+// visibilityService represents the functionality we need to honor the visibility of characters.
+type visibilityService interface {
+	Authorize(ctx context.Context, character string, token string) error
+}
+
+// liveHub represents the functionality we need to stream the updates of characters.
+type liveHub interface {
+	Subscribe(name string) (*live.Subscription, error)
+	Unsubscribe(sub *live.Subscription)
+	Close()
+}
+
+// Server is the gRPC server listener, running next to the HTTP server.
+type Server struct {
+	addr              string
+	token             string
+	characterService  characterService
+	statisticsService statisticsService
+	visibilityService visibilityService
+	liveHub           liveHub
+
+	mu     sync.Mutex
+	server *grpc.Server
+}
+
+// Option configures the optional parts of the server.
+type Option func(*Server)
+
+// WithVisibilityService enables per character visibility, without it every character is visible.
+func WithVisibilityService(visibilityService visibilityService) Option {
+	return func(s *Server) {
+		s.visibilityService = visibilityService
+	}
+}
+
+// WithLiveHub enables streaming the updates of characters.
+func WithLiveHub(liveHub liveHub) Option {
+	return func(s *Server) {
+		s.liveHub = liveHub
+	}
+}
+
+// Open will open a tcp listener to serve gRPC requests.
+func (s *Server) Open() error {
+	ln, err := net.Listen("tcp", s.addr)
+	if err != nil {
+		return err
+	}
+
+	server := s.newGRPCServer()
+
+	s.mu.Lock()
+	s.server = server
+	s.mu.Unlock()
+
+	log.Println("starting gRPC server on:", s.addr)
+
+	return server.Serve(ln)
+}
+
+// Shutdown gracefully stops the server, streams are ended and the calls
+// in flight are given until the context is done to complete.
+func (s *Server) Shutdown(ctx context.Context) error {
+	s.mu.Lock()
+	server := s.server
+	s.mu.Unlock()
+
+	if server == nil {
+		return nil
+	}
+
+	if s.liveHub != nil {
+		// Streams would otherwise keep the server from stopping.
+		s.liveHub.Close()
+	}
+
+	stopped := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		return nil
+	case <-ctx.Done():
+		server.Stop()
+		return ctx.Err()
+	}
+}
+
+// newGRPCServer returns a gRPC server with the armory service registered,
+// every call is authenticated with the token of the server.
+func (s *Server) newGRPCServer() *grpc.Server {
+	auth := authenticator{token: s.token}
+
+	server := grpc.NewServer(
+		grpc.UnaryInterceptor(auth.Unary),
+		grpc.StreamInterceptor(auth.Stream),
+	)
+
+	armorypb.RegisterArmoryServer(server, &armoryServer{
+		characterService:  s.characterService,
+		statisticsService: s.statisticsService,
+		visibilityService: s.visibilityService,
+		liveHub:           s.liveHub,
+	})
+
+	return server
+}
+
+// NewServer returns a new server with all dependencies, every call must be
+// made with the token.
+func NewServer(addr string, characterService characterService, statisticsService statisticsService, token string, opts ...Option) *Server {
+	s := &Server{
+		addr:              addr,
+		token:             token,
+		characterService:  characterService,
+		statisticsService: statisticsService,
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
@@ -0,0 +1,356 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+	"github.com/nokka/d2-armory-api/internal/live"
+	"github.com/nokka/d2-armory-api/pkg/armorypb"
+	"github.com/nokka/d2s"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const testToken = "secret"
+
+// testCharacter returns a parsed sorceress with a single item.
+func testCharacter(name string) *domain.Character {
+	return &domain.Character{
+		ID: name,
+		D2s: &d2s.Character{
+			Header: d2s.Header{
+				Class:  1,
+				Level:  90,
+				Status: 0x24,
+			},
+			Attributes: d2s.Attributes{Strength: 35, Experience: 3520485254},
+			Skills:     []d2s.Skill{{ID: 59, Name: "Blizzard", Points: 20}},
+			Items: []d2s.Item{
+				{LocationID: 1, EquippedID: 1, Type: "uap", TypeName: "Shako", UniqueName: "Harlequin Crest", Quality: 7},
+			},
+		},
+		Info: &domain.CharacterInfo{Account: "Nokka", Realm: "europe"},
+	}
+}
+
+// characters returns a character service with nokka and meanie, meanie being private.
+func characters() *characterServiceMock {
+	return &characterServiceMock{
+		ParseFunc: func(ctx context.Context, name string) (*domain.Character, error) {
+			switch name {
+			case "nokka", "meanie":
+				return testCharacter(name), nil
+			}
+			return nil, fmt.Errorf("character %s: %w", name, domain.ErrNotFound)
+		},
+	}
+}
+
+// visibility makes meanie private, visible with the token "share".
+func visibility() *visibilityServiceMock {
+	return &visibilityServiceMock{
+		AuthorizeFunc: func(ctx context.Context, character string, token string) error {
+			if character == "meanie" && token != "share" {
+				return fmt.Errorf("character %s: %w", character, domain.ErrNotFound)
+			}
+			return nil
+		},
+	}
+}
+
+// dial serves the server over an in memory listener and returns a client
+// authenticated with the token.
+func dial(t *testing.T, s *Server, token string) armorypb.ArmoryClient {
+	t.Helper()
+
+	ln := bufconn.Listen(1 << 20)
+	server := s.newGRPCServer()
+	go func() {
+		_ = server.Serve(ln)
+	}()
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return ln.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithPerRPCCredentials(bearer(token)),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	t.Cleanup(func() {
+		conn.Close()
+		server.Stop()
+	})
+
+	return armorypb.NewArmoryClient(conn)
+}
+
+// bearer sends the token as the authorization metadata of every call.
+type bearer string
+
+func (b bearer) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	if b == "" {
+		return nil, nil
+	}
+	return map[string]string{"authorization": bearerPrefix + string(b)}, nil
+}
+
+func (b bearer) RequireTransportSecurity() bool {
+	return false
+}
+
+func TestAuthentication(t *testing.T) {
+	tests := []struct {
+		name        string
+		serverToken string
+		clientToken string
+		code        codes.Code
+	}{
+		{name: "valid token", serverToken: testToken, clientToken: testToken, code: codes.OK},
+		{name: "missing token", serverToken: testToken, clientToken: "", code: codes.Unauthenticated},
+		{name: "invalid token", serverToken: testToken, clientToken: "guess", code: codes.Unauthenticated},
+		{name: "unconfigured token", serverToken: "", clientToken: "", code: codes.Unauthenticated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := dial(t, NewServer("", characters(), &statisticsServiceMock{}, tt.serverToken), tt.clientToken)
+
+			_, err := client.GetCharacter(context.Background(), &armorypb.GetCharacterRequest{Name: "nokka"})
+			if code := status.Code(err); code != tt.code {
+				t.Errorf("expected code %s, got = %s (%v)", tt.code, code, err)
+			}
+		})
+	}
+}
+
+func TestGetCharacter(t *testing.T) {
+	tests := []struct {
+		name  string
+		req   *armorypb.GetCharacterRequest
+		code  codes.Code
+		class string
+	}{
+		{name: "public character", req: &armorypb.GetCharacterRequest{Name: "nokka"}, code: codes.OK, class: "Sorceress"},
+		{name: "private character with token", req: &armorypb.GetCharacterRequest{Name: "meanie", Token: "share"}, code: codes.OK, class: "Sorceress"},
+		{name: "private character without token", req: &armorypb.GetCharacterRequest{Name: "meanie"}, code: codes.NotFound},
+		{name: "missing character", req: &armorypb.GetCharacterRequest{Name: "ghost"}, code: codes.NotFound},
+	}
+
+	client := dial(t, NewServer("", characters(), &statisticsServiceMock{}, testToken, WithVisibilityService(visibility())), testToken)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := client.GetCharacter(context.Background(), tt.req)
+			if code := status.Code(err); code != tt.code {
+				t.Fatalf("expected code %s, got = %s (%v)", tt.code, code, err)
+			}
+
+			if tt.code != codes.OK {
+				return
+			}
+
+			if c.GetClass() != tt.class {
+				t.Errorf("expected class %s, got = %s", tt.class, c.GetClass())
+			}
+
+			if c.GetAccount() != "Nokka" || len(c.GetItems()) != 1 || c.GetItems()[0].GetName() != "Harlequin Crest" {
+				t.Errorf("unexpected character: %v", c)
+			}
+		})
+	}
+}
+
+func TestBatchGetCharacters(t *testing.T) {
+	client := dial(t, NewServer("", characters(), &statisticsServiceMock{}, testToken, WithVisibilityService(visibility())), testToken)
+
+	resp, err := client.BatchGetCharacters(context.Background(), &armorypb.BatchGetCharactersRequest{
+		Names: []string{"ghost", "nokka", "meanie"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []struct {
+		name string
+		code codes.Code
+	}{
+		{name: "ghost", code: codes.NotFound},
+		{name: "nokka", code: codes.OK},
+		{name: "meanie", code: codes.NotFound},
+	}
+
+	if len(resp.GetResults()) != len(expected) {
+		t.Fatalf("expected %d results, got = %d", len(expected), len(resp.GetResults()))
+	}
+
+	for i, e := range expected {
+		r := resp.GetResults()[i]
+		if r.GetName() != e.name || codes.Code(r.GetCode()) != e.code || (r.GetCharacter() != nil) != (e.code == codes.OK) {
+			t.Errorf("expected %s with code %s, got = %v", e.name, e.code, r)
+		}
+	}
+
+	names := make([]string, maxBatchSize+1)
+	if _, err := client.BatchGetCharacters(context.Background(), &armorypb.BatchGetCharactersRequest{Names: names}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected too many names to be rejected, got = %v", err)
+	}
+}
+
+func TestStatistics(t *testing.T) {
+	stats := &statisticsServiceMock{
+		ParseFunc: func(ctx context.Context, stats []domain.StatisticsRequest) error {
+			for _, s := range stats {
+				if s.Difficulty == "" {
+					return fmt.Errorf("difficulty: %w", domain.ErrRequest)
+				}
+			}
+			return nil
+		},
+		GetCharacterFunc: func(ctx context.Context, character string) (*domain.CharacterStatistics, error) {
+			return &domain.CharacterStatistics{
+				Character: character,
+				Hell: domain.Stats{
+					TotalKills: 12,
+					Special:    map[string]int{"Baal": 2, "Diablo": 5},
+				},
+			}, nil
+		},
+		DeleteStatsFunc: func(ctx context.Context, character string) error {
+			return nil
+		},
+	}
+
+	client := dial(t, NewServer("", characters(), stats, testToken), testToken)
+	ctx := context.Background()
+
+	_, err := client.PostStatistics(ctx, &armorypb.PostStatisticsRequest{
+		Statistics: []*armorypb.StatisticsSubmission{{
+			Character:       "nokka",
+			Difficulty:      armorypb.Difficulty_DIFFICULTY_NIGHTMARE,
+			TotalKills:      3,
+			Areas:           []*armorypb.AreaStatistics{{Name: "Chaos Sanctuary", Kills: 3, Time: 60}},
+			SpecialMonsters: []*armorypb.MonsterKills{{Name: "Diablo", Kills: 1}},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	posted := stats.ParseCalls()[0].Stats[0]
+	if posted.Difficulty != domain.DifficultyNightmare || posted.Area["Chaos Sanctuary"].Time != 60 || posted.Special["Diablo"] != 1 {
+		t.Errorf("unexpected statistics posted: %+v", posted)
+	}
+
+	_, err = client.PostStatistics(ctx, &armorypb.PostStatisticsRequest{
+		Statistics: []*armorypb.StatisticsSubmission{{Character: "nokka"}},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected an unspecified difficulty to be rejected, got = %v", err)
+	}
+
+	got, err := client.GetStatistics(ctx, &armorypb.GetStatisticsRequest{Character: "nokka"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	monsters := got.GetHell().GetSpecialMonsters()
+	if len(monsters) != 2 || monsters[0].GetName() != "Diablo" || got.GetHell().GetTotalKills() != 12 {
+		t.Errorf("unexpected statistics: %v", got)
+	}
+
+	if _, err := client.DeleteStatistics(ctx, &armorypb.DeleteStatisticsRequest{Character: "nokka"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if calls := stats.DeleteStatsCalls(); len(calls) != 1 || calls[0].Character != "nokka" {
+		t.Errorf("expected the statistics of nokka to be deleted, got = %v", calls)
+	}
+}
+
+func TestWatchCharacter(t *testing.T) {
+	hub := live.NewHub(10, 10)
+
+	client := dial(t, NewServer("", characters(), &statisticsServiceMock{}, testToken, WithLiveHub(hub)), testToken)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream, err := client.WatchCharacter(ctx, &armorypb.WatchCharacterRequest{Name: "nokka"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	update, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if update.GetCharacter().GetName() != "nokka" {
+		t.Errorf("expected the character first, got = %v", update)
+	}
+
+	_ = hub.StatisticsSubmitted(ctx, nil, domain.StatisticsRequest{Character: "nokka", Difficulty: domain.DifficultyHell})
+
+	update, err = stream.Recv()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if update.GetStatistics().GetDifficulty() != armorypb.Difficulty_DIFFICULTY_HELL {
+		t.Errorf("expected the statistics, got = %v", update)
+	}
+
+	hub.Close()
+
+	if _, err := stream.Recv(); status.Code(err) != codes.Unavailable {
+		t.Errorf("expected the stream to end when the hub closes, got = %v", err)
+	}
+}
+
+func TestWatchCharacterUnauthorized(t *testing.T) {
+	client := dial(t, NewServer("", characters(), &statisticsServiceMock{}, testToken, WithVisibilityService(visibility()), WithLiveHub(live.NewHub(10, 10))), testToken)
+
+	stream, err := client.WatchCharacter(context.Background(), &armorypb.WatchCharacterRequest{Name: "meanie"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := stream.Recv(); status.Code(err) != codes.NotFound {
+		t.Errorf("expected a private character to be hidden, got = %v", err)
+	}
+}
+
+func TestStatusOf(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		err     error
+		code    codes.Code
+		message string
+	}{
+		{name: "not found", err: fmt.Errorf("character ghost: %w", domain.ErrNotFound), code: codes.NotFound, message: "character ghost: resource was not found"},
+		{name: "invalid argument", err: domain.ErrInvalidArgument, code: codes.InvalidArgument, message: "invalid argument"},
+		{name: "internal", err: fmt.Errorf("unspecified error: auth failed for mongo://armory:hunter2@db, %w", domain.ErrInternal), code: codes.Internal, message: serverErrorMessage},
+		{name: "unknown", err: errors.New("open /var/d2s/nokka: permission denied"), code: codes.Internal, message: serverErrorMessage},
+		{name: "temporary", err: fmt.Errorf("temporary error while performing query: connection reset by 10.0.0.3, %w", domain.ErrTemporary), code: codes.Unavailable, message: serverErrorMessage},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			st := status.Convert(statusOf(tt.err))
+
+			if st.Code() != tt.code || st.Message() != tt.message {
+				t.Errorf("statusOf() = %s %q, want %s %q", st.Code(), st.Message(), tt.code, tt.message)
+			}
+		})
+	}
+}
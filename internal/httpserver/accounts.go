@@ -0,0 +1,52 @@
+package httpserver
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"github.com/nokka/d2-armory-api/internal/domain"
+)
+
+// accountService represents the functionality we need to get accounts.
+type accountService interface {
+	// Get gets the account with all characters except the excluded ones.
+	Get(ctx context.Context, name string, exclude map[string]struct{}) (*domain.Account, error)
+}
+
+// accountHandler is used to get accounts and the characters on them.
+type accountHandler struct {
+	encoder        *encoder
+	accountService accountService
+	visibility     visibilityGuard
+}
+
+func (h accountHandler) Routes(router chi.Router) {
+	router.Get("/{account}", h.getAccount)
+}
+
+func (h accountHandler) getAccount(w http.ResponseWriter, r *http.Request) {
+	// Only public characters are shown on accounts.
+	hidden, err := h.visibility.Hidden(r.Context())
+	if err != nil {
+		h.encoder.Error(w, err)
+		return
+	}
+
+	// Pass the request context in order to make use of cancellation for lower level work.
+	account, err := h.accountService.Get(r.Context(), chi.URLParam(r, "account"), hidden)
+	if err != nil {
+		h.encoder.Error(w, err)
+		return
+	}
+
+	h.encoder.Response(w, account)
+}
+
+func newAccountHandler(encoder *encoder, accountService accountService, visibility visibilityGuard) *accountHandler {
+	return &accountHandler{
+		encoder:        encoder,
+		accountService: accountService,
+		visibility:     visibility,
+	}
+}
@@ -0,0 +1,76 @@
+package httpserver
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"github.com/nokka/d2-armory-api/internal/domain"
+)
+
+// achievementService represents the functionality we need to get achievements.
+type achievementService interface {
+	Character(ctx context.Context, name string) (*domain.CharacterAchievements, error)
+	List(ctx context.Context, exclude map[string]struct{}) ([]domain.AchievementSummary, error)
+}
+
+// achievementHandler is used to get the achievements of characters and the realm.
+type achievementHandler struct {
+	encoder            *encoder
+	achievementService achievementService
+	visibility         visibilityGuard
+}
+
+// Routes are the achievements of the realm.
+func (h achievementHandler) Routes(router chi.Router) {
+	router.Get("/", h.listAchievements)
+}
+
+// CharacterRoutes are the achievements of a single character.
+func (h achievementHandler) CharacterRoutes(router chi.Router) {
+	router.Get("/", h.getCharacterAchievements)
+}
+
+func (h achievementHandler) listAchievements(w http.ResponseWriter, r *http.Request) {
+	// Unlisted and private characters aren't counted.
+	hidden, err := h.visibility.Hidden(r.Context())
+	if err != nil {
+		h.encoder.Error(w, err)
+		return
+	}
+
+	// Pass the request context in order to make use of cancellation for lower level work.
+	achievements, err := h.achievementService.List(r.Context(), hidden)
+	if err != nil {
+		h.encoder.Error(w, err)
+		return
+	}
+
+	h.encoder.Response(w, achievements)
+}
+
+func (h achievementHandler) getCharacterAchievements(w http.ResponseWriter, r *http.Request) {
+	name := chi.URLParam(r, "name")
+
+	if err := h.visibility.Authorize(r, name); err != nil {
+		h.encoder.Error(w, err)
+		return
+	}
+
+	// Pass the request context in order to make use of cancellation for lower level work.
+	achievements, err := h.achievementService.Character(r.Context(), name)
+	if err != nil {
+		h.encoder.Error(w, err)
+		return
+	}
+
+	h.encoder.Response(w, achievements)
+}
+
+func newAchievementHandler(encoder *encoder, achievementService achievementService, visibility visibilityGuard) *achievementHandler {
+	return &achievementHandler{
+		encoder:            encoder,
+		achievementService: achievementService,
+		visibility:         visibility,
+	}
+}
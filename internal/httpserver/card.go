@@ -0,0 +1,63 @@
+package httpserver
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi"
+)
+
+// cardService represents the functionality we need to render character cards.
+type cardService interface {
+	// Render renders the card of the character as a PNG, returning when the character was last parsed.
+	Render(ctx context.Context, name string, template string, size string) ([]byte, time.Time, error)
+}
+
+// cardHandler is used to get the signature image of a character.
+type cardHandler struct {
+	encoder     *encoder
+	cardService cardService
+	visibility  visibilityGuard
+}
+
+func (h cardHandler) Routes(router chi.Router) {
+	router.Get("/", h.getCard)
+}
+
+func (h cardHandler) getCard(w http.ResponseWriter, r *http.Request) {
+	name := chi.URLParam(r, "name")
+
+	if err := h.visibility.Authorize(r, name); err != nil {
+		h.encoder.Error(w, err)
+		return
+	}
+
+	query := r.URL.Query()
+
+	// Pass the request context in order to make use of cancellation for lower level work.
+	img, modified, err := h.cardService.Render(r.Context(), name, query.Get("template"), query.Get("size"))
+	if err != nil {
+		h.encoder.Error(w, err)
+		return
+	}
+
+	// Cards are embedded on forums, let them be cached for a while and revalidated,
+	// cards of private characters are served by share token and mustn't end up in shared caches.
+	cacheControl := "public, max-age=60"
+	if h.visibility.Private(r.Context(), name) {
+		cacheControl = "private, max-age=60"
+	}
+
+	w.Header().Set("Cache-Control", cacheControl)
+	http.ServeContent(w, r, "card.png", modified, bytes.NewReader(img))
+}
+
+func newCardHandler(encoder *encoder, cardService cardService, visibility visibilityGuard) *cardHandler {
+	return &cardHandler{
+		encoder:     encoder,
+		cardService: cardService,
+		visibility:  visibility,
+	}
+}
@@ -2,9 +2,11 @@ package httpserver
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 
 	"github.com/go-chi/chi"
+	"github.com/go-chi/chi/middleware"
 	"github.com/nokka/d2-armory-api/internal/domain"
 )
 
@@ -12,21 +14,52 @@ import (
 type characterService interface {
 	// Parse parses a character binary.
 	Parse(ctx context.Context, name string) (*domain.Character, error)
+
+	// List lists the characters available on disk.
+	List(ctx context.Context, opts domain.ListOptions) (*domain.CharacterList, error)
+
+	// Delete deletes the character and its statistics.
+	Delete(ctx context.Context, name string) error
 }
 
 // characterHandler is used to put parse characters.
 type characterHandler struct {
 	encoder          *encoder
 	characterService characterService
+	visibility       visibilityGuard
+	locale           localizer
+	adminCredentials map[string]string
 }
 
 func (h characterHandler) Routes(router chi.Router) {
 	router.Get("/", h.parseCharacter)
+
+	// Deleting characters, e.g. for takedown requests, requires admin authentication.
+	router.With(middleware.BasicAuth("admin", h.adminCredentials)).Delete("/{name}", h.deleteCharacter)
+}
+
+// DeprecatedRoutes are the routes still served under the deprecated path, only the
+// ones that existed when the path was deprecated.
+func (h characterHandler) DeprecatedRoutes(router chi.Router) {
+	router.Get("/", h.parseCharacter)
 }
 
 func (h characterHandler) parseCharacter(w http.ResponseWriter, r *http.Request) {
 	name := r.URL.Query().Get("name")
 
+	// Without a name, list the characters that are available instead.
+	if name == "" {
+		h.listCharacters(w, r)
+		return
+	}
+
+	if err := h.visibility.Authorize(r, name); err != nil {
+		h.encoder.Error(w, err)
+		return
+	}
+
+	lang := h.locale.Language(w, r)
+
 	// Pass the request context in order to make use of cancellation for lower level work.
 	char, err := h.characterService.Parse(r.Context(), name)
 	if err != nil {
@@ -37,13 +70,75 @@ func (h characterHandler) parseCharacter(w http.ResponseWriter, r *http.Request)
 	h.encoder.Response(w, struct {
 		Character *domain.Character `json:"character"`
 	}{
-		Character: char,
+		Character: h.locale.Character(lang, char),
 	})
 }
 
-func newCharacterHandler(encoder *encoder, characterService characterService) *characterHandler {
+func (h characterHandler) listCharacters(w http.ResponseWriter, r *http.Request) {
+	offset, err := queryInt(r, "offset", 0)
+	if err != nil {
+		h.encoder.Error(w, err)
+		return
+	}
+
+	limit, err := queryInt(r, "limit", 0)
+	if err != nil {
+		h.encoder.Error(w, err)
+		return
+	}
+
+	sort := r.URL.Query().Get("sort")
+	order := r.URL.Query().Get("order")
+
+	if order != "" && order != "asc" && order != "desc" {
+		h.encoder.Error(w, fmt.Errorf("order must be asc or desc: %w", domain.ErrRequest))
+		return
+	}
+
+	// Recently saved characters first, unless asked otherwise.
+	descending := order == "desc" || (order == "" && sort != domain.SortName)
+
+	// Unlisted and private characters are left out.
+	hidden, err := h.visibility.Hidden(r.Context())
+	if err != nil {
+		h.encoder.Error(w, err)
+		return
+	}
+
+	list, err := h.characterService.List(r.Context(), domain.ListOptions{
+		Offset:     offset,
+		Limit:      limit,
+		Sort:       sort,
+		Descending: descending,
+		Exclude:    hidden,
+	})
+	if err != nil {
+		h.encoder.Error(w, err)
+		return
+	}
+
+	h.encoder.Response(w, list)
+}
+
+func (h characterHandler) deleteCharacter(w http.ResponseWriter, r *http.Request) {
+	name := chi.URLParam(r, "name")
+
+	// Pass the request context in order to make use of cancellation for lower level work.
+	err := h.characterService.Delete(r.Context(), name)
+	if err != nil {
+		h.encoder.Error(w, err)
+		return
+	}
+
+	h.encoder.StatusResponse(w, map[string]string{"status": "ok"}, http.StatusOK)
+}
+
+func newCharacterHandler(encoder *encoder, characterService characterService, visibility visibilityGuard, locale localizer, adminCredentials map[string]string) *characterHandler {
 	return &characterHandler{
 		encoder:          encoder,
 		characterService: characterService,
+		visibility:       visibility,
+		locale:           locale,
+		adminCredentials: adminCredentials,
 	}
 }
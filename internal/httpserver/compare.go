@@ -0,0 +1,66 @@
+package httpserver
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"github.com/nokka/d2-armory-api/internal/domain"
+)
+
+// compareService represents the functionality we need to compare characters.
+type compareService interface {
+	Compare(ctx context.Context, a string, b string) (*domain.Comparison, error)
+}
+
+// compareHandler is used to compare two characters side by side.
+type compareHandler struct {
+	encoder        *encoder
+	compareService compareService
+	visibility     visibilityGuard
+}
+
+func (h compareHandler) Routes(router chi.Router) {
+	router.Get("/", h.compare)
+}
+
+func (h compareHandler) compare(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	a := query.Get("a")
+	b := query.Get("b")
+
+	// Both characters must be visible to the request, private characters are authorized
+	// with their own share token, or the token of both when they share one.
+	for _, c := range []struct{ name, token string }{{a, query.Get("token_a")}, {b, query.Get("token_b")}} {
+		if c.name == "" {
+			continue
+		}
+
+		token := c.token
+		if token == "" {
+			token = query.Get("token")
+		}
+
+		if err := h.visibility.AuthorizeToken(r.Context(), c.name, token); err != nil {
+			h.encoder.Error(w, err)
+			return
+		}
+	}
+
+	// Pass the request context in order to make use of cancellation for lower level work.
+	comparison, err := h.compareService.Compare(r.Context(), a, b)
+	if err != nil {
+		h.encoder.Error(w, err)
+		return
+	}
+
+	h.encoder.Response(w, comparison)
+}
+
+func newCompareHandler(encoder *encoder, compareService compareService, visibility visibilityGuard) *compareHandler {
+	return &compareHandler{
+		encoder:        encoder,
+		compareService: compareService,
+		visibility:     visibility,
+	}
+}
@@ -0,0 +1,67 @@
+package httpserver
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+)
+
+// tokenVisibility is a visibility service where every character is private,
+// visible with its name reversed as the share token.
+type tokenVisibility struct{ visibilityService }
+
+func (tokenVisibility) Authorize(ctx context.Context, character string, token string) error {
+	reversed := []rune(character)
+	for i, j := 0, len(reversed)-1; i < j; i, j = i+1, j-1 {
+		reversed[i], reversed[j] = reversed[j], reversed[i]
+	}
+
+	if token != string(reversed) {
+		return fmt.Errorf("character %s: %w", character, domain.ErrNotFound)
+	}
+
+	return nil
+}
+
+type comparison struct{}
+
+func (comparison) Compare(ctx context.Context, a string, b string) (*domain.Comparison, error) {
+	return &domain.Comparison{}, nil
+}
+
+func TestCompareTokens(t *testing.T) {
+	srv := NewServer(":80", nil, nil, nil, false, false,
+		WithVisibilityService(tokenVisibility{}),
+		WithCompareService(comparison{}),
+	)
+
+	tests := []struct {
+		name  string
+		query string
+		want  int
+	}{
+		{name: "no tokens", query: "a=nokka&b=meanski", want: http.StatusNotFound},
+		{name: "separate tokens", query: "a=nokka&b=meanski&token_a=akkon&token_b=iksnaem", want: http.StatusOK},
+		{name: "swapped tokens", query: "a=nokka&b=meanski&token_a=iksnaem&token_b=akkon", want: http.StatusNotFound},
+		{name: "shared token", query: "a=nokka&b=nokka&token=akkon", want: http.StatusOK},
+		{name: "shared token for one", query: "a=nokka&b=meanski&token=akkon&token_b=iksnaem", want: http.StatusOK},
+		{name: "only the first token", query: "a=nokka&b=meanski&token_a=akkon", want: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/api/v1/compare?"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			srv.Handler().ServeHTTP(w, r)
+
+			if w.Code != tt.want {
+				t.Errorf("expected status %d, got %d: %s", tt.want, w.Code, w.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package httpserver
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"github.com/nokka/d2-armory-api/internal/domain"
+)
+
+// craftService represents the functionality we need to get craftable runewords.
+type craftService interface {
+	// Get gets the runewords the character can make.
+	Get(ctx context.Context, name string) (*domain.Craftable, error)
+}
+
+// craftHandler is used to get the runewords a character can make.
+type craftHandler struct {
+	encoder      *encoder
+	craftService craftService
+	visibility   visibilityGuard
+}
+
+func (h craftHandler) Routes(router chi.Router) {
+	router.Get("/", h.getCraftable)
+}
+
+func (h craftHandler) getCraftable(w http.ResponseWriter, r *http.Request) {
+	name := chi.URLParam(r, "name")
+
+	if err := h.visibility.Authorize(r, name); err != nil {
+		h.encoder.Error(w, err)
+		return
+	}
+
+	// Pass the request context in order to make use of cancellation for lower level work.
+	craftable, err := h.craftService.Get(r.Context(), name)
+	if err != nil {
+		h.encoder.Error(w, err)
+		return
+	}
+
+	h.encoder.Response(w, craftable)
+}
+
+func newCraftHandler(encoder *encoder, craftService craftService, visibility visibilityGuard) *craftHandler {
+	return &craftHandler{
+		encoder:      encoder,
+		craftService: craftService,
+		visibility:   visibility,
+	}
+}
@@ -3,7 +3,11 @@ package httpserver
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
+	"log"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/nokka/d2-armory-api/internal/domain"
 )
@@ -31,32 +35,132 @@ func (e *encoder) StatusResponse(w http.ResponseWriter, response interface{}, st
 	}
 }
 
-// errorResponse will encapsulate errors to be transferred over http.
-type errorResponse struct {
-	Error string `json:"error"`
+// contentTypeProblem is the content type of errors, as described by RFC 7807.
+const contentTypeProblem = "application/problem+json"
+
+// problemTypePrefix prefixes the code of a problem to form its type.
+const problemTypePrefix = "urn:d2-armory:problem:"
+
+// retryAfter is how long clients are told to wait before retrying temporary errors.
+const retryAfter = 5 * time.Second
+
+// The stable codes of problems, clients may rely on them never changing.
+const (
+	codeInvalidRequest  = "invalid_request"
+	codeInvalidArgument = "invalid_argument"
+	codeInvalidJSON     = "invalid_json"
+	codeNotFound        = "not_found"
+	codeGone            = "gone"
+	codeConflict        = "conflict"
+	codeMethod          = "method_not_allowed"
+	codeUnavailable     = "unavailable"
+	codeTemporary       = "temporary"
+	codeInternal        = "internal"
+)
+
+// problem is an error transferred over http, as described by RFC 7807.
+type problem struct {
+	Type   string `json:"type"`
+	Title  string `json:"title"`
+	Status int    `json:"status"`
+	Code   string `json:"code"`
+	Detail string `json:"detail,omitempty"`
+}
+
+// problemKind is the status and code of a domain error.
+type problemKind struct {
+	err    error
+	status int
+	code   string
+}
+
+// problemKinds are matched in order against every error in the chain.
+var problemKinds = []problemKind{
+	{domain.ErrTemporary, http.StatusServiceUnavailable, codeTemporary},
+	{domain.ErrUnavailable, http.StatusServiceUnavailable, codeUnavailable},
+	{domain.ErrRequest, http.StatusBadRequest, codeInvalidRequest},
+	{domain.ErrInvalidArgument, http.StatusBadRequest, codeInvalidArgument},
+	{domain.ErrNotFound, http.StatusNotFound, codeNotFound},
+	{domain.ErrGone, http.StatusGone, codeGone},
+	{domain.ErrConflict, http.StatusConflict, codeConflict},
+	{errMethodNotAllowed, http.StatusMethodNotAllowed, codeMethod},
 }
 
-// encodeError will determine status code and content sent over the API.
+// errMethodNotAllowed is returned when a route doesn't serve the method of the request.
+var errMethodNotAllowed = errors.New("method not allowed")
+
+// bodyError is returned when the body of a request can't be decoded, it's a
+// request error that keeps the cause so invalid JSON can be told apart.
+type bodyError struct {
+	body string
+	err  error
+}
+
+func (e bodyError) Error() string {
+	return fmt.Sprintf("invalid %s body: %s", e.body, e.err)
+}
+
+func (e bodyError) Unwrap() error {
+	return e.err
+}
+
+// Is makes the error match domain.ErrRequest, the cause is matched through Unwrap.
+func (e bodyError) Is(target error) bool {
+	return target == domain.ErrRequest
+}
+
+// invalidBody returns the error of a body that couldn't be decoded.
+func invalidBody(body string, err error) error {
+	return bodyError{body: body, err: err}
+}
+
+// problemOf determines the problem told to the client about the error, the
+// detail of server errors is left out since it's of no use to the client.
+func problemOf(err error) problem {
+	p := problem{Status: http.StatusInternalServerError, Code: codeInternal}
+
+	var (
+		syntaxErr    *json.SyntaxError
+		unmarshalErr *json.UnmarshalTypeError
+	)
+
+	switch {
+	case errors.As(err, &syntaxErr), errors.As(err, &unmarshalErr):
+		p.Status, p.Code = http.StatusBadRequest, codeInvalidJSON
+	default:
+		for _, kind := range problemKinds {
+			if errors.Is(err, kind.err) {
+				p.Status, p.Code = kind.status, kind.code
+				break
+			}
+		}
+	}
+
+	p.Type = problemTypePrefix + p.Code
+	p.Title = http.StatusText(p.Status)
+
+	if p.Status < http.StatusInternalServerError {
+		p.Detail = err.Error()
+	}
+
+	return p
+}
+
+// Error will determine status code and content sent over the API.
 func (e *encoder) Error(w http.ResponseWriter, err error) {
-	resp := errorResponse{
-		Error: err.Error(),
+	p := problemOf(err)
+
+	if p.Status >= http.StatusInternalServerError {
+		log.Printf("%s error: %s", p.Code, err)
 	}
 
-	w.Header().Set("Content-type", "application/json; charset=utf-8")
-	if errors.Is(err, domain.ErrTemporary) {
+	if p.Code == codeTemporary {
+		w.Header().Set("Retry-After", strconv.Itoa(int(retryAfter/time.Second)))
 		w.Header().Set("x-temporary", "true")
 	}
 
-	switch errors.Unwrap(err) {
-	case domain.ErrRequest:
-		w.WriteHeader(http.StatusBadRequest)
-	case domain.ErrNotFound:
-		w.WriteHeader(http.StatusNotFound)
-	case domain.ErrUnavailable:
-		w.WriteHeader(http.StatusServiceUnavailable)
-	default:
-		w.WriteHeader(http.StatusInternalServerError)
-	}
+	w.Header().Set("Content-Type", contentTypeProblem)
+	w.WriteHeader(p.Status)
 
-	_ = json.NewEncoder(w).Encode(resp)
+	_ = json.NewEncoder(w).Encode(p)
 }
@@ -3,9 +3,13 @@ package httpserver
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
 )
 
 func TestEncoderResponse(t *testing.T) {
@@ -33,22 +37,99 @@ func TestEncoderResponse(t *testing.T) {
 func TestEncoderError(t *testing.T) {
 	e := &encoder{}
 
-	t.Run("different status codes for known errors", func(t *testing.T) {
-		for _, tt := range []struct {
-			err  error
-			want int
-		}{
-			{errors.New("something went terribly wrong"), http.StatusInternalServerError},
-		} {
-			tt := tt
-
+	for _, tt := range []struct {
+		name       string
+		err        error
+		status     int
+		code       string
+		detail     string
+		retryAfter string
+	}{
+		{name: "unknown", err: errors.New("something went terribly wrong"), status: http.StatusInternalServerError, code: codeInternal},
+		{name: "internal detail", err: fmt.Errorf("unspecified error: connection reset, %w", domain.ErrInternal), status: http.StatusInternalServerError, code: codeInternal},
+		{name: "gone", err: fmt.Errorf("character was deleted: %w", domain.ErrGone), status: http.StatusGone, code: codeGone, detail: "character was deleted: resource is gone"},
+		{name: "invalid argument", err: domain.ErrInvalidArgument, status: http.StatusBadRequest, code: codeInvalidArgument, detail: "invalid argument"},
+		{name: "wrapped twice", err: fmt.Errorf("parse nokka: %w", fmt.Errorf("character nokka: %w", domain.ErrNotFound)), status: http.StatusNotFound, code: codeNotFound, detail: "parse nokka: character nokka: resource was not found"},
+		{name: "conflict", err: fmt.Errorf("webhook exists: %w", domain.ErrConflict), status: http.StatusConflict, code: codeConflict, detail: "webhook exists: conflict error"},
+		{name: "json syntax", err: json.Unmarshal([]byte(`[{`), &struct{}{}), status: http.StatusBadRequest, code: codeInvalidJSON, detail: "unexpected end of JSON input"},
+		{name: "json type", err: json.Unmarshal([]byte(`{"Foo":"bar"}`), &struct{ Foo int }{}), status: http.StatusBadRequest, code: codeInvalidJSON},
+		{name: "invalid json body", err: invalidBody("statistics", json.Unmarshal([]byte(`[{`), &struct{}{})), status: http.StatusBadRequest, code: codeInvalidJSON, detail: "invalid statistics body: unexpected end of JSON input"},
+		{name: "empty body", err: invalidBody("statistics", io.EOF), status: http.StatusBadRequest, code: codeInvalidRequest, detail: "invalid statistics body: EOF"},
+		{name: "method not allowed", err: fmt.Errorf("PATCH /health: %w", errMethodNotAllowed), status: http.StatusMethodNotAllowed, code: codeMethod},
+		{name: "unavailable", err: fmt.Errorf("too many listeners: %w", domain.ErrUnavailable), status: http.StatusServiceUnavailable, code: codeUnavailable},
+		{name: "temporary", err: fmt.Errorf("dial error: %w", domain.ErrTemporary), status: http.StatusServiceUnavailable, code: codeTemporary, retryAfter: "5"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
 			w := httptest.NewRecorder()
 
 			e.Error(w, tt.err)
 
-			if got := w.Code; got != tt.want {
-				t.Fatalf("w.Code = %d, want %d", got, tt.want)
+			if got := w.Code; got != tt.status {
+				t.Fatalf("w.Code = %d, want %d", got, tt.status)
+			}
+
+			if got := w.Header().Get("Content-Type"); got != contentTypeProblem {
+				t.Errorf(`w.Header().Get("Content-Type") = %q, want %q`, got, contentTypeProblem)
+			}
+
+			if got := w.Header().Get("Retry-After"); got != tt.retryAfter {
+				t.Errorf(`w.Header().Get("Retry-After") = %q, want %q`, got, tt.retryAfter)
+			}
+
+			var p problem
+			if err := json.Unmarshal(w.Body.Bytes(), &p); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if p.Status != tt.status || p.Code != tt.code || p.Type != problemTypePrefix+tt.code || p.Title != http.StatusText(tt.status) {
+				t.Errorf("unexpected problem = %+v", p)
+			}
+
+			if tt.detail != "" && p.Detail != tt.detail {
+				t.Errorf("p.Detail = %q, want %q", p.Detail, tt.detail)
+			}
+
+			if tt.status >= http.StatusInternalServerError && p.Detail != "" {
+				t.Errorf("expected the detail of server errors to be hidden, got = %q", p.Detail)
 			}
-		}
-	})
+		})
+	}
+}
+
+func TestUnknownRoute(t *testing.T) {
+	srv := NewServer(":80", nil, nil, nil, false, false)
+
+	for _, tt := range []struct {
+		method string
+		path   string
+		status int
+		code   string
+	}{
+		{method: http.MethodGet, path: "/api/v1/unknown", status: http.StatusNotFound, code: codeNotFound},
+		{method: http.MethodPatch, path: "/health", status: http.StatusMethodNotAllowed, code: codeMethod},
+		{method: http.MethodPut, path: "/api/v1/statistics", status: http.StatusMethodNotAllowed, code: codeMethod},
+		{method: http.MethodDelete, path: "/retrieving/v1/character/nokka", status: http.StatusNotFound, code: codeNotFound},
+	} {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			srv.Handler().ServeHTTP(w, httptest.NewRequest(tt.method, tt.path, nil))
+
+			if w.Code != tt.status {
+				t.Fatalf("w.Code = %d, want %d", w.Code, tt.status)
+			}
+
+			if got := w.Header().Get("Content-Type"); got != contentTypeProblem {
+				t.Errorf(`w.Header().Get("Content-Type") = %q, want %q`, got, contentTypeProblem)
+			}
+
+			var p problem
+			if err := json.Unmarshal(w.Body.Bytes(), &p); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if p.Code != tt.code {
+				t.Errorf("p.Code = %q, want %q", p.Code, tt.code)
+			}
+		})
+	}
 }
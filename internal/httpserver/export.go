@@ -0,0 +1,55 @@
+package httpserver
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"github.com/nokka/d2-armory-api/internal/domain"
+)
+
+// exportService represents the functionality we need to export characters.
+type exportService interface {
+	Export(ctx context.Context, name string, file string) (*domain.Export, error)
+}
+
+// exportHandler is used to download exports of a character.
+type exportHandler struct {
+	encoder       *encoder
+	exportService exportService
+	visibility    visibilityGuard
+}
+
+func (h exportHandler) Routes(router chi.Router) {
+	router.Get("/{file}", h.getExport)
+}
+
+func (h exportHandler) getExport(w http.ResponseWriter, r *http.Request) {
+	name := chi.URLParam(r, "name")
+
+	if err := h.visibility.Authorize(r, name); err != nil {
+		h.encoder.Error(w, err)
+		return
+	}
+
+	// Pass the request context in order to make use of cancellation for lower level work.
+	export, err := h.exportService.Export(r.Context(), name, chi.URLParam(r, "file"))
+	if err != nil {
+		h.encoder.Error(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", export.ContentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", export.Filename))
+	http.ServeContent(w, r, export.Filename, export.LastParsed, bytes.NewReader(export.Body))
+}
+
+func newExportHandler(encoder *encoder, exportService exportService, visibility visibilityGuard) *exportHandler {
+	return &exportHandler{
+		encoder:       encoder,
+		exportService: exportService,
+		visibility:    visibility,
+	}
+}
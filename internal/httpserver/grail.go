@@ -0,0 +1,51 @@
+package httpserver
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"github.com/nokka/d2-armory-api/internal/domain"
+)
+
+// grailService represents the functionality we need to get the Holy Grail of accounts.
+type grailService interface {
+	Get(ctx context.Context, account string, realm string, exclude map[string]struct{}) (*domain.Grail, error)
+}
+
+// grailHandler is used to get the Holy Grail of an account.
+type grailHandler struct {
+	encoder      *encoder
+	grailService grailService
+	visibility   visibilityGuard
+}
+
+func (h grailHandler) Routes(router chi.Router) {
+	router.Get("/", h.getGrail)
+}
+
+func (h grailHandler) getGrail(w http.ResponseWriter, r *http.Request) {
+	// Unlisted and private characters aren't shown as finders.
+	hidden, err := h.visibility.Hidden(r.Context())
+	if err != nil {
+		h.encoder.Error(w, err)
+		return
+	}
+
+	// Pass the request context in order to make use of cancellation for lower level work.
+	grail, err := h.grailService.Get(r.Context(), chi.URLParam(r, "account"), r.URL.Query().Get("realm"), hidden)
+	if err != nil {
+		h.encoder.Error(w, err)
+		return
+	}
+
+	h.encoder.Response(w, grail)
+}
+
+func newGrailHandler(encoder *encoder, grailService grailService, visibility visibilityGuard) *grailHandler {
+	return &grailHandler{
+		encoder:      encoder,
+		grailService: grailService,
+		visibility:   visibility,
+	}
+}
@@ -0,0 +1,151 @@
+package httpserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/nokka/d2-armory-api/internal/domain"
+	"github.com/nokka/d2-armory-api/internal/graph"
+)
+
+// graphService represents the functionality we need to execute GraphQL queries.
+type graphService interface {
+	// Execute executes the query, seeing only the characters the access allows.
+	Execute(ctx context.Context, req graph.Request, access graph.Access) *graphql.Result
+}
+
+// graphErrorMessage is the message of server errors raised by resolvers, the detail is only logged.
+const graphErrorMessage = "the request couldn't be completed"
+
+// graphHandler is used to query characters, items and statistics over GraphQL.
+type graphHandler struct {
+	encoder      *encoder
+	graphService graphService
+	visibility   visibilityGuard
+}
+
+func (h graphHandler) Routes(router chi.Router) {
+	router.Get("/", h.query)
+	router.Post("/", h.query)
+}
+
+func (h graphHandler) query(w http.ResponseWriter, r *http.Request) {
+	req, err := graphRequest(r)
+	if err != nil {
+		h.encoder.Error(w, err)
+		return
+	}
+
+	// Listings only include public characters, private characters are visible with their token.
+	hidden, err := h.visibility.Hidden(r.Context())
+	if err != nil {
+		h.encoder.Error(w, err)
+		return
+	}
+
+	// Pass the request context in order to make use of cancellation for lower level work.
+	result := h.graphService.Execute(r.Context(), req, graph.Access{
+		Authorize: func(name string) error {
+			return h.visibility.Authorize(r, name)
+		},
+		Hidden: hidden,
+	})
+
+	maskErrors(result.Errors)
+
+	// Queries that were rejected before being executed have no data.
+	status := http.StatusOK
+	if result.Data == nil && result.HasErrors() {
+		status = http.StatusBadRequest
+	}
+
+	h.encoder.StatusResponse(w, result, status)
+}
+
+// maskErrors gives the errors raised by resolvers the stable code of their problem
+// as an extension, and hides the detail of server errors the way encoder.Error does.
+func maskErrors(errs []gqlerrors.FormattedError) {
+	for i, e := range errs {
+		err := resolverError(e)
+		if err == nil {
+			continue
+		}
+
+		p := problemOf(err)
+
+		if p.Status >= http.StatusInternalServerError {
+			log.Printf("graphql %s error: %s", p.Code, err)
+			errs[i].Message = graphErrorMessage
+		}
+
+		extensions := make(map[string]interface{}, len(e.Extensions)+1)
+		for k, v := range e.Extensions {
+			extensions[k] = v
+		}
+
+		extensions["code"] = p.Code
+		errs[i].Extensions = extensions
+	}
+}
+
+// resolverError returns the error a resolver failed with, or nil when the error wasn't
+// raised by a resolver, e.g. a syntax error. The executor wraps the errors of resolvers
+// in located errors, and the errors of thunks in formatted errors as well.
+func resolverError(err error) error {
+	located := false
+
+	for {
+		switch e := err.(type) {
+		case gqlerrors.FormattedError:
+			err = e.OriginalError()
+		case *gqlerrors.Error:
+			located, err = true, e.OriginalError
+		default:
+			if !located {
+				return nil
+			}
+
+			return err
+		}
+	}
+}
+
+// graphRequest reads the query from the query parameters of GET requests,
+// and from the JSON body of POST requests.
+func graphRequest(r *http.Request) (graph.Request, error) {
+	var req graph.Request
+
+	if r.Method == http.MethodGet {
+		q := r.URL.Query()
+		req.Query = q.Get("query")
+		req.OperationName = q.Get("operationName")
+
+		if v := q.Get("variables"); v != "" {
+			if err := json.Unmarshal([]byte(v), &req.Variables); err != nil {
+				return req, invalidBody("graphql variables", err)
+			}
+		}
+	} else if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return req, invalidBody("graphql", err)
+	}
+
+	if req.Query == "" {
+		return req, fmt.Errorf("graphql query is missing: %w", domain.ErrRequest)
+	}
+
+	return req, nil
+}
+
+func newGraphHandler(encoder *encoder, graphService graphService, visibility visibilityGuard) *graphHandler {
+	return &graphHandler{
+		encoder:      encoder,
+		graphService: graphService,
+		visibility:   visibility,
+	}
+}
@@ -0,0 +1,117 @@
+package httpserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/nokka/d2-armory-api/internal/domain"
+	"github.com/nokka/d2-armory-api/internal/graph"
+)
+
+// failingGraph is a graph service where resolving the character fails with the error,
+// wrapped the way the executor wraps the errors of resolvers or of thunks.
+type failingGraph struct {
+	err   error
+	thunk bool
+}
+
+func (g failingGraph) Execute(ctx context.Context, req graph.Request, access graph.Access) *graphql.Result {
+	var original error = g.err
+	if g.thunk {
+		original = gqlerrors.FormatError(g.err)
+	}
+
+	located := gqlerrors.NewErrorWithPath(g.err.Error(), nil, "", nil, nil, []interface{}{"character"}, original)
+
+	return &graphql.Result{
+		Data:   map[string]interface{}{"character": nil},
+		Errors: []gqlerrors.FormattedError{gqlerrors.FormatError(located)},
+	}
+}
+
+func TestGraphErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		thunk   bool
+		message string
+		code    string
+	}{
+		{
+			name:    "internal",
+			err:     fmt.Errorf("unspecified error: auth failed for mongo://armory:hunter2@db, %w", domain.ErrInternal),
+			message: graphErrorMessage,
+			code:    codeInternal,
+		},
+		{
+			name:    "internal thunk",
+			err:     fmt.Errorf("unspecified error: auth failed for mongo://armory:hunter2@db, %w", domain.ErrInternal),
+			thunk:   true,
+			message: graphErrorMessage,
+			code:    codeInternal,
+		},
+		{
+			name:    "temporary",
+			err:     fmt.Errorf("temporary error while performing query: connection reset, %w", domain.ErrTemporary),
+			message: graphErrorMessage,
+			code:    codeTemporary,
+		},
+		{
+			name:    "unknown",
+			err:     fmt.Errorf("open /var/d2s/nokka: permission denied"),
+			message: graphErrorMessage,
+			code:    codeInternal,
+		},
+		{
+			name:    "not found",
+			err:     fmt.Errorf("character nokka: %w", domain.ErrNotFound),
+			message: "character nokka: " + domain.ErrNotFound.Error(),
+			code:    codeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := NewServer(":80", nil, nil, nil, false, false, WithGraphService(failingGraph{err: tt.err, thunk: tt.thunk}))
+
+			r := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(`{"query": "{ character(name: \"nokka\") { name } }"}`))
+			w := httptest.NewRecorder()
+
+			srv.Handler().ServeHTTP(w, r)
+
+			if w.Code != http.StatusOK {
+				t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+			}
+
+			var result struct {
+				Errors []struct {
+					Message    string                 `json:"message"`
+					Extensions map[string]interface{} `json:"extensions"`
+				} `json:"errors"`
+			}
+
+			if err := json.NewDecoder(w.Body).Decode(&result); err != nil {
+				t.Fatal(err)
+			}
+
+			if len(result.Errors) != 1 {
+				t.Fatalf("expected a single error, got %d", len(result.Errors))
+			}
+
+			if result.Errors[0].Message != tt.message {
+				t.Errorf("expected message %q, got %q", tt.message, result.Errors[0].Message)
+			}
+
+			if result.Errors[0].Extensions["code"] != tt.code {
+				t.Errorf("expected code %s, got %v", tt.code, result.Errors[0].Extensions["code"])
+			}
+		})
+	}
+}
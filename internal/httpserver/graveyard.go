@@ -0,0 +1,125 @@
+package httpserver
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"github.com/nokka/d2-armory-api/internal/domain"
+	"github.com/nokka/d2-armory-api/internal/graveyard"
+)
+
+// feedSize is the number of deaths in the feeds.
+const feedSize = 50
+
+// graveyardService represents the functionality we need to list the graveyard.
+type graveyardService interface {
+	List(ctx context.Context, opts domain.GraveyardOptions) (*domain.Graveyard, error)
+}
+
+// graveyardHandler is used to list the hardcore characters that died.
+type graveyardHandler struct {
+	encoder          *encoder
+	graveyardService graveyardService
+	visibility       visibilityGuard
+	publicURL        string
+}
+
+func (h graveyardHandler) Routes(router chi.Router) {
+	router.Get("/", h.listGraves)
+	router.Get("/feed.atom", h.atomFeed)
+	router.Get("/feed.rss", h.rssFeed)
+}
+
+func (h graveyardHandler) listGraves(w http.ResponseWriter, r *http.Request) {
+	offset, err := queryInt(r, "offset", 0)
+	if err != nil {
+		h.encoder.Error(w, err)
+		return
+	}
+
+	limit, err := queryInt(r, "limit", 0)
+	if err != nil {
+		h.encoder.Error(w, err)
+		return
+	}
+
+	graves, err := h.list(r, domain.GraveyardOptions{
+		Offset: offset,
+		Limit:  limit,
+		Sort:   r.URL.Query().Get("sort"),
+	})
+	if err != nil {
+		h.encoder.Error(w, err)
+		return
+	}
+
+	h.encoder.Response(w, graves)
+}
+
+func (h graveyardHandler) atomFeed(w http.ResponseWriter, r *http.Request) {
+	graves, err := h.feed(r)
+	if err != nil {
+		h.encoder.Error(w, err)
+		return
+	}
+
+	body, err := graveyard.Atom(graves.Graves, h.publicURL, h.publicURL+r.URL.Path)
+	if err != nil {
+		h.encoder.Error(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/atom+xml; charset=utf-8")
+	_, _ = w.Write(body)
+}
+
+func (h graveyardHandler) rssFeed(w http.ResponseWriter, r *http.Request) {
+	graves, err := h.feed(r)
+	if err != nil {
+		h.encoder.Error(w, err)
+		return
+	}
+
+	body, err := graveyard.RSS(graves.Graves, h.publicURL)
+	if err != nil {
+		h.encoder.Error(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/rss+xml; charset=utf-8")
+	_, _ = w.Write(body)
+}
+
+// list lists the graves of the public characters.
+func (h graveyardHandler) list(r *http.Request, opts domain.GraveyardOptions) (*domain.Graveyard, error) {
+	hidden, err := h.visibility.Hidden(r.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	opts.Exclude = hidden
+
+	// Pass the request context in order to make use of cancellation for lower level work.
+	return h.graveyardService.List(r.Context(), opts)
+}
+
+// feed lists the graves of the feeds, feeds link to the armory by its public URL
+// so they're unavailable without one, the Host header of the request can't be trusted.
+func (h graveyardHandler) feed(r *http.Request) (*domain.Graveyard, error) {
+	if h.publicURL == "" {
+		return nil, fmt.Errorf("public url isn't configured: %w", domain.ErrUnavailable)
+	}
+
+	return h.list(r, domain.GraveyardOptions{Limit: feedSize})
+}
+
+func newGraveyardHandler(encoder *encoder, graveyardService graveyardService, visibility visibilityGuard, publicURL string) *graveyardHandler {
+	return &graveyardHandler{
+		encoder:          encoder,
+		graveyardService: graveyardService,
+		visibility:       visibility,
+		publicURL:        publicURL,
+	}
+}
@@ -0,0 +1,66 @@
+package httpserver
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+)
+
+// buriedGraveyard is a graveyard with a single grave.
+type buriedGraveyard struct{}
+
+func (buriedGraveyard) List(ctx context.Context, opts domain.GraveyardOptions) (*domain.Graveyard, error) {
+	return &domain.Graveyard{
+		Total: 1,
+		Limit: opts.Limit,
+		Graves: []domain.Grave{
+			{Character: "nokka", Class: "Sorceress", Level: 85, DiedAt: time.Date(2021, 5, 1, 12, 0, 0, 0, time.UTC)},
+		},
+	}, nil
+}
+
+func TestGraveyardFeedLinks(t *testing.T) {
+	for _, path := range []string{"/api/v1/graveyard/feed.atom", "/api/v1/graveyard/feed.rss"} {
+		t.Run(path, func(t *testing.T) {
+			srv := NewServer(":80", nil, nil, nil, false, false,
+				WithGraveyardService(buriedGraveyard{}),
+				WithPublicURL("https://armory.example.com"),
+			)
+
+			recorder := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, path, nil)
+			req.Host = "evil.example.com"
+
+			srv.Handler().ServeHTTP(recorder, req)
+
+			if recorder.Code != http.StatusOK {
+				t.Fatalf("want status 200, got = %d", recorder.Code)
+			}
+
+			body := recorder.Body.String()
+			if !strings.Contains(body, "https://armory.example.com/api/v1/characters?name=nokka") {
+				t.Errorf("expected the feed to link to the public url, got = %s", body)
+			}
+
+			if strings.Contains(body, "evil.example.com") {
+				t.Errorf("expected the Host header to be ignored, got = %s", body)
+			}
+		})
+	}
+
+	t.Run("without a public url", func(t *testing.T) {
+		srv := NewServer(":80", nil, nil, nil, false, false, WithGraveyardService(buriedGraveyard{}))
+
+		recorder := httptest.NewRecorder()
+		srv.Handler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/graveyard/feed.atom", nil))
+
+		if recorder.Code != http.StatusServiceUnavailable {
+			t.Errorf("want status 503, got = %d", recorder.Code)
+		}
+	})
+}
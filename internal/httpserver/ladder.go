@@ -0,0 +1,54 @@
+package httpserver
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"github.com/nokka/d2-armory-api/internal/domain"
+)
+
+// ladderService represents the functionality we need to get the realm ladder.
+type ladderService interface {
+	// Get gets a split of the realm ladder without the excluded characters.
+	Get(ctx context.Context, mode string, class string, exclude map[string]struct{}) (*domain.RealmLadder, error)
+}
+
+// ladderHandler is used to get the realm ladder.
+type ladderHandler struct {
+	encoder       *encoder
+	ladderService ladderService
+	visibility    visibilityGuard
+}
+
+func (h ladderHandler) Routes(router chi.Router) {
+	router.Get("/", h.getLadder)
+}
+
+func (h ladderHandler) getLadder(w http.ResponseWriter, r *http.Request) {
+	// Characters that aren't public are left out of the ladder.
+	hidden, err := h.visibility.Hidden(r.Context())
+	if err != nil {
+		h.encoder.Error(w, err)
+		return
+	}
+
+	query := r.URL.Query()
+
+	// Pass the request context in order to make use of cancellation for lower level work.
+	ladder, err := h.ladderService.Get(r.Context(), query.Get("mode"), query.Get("class"), hidden)
+	if err != nil {
+		h.encoder.Error(w, err)
+		return
+	}
+
+	h.encoder.Response(w, ladder)
+}
+
+func newLadderHandler(encoder *encoder, ladderService ladderService, visibility visibilityGuard) *ladderHandler {
+	return &ladderHandler{
+		encoder:       encoder,
+		ladderService: ladderService,
+		visibility:    visibility,
+	}
+}
@@ -0,0 +1,260 @@
+package httpserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/gorilla/websocket"
+	"github.com/nokka/d2-armory-api/internal/domain"
+	"github.com/nokka/d2-armory-api/internal/live"
+)
+
+// liveHub represents the functionality we need to push live updates of characters.
+type liveHub interface {
+	Subscribe(name string) (*live.Subscription, error)
+	Unsubscribe(sub *live.Subscription)
+	Close()
+}
+
+// Live connection settings.
+const (
+	heartbeatInterval = 15 * time.Second
+	liveWriteTimeout  = 10 * time.Second
+	sseRetry          = 5 * time.Second
+)
+
+// liveEvent is a single message of a live connection.
+type liveEvent struct {
+	Type string      `json:"type"`
+	Data interface{} `json:"data"`
+}
+
+// liveHandler streams the updates of a character as they happen, over
+// Server-Sent Events or a WebSocket when the client asks to upgrade.
+type liveHandler struct {
+	encoder          *encoder
+	characterService characterService
+	hub              liveHub
+	visibility       visibilityGuard
+	locale           localizer
+	upgrader         websocket.Upgrader
+}
+
+func (h liveHandler) Routes(router chi.Router) {
+	router.Get("/", h.stream)
+}
+
+func (h liveHandler) stream(w http.ResponseWriter, r *http.Request) {
+	name := chi.URLParam(r, "name")
+
+	if err := h.visibility.Authorize(r, name); err != nil {
+		h.encoder.Error(w, err)
+		return
+	}
+
+	lang := h.locale.Language(w, r)
+
+	// The character as it is right now is the first message of every connection.
+	char, err := h.characterService.Parse(r.Context(), name)
+	if err != nil {
+		h.encoder.Error(w, err)
+		return
+	}
+
+	sub, err := h.hub.Subscribe(name)
+	if err != nil {
+		h.encoder.Error(w, err)
+		return
+	}
+	defer h.hub.Unsubscribe(sub)
+
+	initial := h.event(lang, domain.LiveUpdate{Type: domain.LiveCharacter, Character: char})
+
+	if websocket.IsWebSocketUpgrade(r) {
+		h.websocket(w, r, sub, lang, initial)
+		return
+	}
+
+	h.sse(w, r, sub, lang, initial)
+}
+
+// sse streams the updates as Server-Sent Events, with a comment as heartbeat
+// to keep proxies from closing an idle connection.
+func (h liveHandler) sse(w http.ResponseWriter, r *http.Request, sub *live.Subscription, lang string, initial liveEvent) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		h.encoder.Error(w, fmt.Errorf("streaming is not supported: %w", domain.ErrInternal))
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := fmt.Fprintf(w, "retry: %d\n\n", sseRetry.Milliseconds()); err != nil {
+		return
+	}
+
+	if err := writeSSE(w, initial); err != nil {
+		return
+	}
+	flusher.Flush()
+
+	heartbeat := time.NewTicker(heartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": heartbeat\n\n"); err != nil {
+				return
+			}
+		case update, ok := <-sub.Updates():
+			if !ok {
+				// The server is shutting down, let the client know to reconnect.
+				_, _ = fmt.Fprint(w, "event: close\ndata: {}\n\n")
+				flusher.Flush()
+				return
+			}
+
+			// The character might have been made private since the connection was opened.
+			if err := h.visibility.Authorize(r, sub.Character()); err != nil {
+				return
+			}
+
+			if err := writeSSE(w, h.event(lang, update)); err != nil {
+				return
+			}
+		}
+
+		flusher.Flush()
+	}
+}
+
+// writeSSE writes the event as a single Server-Sent Event.
+func writeSSE(w http.ResponseWriter, e liveEvent) error {
+	data, err := json.Marshal(e.Data)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", e.Type, data)
+
+	return err
+}
+
+// websocket streams the updates as JSON messages, pinging the client as heartbeat.
+func (h liveHandler) websocket(w http.ResponseWriter, r *http.Request, sub *live.Subscription, lang string, initial liveEvent) {
+	conn, err := h.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// The upgrader has already responded with the error.
+		return
+	}
+	defer conn.Close()
+
+	// Clients don't send anything but control messages, reading is needed
+	// to handle them and to notice when the client goes away.
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+
+		conn.SetReadLimit(512)
+		_ = conn.SetReadDeadline(time.Now().Add(2 * heartbeatInterval))
+		conn.SetPongHandler(func(string) error {
+			return conn.SetReadDeadline(time.Now().Add(2 * heartbeatInterval))
+		})
+
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	write := func(e liveEvent) error {
+		_ = conn.SetWriteDeadline(time.Now().Add(liveWriteTimeout))
+		return conn.WriteJSON(e)
+	}
+
+	if err := write(initial); err != nil {
+		return
+	}
+
+	heartbeat := time.NewTicker(heartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-closed:
+			return
+		case <-heartbeat.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(liveWriteTimeout)); err != nil {
+				return
+			}
+		case update, ok := <-sub.Updates():
+			if !ok {
+				// The server is shutting down, let the client know to reconnect.
+				msg := websocket.FormatCloseMessage(websocket.CloseGoingAway, "server shutting down")
+				_ = conn.WriteControl(websocket.CloseMessage, msg, time.Now().Add(liveWriteTimeout))
+				return
+			}
+
+			// The character might have been made private since the connection was opened.
+			if err := h.visibility.Authorize(r, sub.Character()); err != nil {
+				msg := websocket.FormatCloseMessage(websocket.ClosePolicyViolation, "character is not public")
+				_ = conn.WriteControl(websocket.CloseMessage, msg, time.Now().Add(liveWriteTimeout))
+				return
+			}
+
+			if err := write(h.event(lang, update)); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// event returns the message of the update, characters are sent the same way
+// as they're served by the character route.
+func (h liveHandler) event(lang string, update domain.LiveUpdate) liveEvent {
+	if update.Type == domain.LiveStatistics {
+		return liveEvent{Type: update.Type, Data: update.Statistics}
+	}
+
+	return liveEvent{
+		Type: update.Type,
+		Data: struct {
+			Character *domain.Character `json:"character"`
+		}{
+			Character: h.locale.Character(lang, update.Character),
+		},
+	}
+}
+
+// isLive reports if the request is for a live connection, which must not
+// be cut short by the timeout of regular requests.
+func isLive(r *http.Request) bool {
+	return strings.HasPrefix(r.URL.Path, "/api/v1/characters/") && strings.HasSuffix(r.URL.Path, "/events")
+}
+
+func newLiveHandler(encoder *encoder, characterService characterService, hub liveHub, visibility visibilityGuard, locale localizer) *liveHandler {
+	return &liveHandler{
+		encoder:          encoder,
+		characterService: characterService,
+		hub:              hub,
+		visibility:       visibility,
+		locale:           locale,
+		upgrader: websocket.Upgrader{
+			// Overlays are embedded on other sites, like the rest of the API
+			// the updates are public.
+			CheckOrigin: func(r *http.Request) bool { return true },
+		},
+	}
+}
@@ -0,0 +1,117 @@
+package httpserver
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/nokka/d2-armory-api/internal/domain"
+	"github.com/nokka/d2-armory-api/internal/live"
+)
+
+// staticCharacters serves the same character for every name.
+type staticCharacters struct{}
+
+func (staticCharacters) Parse(ctx context.Context, name string) (*domain.Character, error) {
+	return &domain.Character{ID: domain.NormalizeName(name)}, nil
+}
+
+func (staticCharacters) List(ctx context.Context, opts domain.ListOptions) (*domain.CharacterList, error) {
+	return &domain.CharacterList{}, nil
+}
+
+func (staticCharacters) Delete(ctx context.Context, name string) error {
+	return nil
+}
+
+func TestLiveServerSentEvents(t *testing.T) {
+	hub := live.NewHub(10, 10)
+
+	server := httptest.NewServer(NewServer(":80", staticCharacters{}, nil, nil, false, false, WithLiveHub(hub)).Handler())
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/api/v1/characters/nokka/events")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if ct := resp.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Fatalf("expected an event stream, got = %s", ct)
+	}
+
+	events := make(chan string)
+	go func() {
+		defer close(events)
+
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			if line := scanner.Text(); strings.HasPrefix(line, "event: ") {
+				events <- strings.TrimPrefix(line, "event: ")
+			}
+		}
+	}()
+
+	next := func() string {
+		select {
+		case e := <-events:
+			return e
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for an event")
+		}
+		return ""
+	}
+
+	if e := next(); e != domain.LiveCharacter {
+		t.Errorf("expected the character first, got = %s", e)
+	}
+
+	_ = hub.StatisticsSubmitted(context.Background(), nil, domain.StatisticsRequest{Character: "Nokka"})
+
+	if e := next(); e != domain.LiveStatistics {
+		t.Errorf("expected the statistics, got = %s", e)
+	}
+
+	hub.Close()
+
+	if e := next(); e != "close" {
+		t.Errorf("expected the stream to be closed, got = %s", e)
+	}
+}
+
+func TestLiveWebSocket(t *testing.T) {
+	hub := live.NewHub(10, 10)
+
+	server := httptest.NewServer(NewServer(":80", staticCharacters{}, nil, nil, false, false, WithLiveHub(hub)).Handler())
+	defer server.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+"/api/v1/characters/nokka/events", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer conn.Close()
+
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+
+	var e liveEvent
+	if err := conn.ReadJSON(&e); err != nil || e.Type != domain.LiveCharacter {
+		t.Fatalf("expected the character first, got = %+v, %v", e, err)
+	}
+
+	_ = hub.CharacterPersisted(context.Background(), nil, &domain.Character{ID: "nokka"})
+
+	if err := conn.ReadJSON(&e); err != nil || e.Type != domain.LiveCharacter {
+		t.Fatalf("expected the reparsed character, got = %+v, %v", e, err)
+	}
+
+	hub.Close()
+
+	if _, _, err := conn.ReadMessage(); !websocket.IsCloseError(err, websocket.CloseGoingAway) {
+		t.Errorf("expected the connection to be closed, got = %v", err)
+	}
+}
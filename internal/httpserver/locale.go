@@ -0,0 +1,70 @@
+package httpserver
+
+import (
+	"net/http"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+	"github.com/nokka/d2-armory-api/internal/locale"
+)
+
+// translator represents the functionality we need to localize display names.
+type translator interface {
+	Match(preferences ...string) string
+	Character(lang string, character *domain.Character) *domain.Character
+	Mercenary(lang string, merc *domain.Mercenary) *domain.Mercenary
+	SkillTree(lang string, tree *domain.SkillTree) *domain.SkillTree
+}
+
+// localizer is used by handlers to serve display names in the language of the
+// request, everything is served in English when there's no translator configured.
+type localizer struct {
+	translator translator
+}
+
+// Language returns the language to respond in, chosen by the lang query parameter
+// or otherwise the Accept-Language header, and announces it on the response.
+func (l localizer) Language(w http.ResponseWriter, r *http.Request) string {
+	if l.translator == nil {
+		return locale.Default
+	}
+
+	var preferences []string
+	if lang := r.URL.Query().Get("lang"); lang != "" {
+		preferences = append(preferences, lang)
+	}
+	preferences = append(preferences, locale.ParseAcceptLanguage(r.Header.Get("Accept-Language"))...)
+
+	lang := l.translator.Match(preferences...)
+
+	w.Header().Add("Vary", "Accept-Language")
+	w.Header().Set("Content-Language", lang)
+
+	return lang
+}
+
+// Character returns the character with display names in the language.
+func (l localizer) Character(lang string, character *domain.Character) *domain.Character {
+	if l.translator == nil {
+		return character
+	}
+
+	return l.translator.Character(lang, character)
+}
+
+// Mercenary returns the mercenary with display names in the language.
+func (l localizer) Mercenary(lang string, merc *domain.Mercenary) *domain.Mercenary {
+	if l.translator == nil {
+		return merc
+	}
+
+	return l.translator.Mercenary(lang, merc)
+}
+
+// SkillTree returns the skill tree with display names in the language.
+func (l localizer) SkillTree(lang string, tree *domain.SkillTree) *domain.SkillTree {
+	if l.translator == nil {
+		return tree
+	}
+
+	return l.translator.SkillTree(lang, tree)
+}
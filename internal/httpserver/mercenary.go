@@ -0,0 +1,56 @@
+package httpserver
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"github.com/nokka/d2-armory-api/internal/domain"
+)
+
+// mercenaryService represents the functionality we need to get mercenaries.
+type mercenaryService interface {
+	// Get gets the mercenary of the character.
+	Get(ctx context.Context, name string) (*domain.Mercenary, error)
+}
+
+// mercenaryHandler is used to get the mercenary of a character.
+type mercenaryHandler struct {
+	encoder          *encoder
+	mercenaryService mercenaryService
+	visibility       visibilityGuard
+	locale           localizer
+}
+
+func (h mercenaryHandler) Routes(router chi.Router) {
+	router.Get("/", h.getMercenary)
+}
+
+func (h mercenaryHandler) getMercenary(w http.ResponseWriter, r *http.Request) {
+	name := chi.URLParam(r, "name")
+
+	if err := h.visibility.Authorize(r, name); err != nil {
+		h.encoder.Error(w, err)
+		return
+	}
+
+	lang := h.locale.Language(w, r)
+
+	// Pass the request context in order to make use of cancellation for lower level work.
+	merc, err := h.mercenaryService.Get(r.Context(), name)
+	if err != nil {
+		h.encoder.Error(w, err)
+		return
+	}
+
+	h.encoder.Response(w, h.locale.Mercenary(lang, merc))
+}
+
+func newMercenaryHandler(encoder *encoder, mercenaryService mercenaryService, visibility visibilityGuard, locale localizer) *mercenaryHandler {
+	return &mercenaryHandler{
+		encoder:          encoder,
+		mercenaryService: mercenaryService,
+		visibility:       visibility,
+		locale:           locale,
+	}
+}
@@ -0,0 +1,627 @@
+package httpserver
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi"
+	"github.com/nokka/d2-armory-api/internal/card"
+	"github.com/nokka/d2-armory-api/internal/domain"
+	"github.com/nokka/d2-armory-api/internal/export"
+	"github.com/nokka/d2-armory-api/internal/openapi"
+	"github.com/nokka/d2s"
+)
+
+// openapiPath is where the OpenAPI document of the server is served.
+const openapiPath = "/api/openapi.json"
+
+// Security schemes of the authenticated routes.
+const (
+	statisticsAuth = "statisticsAuth"
+	adminAuth      = "adminAuth"
+	ownerAuth      = "ownerAuth"
+)
+
+// openapiHandler serves the OpenAPI document of the routes the server serves.
+type openapiHandler struct {
+	encoder  *encoder
+	document *openapi.Document
+}
+
+func (h *openapiHandler) get(w http.ResponseWriter, r *http.Request) {
+	h.encoder.Response(w, h.document)
+}
+
+// routesOf returns the routes of the router as "METHOD /path", with the paths
+// written the way the OpenAPI document writes them.
+func routesOf(router chi.Routes) []string {
+	var routes []string
+
+	// The walk only fails when the walk function does.
+	_ = chi.Walk(router, func(method string, route string, handler http.Handler, middlewares ...func(http.Handler) http.Handler) error {
+		route = strings.Replace(route, "/*/", "/", -1)
+		if route != "/" {
+			route = strings.TrimSuffix(route, "/")
+		}
+
+		routes = append(routes, method+" "+route)
+		return nil
+	})
+
+	sort.Strings(routes)
+
+	return routes
+}
+
+// apiDocument returns the OpenAPI document of every route the server is able to
+// serve, including the routes of the optional services. The schemas of the
+// domain types are generated from the types themselves.
+func apiDocument() *openapi.Document {
+	g := openapi.NewGenerator()
+
+	// The header of a character marshals itself into something else than its type.
+	g.Define(d2s.Header{}, headerSchema())
+
+	// Statistics are posted by the realm, only the character and difficulty are required.
+	_, submission := g.Component(domain.StatisticsRequest{})
+	submission.Required = []string{"character", "difficulty"}
+	submission.Properties["difficulty"] = openapi.String(domain.DifficultyNormal, domain.DifficultyNightmare, domain.DifficultyHell)
+	for _, name := range []string{"totalkills", "totaluniquekills", "totalchampkills"} {
+		submission.Properties[name] = openapi.Integer(0)
+	}
+
+	// Areas are posted with whatever the realm tracked in them.
+	_, area := g.Component(domain.AreaStats{})
+	areaSubmission := *area
+	areaSubmission.Required = nil
+	submission.Properties["area"] = &openapi.Schema{Type: "object", Nullable: true, AdditionalProperties: &areaSubmission}
+
+	doc := &openapi.Document{
+		OpenAPI: openapi.Version,
+		Info: openapi.Info{
+			Title:       "Diablo II Armory API",
+			Description: "Characters parsed from Diablo II save files and the statistics posted for them.",
+			Version:     "1.0.0",
+		},
+		Components: openapi.Components{
+			SecuritySchemes: map[string]*openapi.SecurityScheme{
+				statisticsAuth: {Type: "http", Scheme: "basic", Description: "The credentials of the realm posting statistics."},
+				adminAuth:      {Type: "http", Scheme: "basic", Description: "The credentials of administrative clients."},
+				ownerAuth:      {Type: "http", Scheme: "bearer", Description: "The owner secret of the character."},
+			},
+		},
+	}
+
+	// Parameters shared by the character routes.
+	var (
+		name   = pathParam("name", "The name of the character.")
+		token  = queryParam("token", "The share token of a private character.", openapi.String())
+		lang   = queryParam("lang", "The language of display names, the Accept-Language header is used otherwise.", openapi.String())
+		offset = queryParam("offset", "The number of elements to skip.", openapi.Integer(0))
+		limit  = queryParam("limit", "The maximum number of elements.", openapi.Integer(0))
+	)
+
+	status := openapi.Ref("Status")
+
+	doc.Add(http.MethodGet, "/health", &openapi.Operation{
+		OperationID: "getHealth",
+		Summary:     "Health probe",
+		Tags:        []string{"health"},
+		Responses:   responses(http.StatusOK, jsonResponse("The service is up.", status)),
+	})
+
+	doc.Add(http.MethodGet, openapiPath, &openapi.Operation{
+		OperationID: "getOpenAPI",
+		Summary:     "The OpenAPI document of the routes served",
+		Tags:        []string{"meta"},
+		Responses:   responses(http.StatusOK, jsonResponse("The OpenAPI document.", &openapi.Schema{Type: "object"})),
+	})
+
+	characterParams := []openapi.Parameter{
+		queryParam("name", "The name of the character, characters are listed without it.", openapi.String()),
+		token,
+		lang,
+		offset,
+		limit,
+		queryParam("sort", "The order of the list.", openapi.String(domain.SortLastSaved, domain.SortName)),
+		queryParam("order", "The direction of the order, recently saved first unless sorted by name.", openapi.String("asc", "desc")),
+	}
+
+	characterResponse := &openapi.Schema{OneOf: []*openapi.Schema{openapi.Ref("CharacterResponse"), g.Schema(domain.CharacterList{})}}
+
+	doc.Add(http.MethodGet, "/api/v1/characters", &openapi.Operation{
+		OperationID: "getCharacter",
+		Summary:     "Get a character by name, or list the characters available",
+		Tags:        []string{"characters"},
+		Parameters:  characterParams,
+		Responses:   responses(http.StatusOK, jsonResponse("The character, or a page of characters without a name.", characterResponse), http.StatusBadRequest, http.StatusNotFound, http.StatusGone),
+	})
+
+	doc.Add(http.MethodDelete, "/api/v1/characters/{name}", &openapi.Operation{
+		OperationID: "deleteCharacter",
+		Summary:     "Delete a character and its statistics",
+		Tags:        []string{"characters"},
+		Parameters:  []openapi.Parameter{name},
+		Security:    security(adminAuth),
+		Responses:   responses(http.StatusOK, jsonResponse("The character was deleted.", status), http.StatusBadRequest, http.StatusUnauthorized, http.StatusNotFound),
+	})
+
+	doc.Add(http.MethodGet, "/retrieving/v1/character", &openapi.Operation{
+		OperationID: "getCharacterDeprecated",
+		Summary:     "Get a character by name, for consumers of versions before 1.0.0",
+		Tags:        []string{"characters"},
+		Deprecated:  true,
+		Parameters:  characterParams,
+		Responses:   responses(http.StatusOK, jsonResponse("The character, or a page of characters without a name.", characterResponse), http.StatusBadRequest, http.StatusNotFound, http.StatusGone),
+	})
+
+	doc.Add(http.MethodGet, "/api/v1/statistics", &openapi.Operation{
+		OperationID: "getStatistics",
+		Summary:     "Get the statistics of a character",
+		Tags:        []string{"statistics"},
+		Parameters: []openapi.Parameter{
+			requiredQueryParam("character", "The name of the character.", openapi.String()),
+			token,
+		},
+		Responses: responses(http.StatusOK, jsonResponse("The statistics of every difficulty.", g.Schema(domain.CharacterStatistics{})), http.StatusNotFound),
+	})
+
+	doc.Add(http.MethodPost, "/api/v1/statistics", &openapi.Operation{
+		OperationID: "postStatistics",
+		Summary:     "Post the statistics of characters, one difficulty at a time",
+		Tags:        []string{"statistics"},
+		RequestBody: jsonBody(openapi.Array(g.Schema(domain.StatisticsRequest{}))),
+		Security:    security(statisticsAuth),
+		Responses:   responses(http.StatusAccepted, jsonResponse("The statistics were accepted.", status), http.StatusBadRequest, http.StatusUnauthorized),
+	})
+
+	doc.Add(http.MethodDelete, "/api/v1/statistics/{name}", &openapi.Operation{
+		OperationID: "deleteStatistics",
+		Summary:     "Delete every statistic of a character",
+		Tags:        []string{"statistics"},
+		Parameters:  []openapi.Parameter{name},
+		Security:    security(statisticsAuth),
+		Responses:   responses(http.StatusOK, jsonResponse("The statistics were deleted.", status), http.StatusUnauthorized),
+	})
+
+	visibility := g.Schema(domain.VisibilitySetting{})
+
+	doc.Add(http.MethodGet, "/api/v1/characters/{name}/visibility", &openapi.Operation{
+		OperationID: "getVisibility",
+		Summary:     "Get the visibility of a character",
+		Tags:        []string{"characters"},
+		Parameters:  []openapi.Parameter{name},
+		Security:    security(ownerAuth, adminAuth),
+		Responses:   responses(http.StatusOK, jsonResponse("The visibility setting.", visibility), http.StatusUnauthorized),
+	})
+
+	visibilityBody := openapi.Object(map[string]*openapi.Schema{
+		"visibility": openapi.String(domain.VisibilityPublic, domain.VisibilityUnlisted, domain.VisibilityPrivate),
+	})
+	visibilityBody.Properties["rotate_token"] = &openapi.Schema{Type: "boolean", Description: "Whether to issue a new share token."}
+
+	doc.Add(http.MethodPut, "/api/v1/characters/{name}/visibility", &openapi.Operation{
+		OperationID: "putVisibility",
+		Summary:     "Change the visibility of a character",
+		Tags:        []string{"characters"},
+		Parameters:  []openapi.Parameter{name},
+		RequestBody: jsonBody(visibilityBody),
+		Security:    security(ownerAuth, adminAuth),
+		Responses:   responses(http.StatusOK, jsonResponse("The visibility setting.", visibility), http.StatusBadRequest, http.StatusUnauthorized),
+	})
+
+	doc.Add(http.MethodPost, "/api/v1/characters/{name}/visibility/owner-secret", &openapi.Operation{
+		OperationID: "issueOwnerSecret",
+		Summary:     "Issue a new owner secret for a character",
+		Tags:        []string{"characters"},
+		Parameters:  []openapi.Parameter{name},
+		Security:    security(adminAuth),
+		Responses: responses(http.StatusOK, jsonResponse("The owner secret, it's only returned once.", openapi.Object(map[string]*openapi.Schema{
+			"owner_secret": openapi.String(),
+		})), http.StatusUnauthorized),
+	})
+
+	doc.Add(http.MethodGet, "/api/v1/characters/{name}/mercenary", &openapi.Operation{
+		OperationID: "getMercenary",
+		Summary:     "Get the mercenary of a character",
+		Tags:        []string{"characters"},
+		Parameters:  []openapi.Parameter{name, token, lang},
+		Responses:   responses(http.StatusOK, jsonResponse("The mercenary and its items.", g.Schema(domain.Mercenary{})), http.StatusBadRequest, http.StatusNotFound),
+	})
+
+	doc.Add(http.MethodGet, "/api/v1/characters/{name}/progress", &openapi.Operation{
+		OperationID: "getProgress",
+		Summary:     "Get the quest and waypoint progress of a character",
+		Tags:        []string{"characters"},
+		Parameters:  []openapi.Parameter{name, token},
+		Responses:   responses(http.StatusOK, jsonResponse("The progress of every difficulty.", g.Schema(domain.Progress{})), http.StatusBadRequest, http.StatusNotFound),
+	})
+
+	doc.Add(http.MethodGet, "/api/v1/characters/{name}/skills", &openapi.Operation{
+		OperationID: "getSkills",
+		Summary:     "Get the skill allocation of a character",
+		Tags:        []string{"characters"},
+		Parameters:  []openapi.Parameter{name, token, lang},
+		Responses:   responses(http.StatusOK, jsonResponse("The skill trees of the class.", g.Schema(domain.SkillTree{})), http.StatusBadRequest, http.StatusNotFound),
+	})
+
+	png := &openapi.Response{
+		Description: "The card as a PNG.",
+		Content:     map[string]openapi.MediaType{"image/png": {Schema: binary()}},
+	}
+
+	doc.Add(http.MethodGet, "/api/v1/characters/{name}/card.png", &openapi.Operation{
+		OperationID: "getCard",
+		Summary:     "Get the signature card of a character",
+		Tags:        []string{"characters"},
+		Parameters: []openapi.Parameter{
+			name,
+			token,
+			queryParam("template", "The look of the card.", openapi.String(card.TemplateClassic, card.TemplateDark, card.TemplateLight)),
+			queryParam("size", "The size of the card.", openapi.String(card.SizeSmall, card.SizeMedium, card.SizeLarge)),
+		},
+		Responses: responses(http.StatusOK, png, http.StatusBadRequest, http.StatusNotFound),
+	})
+
+	exported := &openapi.Response{
+		Description: "The export as an attachment.",
+		Content: map[string]openapi.MediaType{
+			"text/csv":         {Schema: binary()},
+			"application/json": {Schema: &openapi.Schema{Type: "object"}},
+			"text/plain":       {Schema: &openapi.Schema{Type: "string"}},
+		},
+	}
+
+	doc.Add(http.MethodGet, "/api/v1/characters/{name}/export/{file}", &openapi.Operation{
+		OperationID: "getExport",
+		Summary:     "Download an export of a character",
+		Tags:        []string{"characters"},
+		Parameters: []openapi.Parameter{
+			name,
+			{Name: "file", In: openapi.InPath, Required: true, Description: "The export to download.", Schema: openapi.String(export.FileItemsCSV, export.FileStatsCSV, export.FileBuildJSON, export.FileTooltipText)},
+			token,
+		},
+		Responses: responses(http.StatusOK, exported, http.StatusBadRequest, http.StatusNotFound),
+	})
+
+	events := &openapi.Response{
+		Description: "Server-Sent Events of type character or statistics, the first event is the character. Clients asking to upgrade get a WebSocket with the same messages instead.",
+		Content:     map[string]openapi.MediaType{"text/event-stream": {Schema: &openapi.Schema{Type: "string"}}},
+	}
+
+	doc.Add(http.MethodGet, "/api/v1/characters/{name}/events", &openapi.Operation{
+		OperationID: "getEvents",
+		Summary:     "Stream the updates of a character as they happen",
+		Tags:        []string{"characters"},
+		Parameters:  []openapi.Parameter{name, token, lang},
+		Responses:   responses(http.StatusOK, events, http.StatusNotFound),
+	})
+
+	doc.Add(http.MethodGet, "/api/v1/characters/{name}/craftable", &openapi.Operation{
+		OperationID: "getCraftable",
+		Summary:     "Get the runewords a character can make",
+		Tags:        []string{"characters"},
+		Parameters:  []openapi.Parameter{name, token},
+		Responses:   responses(http.StatusOK, jsonResponse("The runewords with the runes and bases the character has.", g.Schema(domain.Craftable{})), http.StatusBadRequest, http.StatusNotFound),
+	})
+
+	doc.Add(http.MethodGet, "/api/v1/characters/{name}/achievements", &openapi.Operation{
+		OperationID: "getCharacterAchievements",
+		Summary:     "Get the achievements of a character",
+		Tags:        []string{"achievements"},
+		Parameters:  []openapi.Parameter{name, token},
+		Responses:   responses(http.StatusOK, jsonResponse("The achievements unlocked and locked.", g.Schema(domain.CharacterAchievements{})), http.StatusNotFound),
+	})
+
+	doc.Add(http.MethodGet, "/api/v1/achievements", &openapi.Operation{
+		OperationID: "listAchievements",
+		Summary:     "List the achievements and how many characters unlocked them",
+		Tags:        []string{"achievements"},
+		Responses:   responses(http.StatusOK, jsonResponse("Every achievement.", g.Schema([]domain.AchievementSummary{}))),
+	})
+
+	doc.Add(http.MethodGet, "/api/v1/compare", &openapi.Operation{
+		OperationID: "compare",
+		Summary:     "Compare two characters side by side",
+		Tags:        []string{"characters"},
+		Parameters: []openapi.Parameter{
+			requiredQueryParam("a", "The name of the first character.", openapi.String()),
+			requiredQueryParam("b", "The name of the second character.", openapi.String()),
+			queryParam("token_a", "The share token of the first character, when it's private.", openapi.String()),
+			queryParam("token_b", "The share token of the second character, when it's private.", openapi.String()),
+			token,
+		},
+		Responses: responses(http.StatusOK, jsonResponse("The differences between the characters.", g.Schema(domain.Comparison{})), http.StatusBadRequest, http.StatusNotFound),
+	})
+
+	account := pathParam("account", "The name of the account.")
+
+	doc.Add(http.MethodGet, "/api/v1/accounts/{account}", &openapi.Operation{
+		OperationID: "getAccount",
+		Summary:     "Get an account and its public characters",
+		Tags:        []string{"accounts"},
+		Parameters:  []openapi.Parameter{account},
+		Responses:   responses(http.StatusOK, jsonResponse("The account.", g.Schema(domain.Account{})), http.StatusNotFound),
+	})
+
+	doc.Add(http.MethodGet, "/api/v1/accounts/{account}/grail", &openapi.Operation{
+		OperationID: "getGrail",
+		Summary:     "Get the Holy Grail of an account",
+		Tags:        []string{"accounts"},
+		Parameters: []openapi.Parameter{
+			account,
+			queryParam("realm", "The realm of the account.", openapi.String()),
+		},
+		Responses: responses(http.StatusOK, jsonResponse("The items found and missing.", g.Schema(domain.Grail{})), http.StatusNotFound),
+	})
+
+	webhookBody := openapi.Object(map[string]*openapi.Schema{
+		"url": openapi.String(),
+		"events": openapi.Array(openapi.String(
+			domain.EventLevelUp, domain.EventDeath, domain.EventUniqueFound, domain.EventStatisticsMilestone,
+		)),
+	})
+	webhookBody.Required = []string{"url"}
+
+	doc.Add(http.MethodGet, "/api/v1/webhooks", &openapi.Operation{
+		OperationID: "listWebhooks",
+		Summary:     "List the registered webhooks",
+		Tags:        []string{"webhooks"},
+		Security:    security(adminAuth),
+		Responses:   responses(http.StatusOK, jsonResponse("The webhooks.", g.Schema([]domain.Webhook{})), http.StatusUnauthorized),
+	})
+
+	doc.Add(http.MethodPost, "/api/v1/webhooks", &openapi.Operation{
+		OperationID: "registerWebhook",
+		Summary:     "Register a webhook for character events",
+		Tags:        []string{"webhooks"},
+		RequestBody: jsonBody(webhookBody),
+		Security:    security(adminAuth),
+		Responses:   responses(http.StatusCreated, jsonResponse("The webhook with the secret its deliveries are signed with.", g.Schema(domain.Webhook{})), http.StatusBadRequest, http.StatusUnauthorized),
+	})
+
+	doc.Add(http.MethodDelete, "/api/v1/webhooks/{id}", &openapi.Operation{
+		OperationID: "deleteWebhook",
+		Summary:     "Delete a webhook",
+		Tags:        []string{"webhooks"},
+		Parameters:  []openapi.Parameter{pathParam("id", "The id of the webhook.")},
+		Security:    security(adminAuth),
+		Responses:   responses(http.StatusOK, jsonResponse("The webhook was deleted.", status), http.StatusUnauthorized, http.StatusNotFound),
+	})
+
+	doc.Add(http.MethodGet, "/api/v1/webhooks/dead-letters", &openapi.Operation{
+		OperationID: "listDeadLetters",
+		Summary:     "List the events that couldn't be delivered",
+		Tags:        []string{"webhooks"},
+		Security:    security(adminAuth),
+		Responses:   responses(http.StatusOK, jsonResponse("The dead letters.", g.Schema([]domain.DeadLetter{})), http.StatusUnauthorized),
+	})
+
+	doc.Add(http.MethodPost, "/api/v1/webhooks/dead-letters/{id}/redeliver", &openapi.Operation{
+		OperationID: "redeliverDeadLetter",
+		Summary:     "Deliver a dead letter again",
+		Tags:        []string{"webhooks"},
+		Parameters:  []openapi.Parameter{pathParam("id", "The id of the dead letter.")},
+		Security:    security(adminAuth),
+		Responses:   responses(http.StatusAccepted, jsonResponse("The dead letter was queued for delivery.", status), http.StatusUnauthorized, http.StatusNotFound),
+	})
+
+	doc.Add(http.MethodGet, "/api/v1/graveyard", &openapi.Operation{
+		OperationID: "listGraves",
+		Summary:     "List the hardcore characters that died",
+		Tags:        []string{"graveyard"},
+		Parameters: []openapi.Parameter{
+			offset,
+			limit,
+			queryParam("sort", "The order of the graves.", openapi.String(domain.GraveyardSortRecent, domain.GraveyardSortLevel)),
+		},
+		Responses: responses(http.StatusOK, jsonResponse("A page of graves.", g.Schema(domain.Graveyard{})), http.StatusBadRequest),
+	})
+
+	doc.Add(http.MethodGet, "/api/v1/graveyard/feed.atom", &openapi.Operation{
+		OperationID: "getGraveyardAtom",
+		Summary:     "The most recent deaths as an Atom feed",
+		Tags:        []string{"graveyard"},
+		Responses:   responses(http.StatusOK, feedResponse("application/atom+xml")),
+	})
+
+	doc.Add(http.MethodGet, "/api/v1/graveyard/feed.rss", &openapi.Operation{
+		OperationID: "getGraveyardRSS",
+		Summary:     "The most recent deaths as an RSS feed",
+		Tags:        []string{"graveyard"},
+		Responses:   responses(http.StatusOK, feedResponse("application/rss+xml")),
+	})
+
+	doc.Add(http.MethodGet, "/api/v1/realm-ladder", &openapi.Operation{
+		OperationID: "getRealmLadder",
+		Summary:     "Get a split of the realm ladder",
+		Tags:        []string{"ladder"},
+		Parameters: []openapi.Parameter{
+			queryParam("mode", "The ladder split.", openapi.String(domain.LadderClassicSoftcore, domain.LadderClassicHardcore, domain.LadderExpansionSoftcore, domain.LadderExpansionHardcore)),
+			queryParam("class", "The class, or overall.", openapi.String()),
+		},
+		Responses: responses(http.StatusOK, jsonResponse("The ranked characters.", g.Schema(domain.RealmLadder{})), http.StatusBadRequest),
+	})
+
+	graphResult := jsonResponse("The result of the query, a query that can't be executed has no data.", openapi.Ref("GraphQLResponse"))
+
+	doc.Add(http.MethodGet, "/graphql", &openapi.Operation{
+		OperationID: "queryGraphQLGet",
+		Summary:     "Execute a GraphQL query",
+		Tags:        []string{"graphql"},
+		Parameters: []openapi.Parameter{
+			requiredQueryParam("query", "The GraphQL query.", openapi.String()),
+			queryParam("operationName", "The operation of the query to execute.", openapi.String()),
+			queryParam("variables", "The variables of the query as a JSON object.", openapi.String()),
+			token,
+		},
+		Responses: responses(http.StatusOK, graphResult, http.StatusBadRequest),
+	})
+
+	doc.Add(http.MethodPost, "/graphql", &openapi.Operation{
+		OperationID: "queryGraphQL",
+		Summary:     "Execute a GraphQL query",
+		Tags:        []string{"graphql"},
+		Parameters:  []openapi.Parameter{token},
+		RequestBody: jsonBody(openapi.Ref("GraphQLRequest")),
+		Responses:   responses(http.StatusOK, graphResult, http.StatusBadRequest),
+	})
+
+	// The envelope of every error.
+	g.Schema(problem{})
+
+	schemas := g.Schemas()
+	schemas["Problem"].Properties["code"] = openapi.String(codeInvalidRequest, codeInvalidArgument, codeInvalidJSON,
+		codeNotFound, codeGone, codeConflict, codeMethod, codeUnavailable, codeTemporary, codeInternal)
+	schemas["Status"] = openapi.Object(map[string]*openapi.Schema{"status": openapi.String()})
+	schemas["CharacterResponse"] = openapi.Object(map[string]*openapi.Schema{"character": g.Schema(domain.Character{})})
+
+	graphRequest := openapi.Object(map[string]*openapi.Schema{
+		"query":         openapi.String(),
+		"operationName": openapi.String(),
+		"variables":     {Type: "object", Nullable: true},
+	})
+	graphRequest.Required = []string{"query"}
+	schemas["GraphQLRequest"] = graphRequest
+
+	schemas["GraphQLResponse"] = &openapi.Schema{
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"data":   {Nullable: true, Description: "The fields selected by the query."},
+			"errors": openapi.Array(openapi.Object(map[string]*openapi.Schema{"message": openapi.String()})),
+		},
+	}
+
+	doc.Components.Schemas = schemas
+
+	return doc
+}
+
+// errorStatuses describe the statuses of errors.
+var errorStatuses = map[int]string{
+	http.StatusBadRequest:          "The request is invalid.",
+	http.StatusUnauthorized:        "The credentials are missing or invalid.",
+	http.StatusNotFound:            "The resource doesn't exist, or isn't visible to the request.",
+	http.StatusGone:                "The resource has been deleted.",
+	http.StatusServiceUnavailable:  "The service is unavailable, temporary errors tell when to retry.",
+	http.StatusInternalServerError: "The request couldn't be completed.",
+}
+
+// responses returns the responses of an operation, the successful response
+// followed by the statuses of the errors. Every operation might fail internally
+// or temporarily.
+func responses(statusCode int, success *openapi.Response, errorCodes ...int) map[string]*openapi.Response {
+	resps := map[string]*openapi.Response{
+		strconv.Itoa(statusCode): success,
+	}
+
+	for _, code := range append(errorCodes, http.StatusServiceUnavailable, http.StatusInternalServerError) {
+		resp := &openapi.Response{Description: errorStatuses[code]}
+
+		// Basic authentication fails without a body.
+		if code != http.StatusUnauthorized {
+			resp.Content = map[string]openapi.MediaType{contentTypeProblem: {Schema: openapi.Ref("Problem")}}
+		}
+
+		if code == http.StatusServiceUnavailable {
+			resp.Headers = map[string]openapi.Header{
+				"Retry-After": {Description: "The seconds to wait before retrying a temporary error.", Schema: openapi.Integer(0)},
+			}
+		}
+
+		resps[strconv.Itoa(code)] = resp
+	}
+
+	return resps
+}
+
+// contentTypeJSON is the content type of the JSON bodies.
+const contentTypeJSON = "application/json"
+
+func jsonResponse(description string, schema *openapi.Schema) *openapi.Response {
+	return &openapi.Response{
+		Description: description,
+		Content:     map[string]openapi.MediaType{contentTypeJSON: {Schema: schema}},
+	}
+}
+
+func feedResponse(contentType string) *openapi.Response {
+	return &openapi.Response{
+		Description: "The feed of the 50 most recent deaths of public characters.",
+		Content:     map[string]openapi.MediaType{contentType: {Schema: &openapi.Schema{Type: "string"}}},
+	}
+}
+
+func jsonBody(schema *openapi.Schema) *openapi.RequestBody {
+	return &openapi.RequestBody{
+		Required: true,
+		Content:  map[string]openapi.MediaType{contentTypeJSON: {Schema: schema}},
+	}
+}
+
+func binary() *openapi.Schema {
+	return &openapi.Schema{Type: "string", Format: "binary"}
+}
+
+func pathParam(name string, description string) openapi.Parameter {
+	return openapi.Parameter{Name: name, In: openapi.InPath, Required: true, Description: description, Schema: openapi.String()}
+}
+
+func queryParam(name string, description string, schema *openapi.Schema) openapi.Parameter {
+	return openapi.Parameter{Name: name, In: openapi.InQuery, Description: description, Schema: schema}
+}
+
+func requiredQueryParam(name string, description string, schema *openapi.Schema) openapi.Parameter {
+	p := queryParam(name, description, schema)
+	p.Required = true
+
+	return p
+}
+
+func security(schemes ...string) []map[string][]string {
+	requirements := make([]map[string][]string, 0, len(schemes))
+	for _, scheme := range schemes {
+		requirements = append(requirements, map[string][]string{scheme: {}})
+	}
+
+	return requirements
+}
+
+// headerSchema describes the header of a character the way it's marshaled,
+// with the bytes of the save file made readable.
+func headerSchema() *openapi.Schema {
+	integer := openapi.Integer(0)
+	skills := openapi.Array(openapi.String())
+	skills.Nullable = true
+
+	return &openapi.Schema{
+		Type:     "object",
+		Required: []string{"class", "level", "name", "status"},
+		Properties: map[string]*openapi.Schema{
+			"identifier":       openapi.String(),
+			"checksum":         openapi.String(),
+			"version":          integer,
+			"filesize":         integer,
+			"name":             openapi.String(),
+			"class":            openapi.String(),
+			"level":            integer,
+			"last_played":      {Type: "integer", Description: "Unix time the character was last played."},
+			"status":           openapi.Object(map[string]*openapi.Schema{"expansion": {Type: "boolean"}, "died": {Type: "boolean"}, "hardcore": {Type: "boolean"}, "ladder": {Type: "boolean"}}),
+			"left_skill":       openapi.String(),
+			"right_skill":      openapi.String(),
+			"left_swap_skill":  openapi.String(),
+			"right_swap_skill": openapi.String(),
+			"assigned_skills":  skills,
+			"merc_id":          openapi.String(),
+			"merc_name_id":     integer,
+			"merc_type":        integer,
+			"merc_experience":  integer,
+			"dead_merc":        integer,
+			"quests_normal":    {Type: "object"},
+			"quests_nm":        {Type: "object"},
+			"quests_hell":      {Type: "object"},
+		},
+	}
+}
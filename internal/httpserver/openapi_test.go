@@ -0,0 +1,299 @@
+package httpserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/nokka/d2-armory-api/internal/domain"
+	"github.com/nokka/d2s"
+)
+
+// The stubs mount the routes of every optional service, their routes are walked but never served.
+type (
+	stubVisibility  struct{ visibilityService }
+	stubAccounts    struct{ accountService }
+	stubLadder      struct{ ladderService }
+	stubMercenary   struct{ mercenaryService }
+	stubProgress    struct{ progressService }
+	stubSkills      struct{ skillService }
+	stubCards       struct{ cardService }
+	stubExports     struct{ exportService }
+	stubCompare     struct{ compareService }
+	stubGrail       struct{ grailService }
+	stubCraft       struct{ craftService }
+	stubWebhooks    struct{ webhookService }
+	stubLive        struct{ liveHub }
+	stubGraveyard   struct{ graveyardService }
+	stubAchievement struct{ achievementService }
+	stubGraph       struct{ graphService }
+)
+
+// documentedCharacters serves nokka, every other character is missing.
+type documentedCharacters struct{}
+
+func (documentedCharacters) Parse(ctx context.Context, name string) (*domain.Character, error) {
+	if strings.ContainsAny(name, "0123456789") {
+		return nil, domain.ErrInvalidArgument
+	}
+
+	if name != "nokka" {
+		return nil, fmt.Errorf("character %s: %w", name, domain.ErrNotFound)
+	}
+
+	return &domain.Character{
+		ID: name,
+		D2s: &d2s.Character{
+			Header:     d2s.Header{Class: 1, Level: 90, Status: 0x24, AssignedSkills: [16]uint32{59}},
+			Attributes: d2s.Attributes{Strength: 35, Experience: 3520485254},
+			Skills:     []d2s.Skill{{ID: 59, Name: "Blizzard", Points: 20}},
+			Items: []d2s.Item{
+				{LocationID: 1, EquippedID: 1, Type: "uap", TypeName: "Shako", UniqueName: "Harlequin Crest", Quality: 7},
+			},
+		},
+		LastParsed: time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC),
+		Info:       &domain.CharacterInfo{Account: "nokka", Realm: "europe"},
+	}, nil
+}
+
+func (documentedCharacters) List(ctx context.Context, opts domain.ListOptions) (*domain.CharacterList, error) {
+	return &domain.CharacterList{
+		Total: 1,
+		Limit: 20,
+		Characters: []domain.CharacterSummary{
+			{Name: "nokka", Class: "Sorceress", Level: 90, LastSaved: time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)},
+		},
+	}, nil
+}
+
+func (documentedCharacters) Delete(ctx context.Context, name string) error {
+	return nil
+}
+
+// documentedStatistics keeps the statistics posted.
+type documentedStatistics struct {
+	posted [][]domain.StatisticsRequest
+}
+
+func (s *documentedStatistics) GetCharacter(ctx context.Context, character string) (*domain.CharacterStatistics, error) {
+	return &domain.CharacterStatistics{
+		Character: character,
+		Hell: domain.Stats{
+			TotalKills: 12,
+			Special:    map[string]int{"Baal": 2},
+			Area:       map[string]domain.AreaStats{"Worldstone Keep": {Kills: 10, Time: 300}},
+		},
+	}, nil
+}
+
+func (s *documentedStatistics) Parse(ctx context.Context, stats []domain.StatisticsRequest) error {
+	s.posted = append(s.posted, stats)
+	return nil
+}
+
+func (s *documentedStatistics) DeleteStats(ctx context.Context, character string) error {
+	return nil
+}
+
+var testCredentials = map[string]string{"realm": "secret"}
+
+func TestDocumentRoutes(t *testing.T) {
+	server := NewServer(":80", documentedCharacters{}, &documentedStatistics{}, testCredentials, false, false,
+		WithVisibilityService(stubVisibility{}),
+		WithAccountService(stubAccounts{}),
+		WithLadderService(stubLadder{}),
+		WithMercenaryService(stubMercenary{}),
+		WithProgressService(stubProgress{}),
+		WithSkillService(stubSkills{}),
+		WithCardService(stubCards{}),
+		WithExportService(stubExports{}),
+		WithCompareService(stubCompare{}),
+		WithGrailService(stubGrail{}),
+		WithCraftService(stubCraft{}),
+		WithWebhookService(stubWebhooks{}),
+		WithLiveHub(stubLive{}),
+		WithGraveyardService(stubGraveyard{}),
+		WithAchievementService(stubAchievement{}),
+		WithGraphService(stubGraph{}),
+	)
+
+	served := routesOf(server.Handler().(chi.Routes))
+	doc := apiDocument()
+
+	documented := make(map[string]bool)
+	for _, route := range doc.Routes() {
+		documented[route] = true
+	}
+
+	for _, route := range served {
+		if !documented[route] {
+			t.Errorf("route %s is served but not documented", route)
+		}
+		delete(documented, route)
+	}
+
+	for route := range documented {
+		t.Errorf("route %s is documented but not served", route)
+	}
+
+	operations := make(map[string]string)
+	for path, item := range doc.Paths {
+		for method, op := range item {
+			if other, ok := operations[op.OperationID]; ok {
+				t.Errorf("operation id %s is used by both %s and %s %s", op.OperationID, other, method, path)
+			}
+			operations[op.OperationID] = method + " " + path
+
+			params := make(map[string]bool)
+			for _, p := range op.Parameters {
+				if p.In == "path" {
+					params[p.Name] = true
+				}
+			}
+
+			for _, segment := range strings.Split(path, "/") {
+				if strings.HasPrefix(segment, "{") && !params[strings.Trim(segment, "{}")] {
+					t.Errorf("path parameter %s of %s %s isn't documented", segment, method, path)
+				}
+			}
+		}
+	}
+
+	body, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, ref := range regexp.MustCompile(`"\$ref":"#/components/schemas/([^"]+)"`).FindAllStringSubmatch(string(body), -1) {
+		if _, ok := doc.Components.Schemas[ref[1]]; !ok {
+			t.Errorf("schema %s is referred to but not defined", ref[1])
+		}
+	}
+}
+
+func TestDocumentResponses(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		target string
+		body   string
+		auth   bool
+		status int
+	}{
+		{name: "character", method: http.MethodGet, target: "/api/v1/characters?name=nokka", status: http.StatusOK},
+		{name: "character list", method: http.MethodGet, target: "/api/v1/characters?sort=name", status: http.StatusOK},
+		{name: "missing character", method: http.MethodGet, target: "/api/v1/characters?name=ghost", status: http.StatusNotFound},
+		{name: "invalid character name", method: http.MethodGet, target: "/api/v1/characters?name=n0kka", status: http.StatusBadRequest},
+		{name: "invalid limit", method: http.MethodGet, target: "/api/v1/characters?limit=ten", status: http.StatusBadRequest},
+		{name: "deprecated character", method: http.MethodGet, target: "/retrieving/v1/character?name=nokka", status: http.StatusOK},
+		{name: "statistics", method: http.MethodGet, target: "/api/v1/statistics?character=nokka", status: http.StatusOK},
+		{name: "post statistics", method: http.MethodPost, target: "/api/v1/statistics", body: `[{"character":"nokka","difficulty":"Hell","totalkills":3}]`, auth: true, status: http.StatusAccepted},
+		{name: "post statistics invalid json", method: http.MethodPost, target: "/api/v1/statistics", body: `[{`, auth: true, status: http.StatusBadRequest},
+		{name: "post statistics unauthorized", method: http.MethodPost, target: "/api/v1/statistics", body: `[]`, status: http.StatusUnauthorized},
+		{name: "delete statistics", method: http.MethodDelete, target: "/api/v1/statistics/nokka", auth: true, status: http.StatusOK},
+		{name: "delete character", method: http.MethodDelete, target: "/api/v1/characters/nokka", status: http.StatusUnauthorized},
+		{name: "health", method: http.MethodGet, target: "/health", status: http.StatusOK},
+		{name: "openapi", method: http.MethodGet, target: openapiPath, status: http.StatusOK},
+	}
+
+	server := NewServer(":80", documentedCharacters{}, &documentedStatistics{}, testCredentials, false, false)
+	handler := server.Handler()
+	doc := apiDocument()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			if tt.auth {
+				req.SetBasicAuth("realm", "secret")
+			}
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+
+			if w.Code != tt.status {
+				t.Fatalf("expected status %d, got = %d: %s", tt.status, w.Code, w.Body.String())
+			}
+
+			op, _ := doc.Match(tt.method, req.URL.Path)
+			if op == nil {
+				t.Fatalf("%s %s isn't documented", tt.method, req.URL.Path)
+			}
+
+			resp, ok := op.Responses[strconv.Itoa(w.Code)]
+			if !ok {
+				t.Fatalf("status %d isn't documented", w.Code)
+			}
+
+			if len(resp.Content) == 0 {
+				if w.Body.Len() > 0 {
+					t.Errorf("expected no body, got = %s", w.Body.String())
+				}
+				return
+			}
+
+			ct, _, _ := mime.ParseMediaType(w.Header().Get("Content-Type"))
+			media, ok := resp.Content[ct]
+			if !ok {
+				t.Fatalf("content type %s isn't documented", ct)
+			}
+
+			if err := doc.ValidateJSON(media.Schema, "response", w.Body.Bytes()); err != nil {
+				t.Errorf("response doesn't match the document: %v\n%s", err, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestRequestValidation(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		target string
+		body   string
+		status int
+		posted bool
+	}{
+		{name: "valid statistics", method: http.MethodPost, target: "/api/v1/statistics", body: `[{"character":"nokka","difficulty":"Hell","totalkills":3,"area":{"Worldstone Keep":{"kills":3,"time":60}}}]`, status: http.StatusAccepted, posted: true},
+		{name: "unknown difficulty", method: http.MethodPost, target: "/api/v1/statistics", body: `[{"character":"nokka","difficulty":"Easy"}]`, status: http.StatusBadRequest},
+		{name: "kills as a string", method: http.MethodPost, target: "/api/v1/statistics", body: `[{"character":"nokka","difficulty":"Hell","totalkills":"many"}]`, status: http.StatusBadRequest},
+		{name: "negative area kills", method: http.MethodPost, target: "/api/v1/statistics", body: `[{"character":"nokka","difficulty":"Hell","area":{"Worldstone Keep":{"kills":-1}}}]`, status: http.StatusBadRequest},
+		{name: "missing character", method: http.MethodPost, target: "/api/v1/statistics", body: `[{"difficulty":"Hell"}]`, status: http.StatusBadRequest},
+		{name: "not an array", method: http.MethodPost, target: "/api/v1/statistics", body: `{"character":"nokka","difficulty":"Hell"}`, status: http.StatusBadRequest},
+		{name: "invalid json", method: http.MethodPost, target: "/api/v1/statistics", body: `[{`, status: http.StatusBadRequest},
+		{name: "missing query parameter", method: http.MethodGet, target: "/api/v1/statistics", status: http.StatusBadRequest},
+		{name: "integer query parameter", method: http.MethodGet, target: "/api/v1/characters?limit=ten", status: http.StatusBadRequest},
+		{name: "enum query parameter", method: http.MethodGet, target: "/api/v1/characters?sort=level", status: http.StatusBadRequest},
+		{name: "valid query parameters", method: http.MethodGet, target: "/api/v1/characters?sort=name&order=asc&limit=10", status: http.StatusOK},
+		{name: "undocumented route", method: http.MethodGet, target: "/api/v1/nothing", status: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stats := &documentedStatistics{}
+			server := NewServer(":80", documentedCharacters{}, stats, testCredentials, false, false, WithRequestValidation())
+
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			req.SetBasicAuth("realm", "secret")
+
+			w := httptest.NewRecorder()
+			server.Handler().ServeHTTP(w, req)
+
+			if w.Code != tt.status {
+				t.Errorf("expected status %d, got = %d: %s", tt.status, w.Code, w.Body.String())
+			}
+
+			if posted := len(stats.posted) > 0; posted != tt.posted {
+				t.Errorf("expected statistics posted to be %t, got = %t", tt.posted, posted)
+			}
+		})
+	}
+}
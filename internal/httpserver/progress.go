@@ -0,0 +1,52 @@
+package httpserver
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"github.com/nokka/d2-armory-api/internal/domain"
+)
+
+// progressService represents the functionality we need to get character progress.
+type progressService interface {
+	// Get gets the quest and waypoint progress of the character.
+	Get(ctx context.Context, name string) (*domain.Progress, error)
+}
+
+// progressHandler is used to get the progress of a character.
+type progressHandler struct {
+	encoder         *encoder
+	progressService progressService
+	visibility      visibilityGuard
+}
+
+func (h progressHandler) Routes(router chi.Router) {
+	router.Get("/", h.getProgress)
+}
+
+func (h progressHandler) getProgress(w http.ResponseWriter, r *http.Request) {
+	name := chi.URLParam(r, "name")
+
+	if err := h.visibility.Authorize(r, name); err != nil {
+		h.encoder.Error(w, err)
+		return
+	}
+
+	// Pass the request context in order to make use of cancellation for lower level work.
+	progress, err := h.progressService.Get(r.Context(), name)
+	if err != nil {
+		h.encoder.Error(w, err)
+		return
+	}
+
+	h.encoder.Response(w, progress)
+}
+
+func newProgressHandler(encoder *encoder, progressService progressService, visibility visibilityGuard) *progressHandler {
+	return &progressHandler{
+		encoder:         encoder,
+		progressService: progressService,
+		visibility:      visibility,
+	}
+}
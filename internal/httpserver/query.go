@@ -0,0 +1,24 @@
+package httpserver
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+)
+
+// queryInt returns the integer query parameter by key, or the fallback when it's not set.
+func queryInt(r *http.Request, key string, fallback int) (int, error) {
+	value := r.URL.Query().Get(key)
+	if value == "" {
+		return fallback, nil
+	}
+
+	i, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("query parameter %s must be an integer: %w", key, domain.ErrRequest)
+	}
+
+	return i, nil
+}
@@ -1,26 +1,73 @@
 package httpserver
 
 import (
+	"context"
+	"fmt"
 	"log"
 	"net"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/go-chi/chi"
 	"github.com/go-chi/chi/middleware"
 	"github.com/go-chi/cors"
+	"github.com/nokka/d2-armory-api/internal/domain"
+	"github.com/nokka/d2-armory-api/internal/openapi"
 )
 
 // Server is the HTTP server listener.
 type Server struct {
-	encoder           *encoder
-	listener          net.Listener
-	addr              string
-	characterService  characterService
-	statisticsService statisticsService
-	credentials       map[string]string
-	corsEnabled       bool
-	loggingEnabled    bool
+	encoder            *encoder
+	listener           net.Listener
+	addr               string
+	characterService   characterService
+	statisticsService  statisticsService
+	visibilityService  visibilityService
+	accountService     accountService
+	ladderService      ladderService
+	mercenaryService   mercenaryService
+	progressService    progressService
+	skillService       skillService
+	cardService        cardService
+	exportService      exportService
+	compareService     compareService
+	grailService       grailService
+	craftService       craftService
+	webhookService     webhookService
+	liveHub            liveHub
+	graveyardService   graveyardService
+	achievementService achievementService
+	graphService       graphService
+	translator         translator
+	credentials        map[string]string
+	adminCredentials   map[string]string
+	publicURL          string
+	corsEnabled        bool
+	loggingEnabled     bool
+	validateRequests   bool
+
+	mu     sync.Mutex
+	server *http.Server
+}
+
+// Option configures the optional parts of the server.
+type Option func(*Server)
+
+// WithAdminCredentials sets the credentials required by the administrative routes,
+// without them every administrative request is unauthorized.
+func WithAdminCredentials(credentials map[string]string) Option {
+	return func(s *Server) {
+		s.adminCredentials = credentials
+	}
+}
+
+// WithPublicURL sets the absolute URL clients reach the armory on, without a
+// trailing slash. It's used to link back to the armory, e.g. from feeds.
+func WithPublicURL(publicURL string) Option {
+	return func(s *Server) {
+		s.publicURL = publicURL
+	}
 }
 
 // Open will open a tcp listener to serve http requests.
@@ -32,21 +79,65 @@ func (s *Server) Open() error {
 
 	s.listener = ln
 
+	handler := s.Handler()
+	timeout := http.TimeoutHandler(handler, (2 * time.Second), "connection timeout")
+
 	// Create an http server.
-	server := http.Server{
-		Handler:     http.TimeoutHandler(s.Handler(), (2 * time.Second), "connection timeout"),
+	server := &http.Server{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// Live connections stay open, so they aren't subject to the request timeout.
+			if isLive(r) {
+				handler.ServeHTTP(w, r)
+				return
+			}
+
+			timeout.ServeHTTP(w, r)
+		}),
 		ReadTimeout: 5 * time.Second,
 	}
 
+	if s.liveHub != nil {
+		// Live connections would otherwise keep the server from shutting down.
+		server.RegisterOnShutdown(s.liveHub.Close)
+	}
+
+	s.mu.Lock()
+	s.server = server
+	s.mu.Unlock()
+
 	log.Println("starting HTTP server on:", s.addr)
 
 	return server.Serve(s.listener)
 }
 
+// Shutdown gracefully stops the server, live connections are closed and the
+// requests in flight are given until the context is done to complete.
+func (s *Server) Shutdown(ctx context.Context) error {
+	s.mu.Lock()
+	server := s.server
+	s.mu.Unlock()
+
+	if server == nil {
+		return nil
+	}
+
+	return server.Shutdown(ctx)
+}
+
 // Handler will setup a router that implements the http.Handler interface.
 func (s *Server) Handler() http.Handler {
 	r := chi.NewRouter()
 
+	// Unknown routes and methods are told as problems like every other error. They're
+	// set before the middlewares, which chi would otherwise run twice, and before
+	// mounting the routes for the sub routers to inherit them.
+	r.NotFound(func(w http.ResponseWriter, r *http.Request) {
+		s.encoder.Error(w, fmt.Errorf("no route for %s: %w", r.URL.Path, domain.ErrNotFound))
+	})
+	r.MethodNotAllowed(func(w http.ResponseWriter, r *http.Request) {
+		s.encoder.Error(w, fmt.Errorf("%s %s: %w", r.Method, r.URL.Path, errMethodNotAllowed))
+	})
+
 	if s.loggingEnabled {
 		// Middleware for logging requests.
 		r.Use(middleware.Logger)
@@ -66,19 +157,225 @@ func (s *Server) Handler() http.Handler {
 		r.Use(cors.Handler)
 	}
 
+	// The OpenAPI document is filled in with the routes once they're all mounted.
+	document := &openapi.Document{}
+
+	if s.validateRequests {
+		r.Use(requestValidator{encoder: s.encoder, document: document}.Handler)
+	}
+
+	visibility := visibilityGuard{visibilityService: s.visibilityService}
+	locale := localizer{translator: s.translator}
+
+	r.Get(openapiPath, (&openapiHandler{encoder: s.encoder, document: document}).get)
 	r.Route("/health", newHealthHandler().Routes)
-	r.Route("/api/v1/characters", newCharacterHandler(s.encoder, s.characterService).Routes)
-	r.Route("/api/v1/statistics", newStatisticsHandler(s.encoder, s.statisticsService, s.credentials).Routes)
+	r.Route("/api/v1/characters", newCharacterHandler(s.encoder, s.characterService, visibility, locale, s.adminCredentials).Routes)
+	r.Route("/api/v1/statistics", newStatisticsHandler(s.encoder, s.statisticsService, visibility, s.credentials).Routes)
+
+	if s.visibilityService != nil {
+		r.Route("/api/v1/characters/{name}/visibility", newVisibilityHandler(s.encoder, s.visibilityService, s.adminCredentials).Routes)
+	}
+
+	if s.mercenaryService != nil {
+		r.Route("/api/v1/characters/{name}/mercenary", newMercenaryHandler(s.encoder, s.mercenaryService, visibility, locale).Routes)
+	}
+
+	if s.progressService != nil {
+		r.Route("/api/v1/characters/{name}/progress", newProgressHandler(s.encoder, s.progressService, visibility).Routes)
+	}
+
+	if s.skillService != nil {
+		r.Route("/api/v1/characters/{name}/skills", newSkillHandler(s.encoder, s.skillService, visibility, locale).Routes)
+	}
+
+	if s.cardService != nil {
+		r.Route("/api/v1/characters/{name}/card.png", newCardHandler(s.encoder, s.cardService, visibility).Routes)
+	}
+
+	if s.exportService != nil {
+		r.Route("/api/v1/characters/{name}/export", newExportHandler(s.encoder, s.exportService, visibility).Routes)
+	}
+
+	if s.liveHub != nil {
+		r.Route("/api/v1/characters/{name}/events", newLiveHandler(s.encoder, s.characterService, s.liveHub, visibility, locale).Routes)
+	}
+
+	if s.craftService != nil {
+		r.Route("/api/v1/characters/{name}/craftable", newCraftHandler(s.encoder, s.craftService, visibility).Routes)
+	}
+
+	if s.achievementService != nil {
+		achievements := newAchievementHandler(s.encoder, s.achievementService, visibility)
+		r.Route("/api/v1/characters/{name}/achievements", achievements.CharacterRoutes)
+		r.Route("/api/v1/achievements", achievements.Routes)
+	}
+
+	if s.compareService != nil {
+		r.Route("/api/v1/compare", newCompareHandler(s.encoder, s.compareService, visibility).Routes)
+	}
+
+	if s.accountService != nil {
+		r.Route("/api/v1/accounts", newAccountHandler(s.encoder, s.accountService, visibility).Routes)
+	}
+
+	if s.grailService != nil {
+		r.Route("/api/v1/accounts/{account}/grail", newGrailHandler(s.encoder, s.grailService, visibility).Routes)
+	}
+
+	if s.webhookService != nil {
+		r.Route("/api/v1/webhooks", newWebhookHandler(s.encoder, s.webhookService, s.adminCredentials).Routes)
+	}
+
+	if s.graveyardService != nil {
+		r.Route("/api/v1/graveyard", newGraveyardHandler(s.encoder, s.graveyardService, visibility, s.publicURL).Routes)
+	}
+
+	if s.graphService != nil {
+		r.Route("/graphql", newGraphHandler(s.encoder, s.graphService, visibility).Routes)
+	}
+
+	if s.ladderService != nil {
+		r.Route("/api/v1/realm-ladder", newLadderHandler(s.encoder, s.ladderService, visibility).Routes)
+	}
 
 	// Deprecated handler, supported for consumers who rely on it.
-	r.Route("/retrieving/v1/character", newCharacterHandler(s.encoder, s.characterService).Routes)
+	r.Route("/retrieving/v1/character", newCharacterHandler(s.encoder, s.characterService, visibility, locale, s.adminCredentials).DeprecatedRoutes)
+
+	// Only the routes that are served are documented.
+	*document = *apiDocument().Only(routesOf(r))
 
 	return r
 }
 
+// WithVisibilityService enables per character visibility, without it every character is public.
+func WithVisibilityService(visibilityService visibilityService) Option {
+	return func(s *Server) {
+		s.visibilityService = visibilityService
+	}
+}
+
+// WithAccountService enables the account routes.
+func WithAccountService(accountService accountService) Option {
+	return func(s *Server) {
+		s.accountService = accountService
+	}
+}
+
+// WithLadderService enables the realm ladder route.
+func WithLadderService(ladderService ladderService) Option {
+	return func(s *Server) {
+		s.ladderService = ladderService
+	}
+}
+
+// WithMercenaryService enables the mercenary route.
+func WithMercenaryService(mercenaryService mercenaryService) Option {
+	return func(s *Server) {
+		s.mercenaryService = mercenaryService
+	}
+}
+
+// WithProgressService enables the progress route.
+func WithProgressService(progressService progressService) Option {
+	return func(s *Server) {
+		s.progressService = progressService
+	}
+}
+
+// WithSkillService enables the skills route.
+func WithSkillService(skillService skillService) Option {
+	return func(s *Server) {
+		s.skillService = skillService
+	}
+}
+
+// WithCardService enables the character card route.
+func WithCardService(cardService cardService) Option {
+	return func(s *Server) {
+		s.cardService = cardService
+	}
+}
+
+// WithExportService enables the character export route.
+func WithExportService(exportService exportService) Option {
+	return func(s *Server) {
+		s.exportService = exportService
+	}
+}
+
+// WithCompareService enables the compare route.
+func WithCompareService(compareService compareService) Option {
+	return func(s *Server) {
+		s.compareService = compareService
+	}
+}
+
+// WithGrailService enables the Holy Grail route.
+func WithGrailService(grailService grailService) Option {
+	return func(s *Server) {
+		s.grailService = grailService
+	}
+}
+
+// WithCraftService enables the craftable runewords route.
+func WithCraftService(craftService craftService) Option {
+	return func(s *Server) {
+		s.craftService = craftService
+	}
+}
+
+// WithWebhookService enables the webhook routes.
+func WithWebhookService(webhookService webhookService) Option {
+	return func(s *Server) {
+		s.webhookService = webhookService
+	}
+}
+
+// WithLiveHub enables the live updates route.
+func WithLiveHub(liveHub liveHub) Option {
+	return func(s *Server) {
+		s.liveHub = liveHub
+	}
+}
+
+// WithGraveyardService enables the graveyard routes.
+func WithGraveyardService(graveyardService graveyardService) Option {
+	return func(s *Server) {
+		s.graveyardService = graveyardService
+	}
+}
+
+// WithAchievementService enables the achievement routes.
+func WithAchievementService(achievementService achievementService) Option {
+	return func(s *Server) {
+		s.achievementService = achievementService
+	}
+}
+
+// WithGraphService enables the GraphQL route.
+func WithGraphService(graphService graphService) Option {
+	return func(s *Server) {
+		s.graphService = graphService
+	}
+}
+
+// WithRequestValidation enables rejecting requests that don't match the OpenAPI document.
+func WithRequestValidation() Option {
+	return func(s *Server) {
+		s.validateRequests = true
+	}
+}
+
+// WithTranslator enables display names in the language of the request.
+func WithTranslator(translator translator) Option {
+	return func(s *Server) {
+		s.translator = translator
+	}
+}
+
 // NewServer returns a new server with all dependencies.
-func NewServer(addr string, characterService characterService, statisticsService statisticsService, credentials map[string]string, corsEnabled bool, loggingEnabled bool) *Server {
-	return &Server{
+func NewServer(addr string, characterService characterService, statisticsService statisticsService, credentials map[string]string, corsEnabled bool, loggingEnabled bool, opts ...Option) *Server {
+	s := &Server{
 		addr:              addr,
 		encoder:           newEncoder(),
 		characterService:  characterService,
@@ -87,4 +384,10 @@ func NewServer(addr string, characterService characterService, statisticsService
 		corsEnabled:       corsEnabled,
 		loggingEnabled:    loggingEnabled,
 	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
 }
@@ -0,0 +1,56 @@
+package httpserver
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"github.com/nokka/d2-armory-api/internal/domain"
+)
+
+// skillService represents the functionality we need to get skill allocations.
+type skillService interface {
+	// Get gets the skill allocation of the character.
+	Get(ctx context.Context, name string) (*domain.SkillTree, error)
+}
+
+// skillHandler is used to get the skill allocation of a character.
+type skillHandler struct {
+	encoder      *encoder
+	skillService skillService
+	visibility   visibilityGuard
+	locale       localizer
+}
+
+func (h skillHandler) Routes(router chi.Router) {
+	router.Get("/", h.getSkill)
+}
+
+func (h skillHandler) getSkill(w http.ResponseWriter, r *http.Request) {
+	name := chi.URLParam(r, "name")
+
+	if err := h.visibility.Authorize(r, name); err != nil {
+		h.encoder.Error(w, err)
+		return
+	}
+
+	lang := h.locale.Language(w, r)
+
+	// Pass the request context in order to make use of cancellation for lower level work.
+	tree, err := h.skillService.Get(r.Context(), name)
+	if err != nil {
+		h.encoder.Error(w, err)
+		return
+	}
+
+	h.encoder.Response(w, h.locale.SkillTree(lang, tree))
+}
+
+func newSkillHandler(encoder *encoder, skillService skillService, visibility visibilityGuard, locale localizer) *skillHandler {
+	return &skillHandler{
+		encoder:      encoder,
+		skillService: skillService,
+		visibility:   visibility,
+		locale:       locale,
+	}
+}
@@ -26,6 +26,7 @@ type statisticsService interface {
 type statisticsHandler struct {
 	encoder           *encoder
 	statisticsService statisticsService
+	visibility        visibilityGuard
 	credentials       map[string]string
 }
 
@@ -41,7 +42,7 @@ func (h statisticsHandler) Routes(router chi.Router) {
 func (h statisticsHandler) postStatistics(w http.ResponseWriter, r *http.Request) {
 	var stats []domain.StatisticsRequest
 	if err := json.NewDecoder(r.Body).Decode(&stats); err != nil {
-		h.encoder.Error(w, err)
+		h.encoder.Error(w, invalidBody("statistics", err))
 		return
 	}
 
@@ -70,6 +71,11 @@ func (h statisticsHandler) deleteStatistics(w http.ResponseWriter, r *http.Reque
 func (h statisticsHandler) getStatistics(w http.ResponseWriter, r *http.Request) {
 	characterName := r.URL.Query().Get("character")
 
+	if err := h.visibility.Authorize(r, characterName); err != nil {
+		h.encoder.Error(w, err)
+		return
+	}
+
 	//Pass the request context in order to make use of cancellation for lower level work.
 	stats, err := h.statisticsService.GetCharacter(r.Context(), characterName)
 	if err != nil {
@@ -80,10 +86,11 @@ func (h statisticsHandler) getStatistics(w http.ResponseWriter, r *http.Request)
 	h.encoder.Response(w, stats)
 }
 
-func newStatisticsHandler(encoder *encoder, statisticsService statisticsService, credentials map[string]string) *statisticsHandler {
+func newStatisticsHandler(encoder *encoder, statisticsService statisticsService, visibility visibilityGuard, credentials map[string]string) *statisticsHandler {
 	return &statisticsHandler{
 		encoder:           encoder,
 		statisticsService: statisticsService,
+		visibility:        visibility,
 		credentials:       credentials,
 	}
 }
@@ -0,0 +1,71 @@
+package httpserver
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+	"github.com/nokka/d2-armory-api/internal/openapi"
+)
+
+// maxValidatedBody is the largest request body validated, larger bodies are rejected.
+const maxValidatedBody = 1 << 20
+
+// requestValidator rejects requests that don't match the OpenAPI document before
+// they reach the handlers, requests to routes that aren't documented are let through.
+type requestValidator struct {
+	encoder  *encoder
+	document *openapi.Document
+}
+
+// Handler validates the parameters and JSON body of the request.
+func (v requestValidator) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		op, params := v.document.Match(r.Method, r.URL.Path)
+		if op == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if err := v.document.ValidateParameters(op, params, r.URL.Query()); err != nil {
+			v.encoder.Error(w, fmt.Errorf("%s: %w", err, domain.ErrRequest))
+			return
+		}
+
+		if body := jsonSchemaOf(op); body != nil {
+			data, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxValidatedBody))
+			if err != nil {
+				v.encoder.Error(w, fmt.Errorf("request body is too large: %w", domain.ErrRequest))
+				return
+			}
+
+			if err := v.document.ValidateJSON(body, "body", data); err != nil {
+				v.encoder.Error(w, fmt.Errorf("%s: %w", err, domain.ErrRequest))
+				return
+			}
+
+			// The handler reads the body all over again.
+			r.Body = ioutil.NopCloser(bytes.NewReader(data))
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// jsonSchemaOf returns the schema of the JSON request body of the operation, if it has one.
+func jsonSchemaOf(op *openapi.Operation) *openapi.Schema {
+	if op.RequestBody == nil {
+		return nil
+	}
+
+	for contentType, media := range op.RequestBody.Content {
+		if strings.HasPrefix(contentType, contentTypeJSON) {
+			return media.Schema
+		}
+	}
+
+	return nil
+}
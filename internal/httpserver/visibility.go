@@ -0,0 +1,204 @@
+package httpserver
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi"
+	"github.com/go-chi/chi/middleware"
+	"github.com/nokka/d2-armory-api/internal/domain"
+)
+
+// visibilityService represents the functionality we need to enforce and change character visibility.
+type visibilityService interface {
+	// Get gets the visibility setting of a character.
+	Get(ctx context.Context, character string) (*domain.VisibilitySetting, error)
+
+	// Set sets the visibility of a character.
+	Set(ctx context.Context, character string, visibility string, rotateToken bool) (*domain.VisibilitySetting, error)
+
+	// IssueOwnerSecret gives the character a new owner secret.
+	IssueOwnerSecret(ctx context.Context, character string) (string, error)
+
+	// IsOwner reports whether the secret is the owner secret of the character.
+	IsOwner(ctx context.Context, character string, secret string) (bool, error)
+
+	// Authorize returns an error if the character isn't visible with the token.
+	Authorize(ctx context.Context, character string, token string) error
+
+	// Hidden returns the characters to leave out of listings.
+	Hidden(ctx context.Context) (map[string]struct{}, error)
+}
+
+// visibilityGuard is used by handlers to honor the visibility of characters,
+// everything is visible when there's no visibility service configured.
+type visibilityGuard struct {
+	visibilityService visibilityService
+}
+
+// Authorize returns an error if the character isn't visible to the request,
+// private characters are visible with the share token in the token query parameter.
+func (g visibilityGuard) Authorize(r *http.Request, character string) error {
+	if g.visibilityService == nil {
+		return nil
+	}
+
+	return g.AuthorizeToken(r.Context(), character, r.URL.Query().Get("token"))
+}
+
+// AuthorizeToken returns an error if the character isn't visible with the share token,
+// used by handlers serving several characters that each have their own token.
+func (g visibilityGuard) AuthorizeToken(ctx context.Context, character string, token string) error {
+	if g.visibilityService == nil {
+		return nil
+	}
+
+	return g.visibilityService.Authorize(ctx, character, token)
+}
+
+// Private reports whether the character is private, responses of private characters
+// mustn't be stored by shared caches. Characters are treated as private when the
+// setting can't be read.
+func (g visibilityGuard) Private(ctx context.Context, character string) bool {
+	if g.visibilityService == nil {
+		return false
+	}
+
+	setting, err := g.visibilityService.Get(ctx, character)
+	if err != nil {
+		return true
+	}
+
+	return setting.Visibility == domain.VisibilityPrivate
+}
+
+// Hidden returns the characters to leave out of listings.
+func (g visibilityGuard) Hidden(ctx context.Context) (map[string]struct{}, error) {
+	if g.visibilityService == nil {
+		return nil, nil
+	}
+
+	return g.visibilityService.Hidden(ctx)
+}
+
+// visibilityHandler is used by owners to change the visibility of their characters.
+type visibilityHandler struct {
+	encoder           *encoder
+	visibilityService visibilityService
+	adminCredentials  map[string]string
+}
+
+func (h visibilityHandler) Routes(router chi.Router) {
+	// Owners manage visibility with their owner secret, admins can manage every character.
+	router.With(h.ownerOrAdmin).Get("/", h.getVisibility)
+	router.With(h.ownerOrAdmin).Put("/", h.putVisibility)
+
+	// Owner secrets are handed out by an admin once the owner has been verified.
+	router.With(middleware.BasicAuth("admin", h.adminCredentials)).Post("/owner-secret", h.issueOwnerSecret)
+}
+
+// ownerOrAdmin lets the request through with the admin credentials or
+// with the owner secret of the character as a bearer token.
+func (h visibilityHandler) ownerOrAdmin(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if h.isAdmin(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if secret := bearerToken(r); secret != "" {
+			owner, err := h.visibilityService.IsOwner(r.Context(), chi.URLParam(r, "name"), secret)
+			if err != nil {
+				h.encoder.Error(w, err)
+				return
+			}
+
+			if owner {
+				next.ServeHTTP(w, r)
+				return
+			}
+		}
+
+		w.Header().Add("WWW-Authenticate", `Basic realm="admin"`)
+		w.Header().Add("WWW-Authenticate", "Bearer")
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+}
+
+// isAdmin reports whether the request carries valid admin credentials.
+func (h visibilityHandler) isAdmin(r *http.Request) bool {
+	user, pass, ok := r.BasicAuth()
+	if !ok {
+		return false
+	}
+
+	credPass, ok := h.adminCredentials[user]
+	return ok && subtle.ConstantTimeCompare([]byte(pass), []byte(credPass)) == 1
+}
+
+// bearerToken returns the bearer token of the request, if any.
+func bearerToken(r *http.Request) string {
+	header := r.Header.Get("Authorization")
+	if len(header) < len("Bearer ") || !strings.EqualFold(header[:len("Bearer ")], "Bearer ") {
+		return ""
+	}
+
+	return strings.TrimSpace(header[len("Bearer "):])
+}
+
+func (h visibilityHandler) getVisibility(w http.ResponseWriter, r *http.Request) {
+	setting, err := h.visibilityService.Get(r.Context(), chi.URLParam(r, "name"))
+	if err != nil {
+		h.encoder.Error(w, err)
+		return
+	}
+
+	h.encoder.Response(w, setting)
+}
+
+func (h visibilityHandler) putVisibility(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Visibility  string `json:"visibility"`
+		RotateToken bool   `json:"rotate_token"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.encoder.Error(w, invalidBody("visibility", err))
+		return
+	}
+
+	// Pass the request context in order to make use of cancellation for lower level work.
+	setting, err := h.visibilityService.Set(r.Context(), chi.URLParam(r, "name"), req.Visibility, req.RotateToken)
+	if err != nil {
+		h.encoder.Error(w, err)
+		return
+	}
+
+	h.encoder.Response(w, setting)
+}
+
+func (h visibilityHandler) issueOwnerSecret(w http.ResponseWriter, r *http.Request) {
+	secret, err := h.visibilityService.IssueOwnerSecret(r.Context(), chi.URLParam(r, "name"))
+	if err != nil {
+		h.encoder.Error(w, err)
+		return
+	}
+
+	h.encoder.Response(w, ownerSecret{OwnerSecret: secret})
+}
+
+// ownerSecret is the response of a newly issued owner secret.
+type ownerSecret struct {
+	OwnerSecret string `json:"owner_secret"`
+}
+
+func newVisibilityHandler(encoder *encoder, visibilityService visibilityService, adminCredentials map[string]string) *visibilityHandler {
+	return &visibilityHandler{
+		encoder:           encoder,
+		visibilityService: visibilityService,
+		adminCredentials:  adminCredentials,
+	}
+}
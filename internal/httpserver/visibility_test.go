@@ -0,0 +1,104 @@
+package httpserver
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+)
+
+// ownedVisibility is a visibility service where nokka is owned with the secret owned.
+type ownedVisibility struct{ visibilityService }
+
+func (ownedVisibility) Get(ctx context.Context, character string) (*domain.VisibilitySetting, error) {
+	return &domain.VisibilitySetting{Character: character, Visibility: domain.VisibilityPrivate}, nil
+}
+
+func (ownedVisibility) IsOwner(ctx context.Context, character string, secret string) (bool, error) {
+	return character == "nokka" && secret == "owned", nil
+}
+
+func (ownedVisibility) IssueOwnerSecret(ctx context.Context, character string) (string, error) {
+	return "owned", nil
+}
+
+func TestVisibilityAuth(t *testing.T) {
+	srv := NewServer(":80", nil, nil, nil, false, false,
+		WithVisibilityService(ownedVisibility{}),
+		WithAdminCredentials(map[string]string{"admin": "secret"}),
+	)
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		auth   func(r *http.Request)
+		want   int
+	}{
+		{
+			name:   "anonymous",
+			method: http.MethodGet,
+			path:   "/api/v1/characters/nokka/visibility",
+			auth:   func(r *http.Request) {},
+			want:   http.StatusUnauthorized,
+		},
+		{
+			name:   "owner",
+			method: http.MethodGet,
+			path:   "/api/v1/characters/nokka/visibility",
+			auth:   func(r *http.Request) { r.Header.Set("Authorization", "Bearer owned") },
+			want:   http.StatusOK,
+		},
+		{
+			name:   "owner of another character",
+			method: http.MethodGet,
+			path:   "/api/v1/characters/meanski/visibility",
+			auth:   func(r *http.Request) { r.Header.Set("Authorization", "Bearer owned") },
+			want:   http.StatusUnauthorized,
+		},
+		{
+			name:   "admin",
+			method: http.MethodGet,
+			path:   "/api/v1/characters/meanski/visibility",
+			auth:   func(r *http.Request) { r.SetBasicAuth("admin", "secret") },
+			want:   http.StatusOK,
+		},
+		{
+			name:   "wrong admin password",
+			method: http.MethodGet,
+			path:   "/api/v1/characters/meanski/visibility",
+			auth:   func(r *http.Request) { r.SetBasicAuth("admin", "guess") },
+			want:   http.StatusUnauthorized,
+		},
+		{
+			name:   "owner can't issue secrets",
+			method: http.MethodPost,
+			path:   "/api/v1/characters/nokka/visibility/owner-secret",
+			auth:   func(r *http.Request) { r.Header.Set("Authorization", "Bearer owned") },
+			want:   http.StatusUnauthorized,
+		},
+		{
+			name:   "admin issues secrets",
+			method: http.MethodPost,
+			path:   "/api/v1/characters/nokka/visibility/owner-secret",
+			auth:   func(r *http.Request) { r.SetBasicAuth("admin", "secret") },
+			want:   http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			tt.auth(req)
+
+			srv.Handler().ServeHTTP(recorder, req)
+
+			if recorder.Code != tt.want {
+				t.Errorf("want status %d, got = %d", tt.want, recorder.Code)
+			}
+		})
+	}
+}
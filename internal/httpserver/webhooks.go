@@ -0,0 +1,105 @@
+package httpserver
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"github.com/go-chi/chi/middleware"
+	"github.com/nokka/d2-armory-api/internal/domain"
+)
+
+// webhookService represents the functionality we need to manage webhooks.
+type webhookService interface {
+	Register(ctx context.Context, url string, events []string) (*domain.Webhook, error)
+	List(ctx context.Context) ([]domain.Webhook, error)
+	Delete(ctx context.Context, id string) error
+	DeadLetters(ctx context.Context) ([]domain.DeadLetter, error)
+	Redeliver(ctx context.Context, id string) error
+}
+
+// webhookHandler is used by the realm to manage webhooks.
+type webhookHandler struct {
+	encoder          *encoder
+	webhookService   webhookService
+	adminCredentials map[string]string
+}
+
+func (h webhookHandler) Routes(router chi.Router) {
+	// Webhooks are managed by an authenticated client.
+	router.Use(middleware.BasicAuth("admin", h.adminCredentials))
+
+	router.Get("/", h.listWebhooks)
+	router.Post("/", h.registerWebhook)
+	router.Delete("/{id}", h.deleteWebhook)
+	router.Get("/dead-letters", h.listDeadLetters)
+	router.Post("/dead-letters/{id}/redeliver", h.redeliver)
+}
+
+func (h webhookHandler) listWebhooks(w http.ResponseWriter, r *http.Request) {
+	webhooks, err := h.webhookService.List(r.Context())
+	if err != nil {
+		h.encoder.Error(w, err)
+		return
+	}
+
+	h.encoder.Response(w, webhooks)
+}
+
+func (h webhookHandler) registerWebhook(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		URL    string   `json:"url"`
+		Events []string `json:"events"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.encoder.Error(w, invalidBody("webhook", err))
+		return
+	}
+
+	// Pass the request context in order to make use of cancellation for lower level work.
+	webhook, err := h.webhookService.Register(r.Context(), req.URL, req.Events)
+	if err != nil {
+		h.encoder.Error(w, err)
+		return
+	}
+
+	h.encoder.StatusResponse(w, webhook, http.StatusCreated)
+}
+
+func (h webhookHandler) deleteWebhook(w http.ResponseWriter, r *http.Request) {
+	if err := h.webhookService.Delete(r.Context(), chi.URLParam(r, "id")); err != nil {
+		h.encoder.Error(w, err)
+		return
+	}
+
+	h.encoder.StatusResponse(w, map[string]string{"status": "ok"}, http.StatusOK)
+}
+
+func (h webhookHandler) listDeadLetters(w http.ResponseWriter, r *http.Request) {
+	letters, err := h.webhookService.DeadLetters(r.Context())
+	if err != nil {
+		h.encoder.Error(w, err)
+		return
+	}
+
+	h.encoder.Response(w, letters)
+}
+
+func (h webhookHandler) redeliver(w http.ResponseWriter, r *http.Request) {
+	if err := h.webhookService.Redeliver(r.Context(), chi.URLParam(r, "id")); err != nil {
+		h.encoder.Error(w, err)
+		return
+	}
+
+	h.encoder.StatusResponse(w, map[string]string{"status": "queued"}, http.StatusAccepted)
+}
+
+func newWebhookHandler(encoder *encoder, webhookService webhookService, adminCredentials map[string]string) *webhookHandler {
+	return &webhookHandler{
+		encoder:          encoder,
+		webhookService:   webhookService,
+		adminCredentials: adminCredentials,
+	}
+}
@@ -0,0 +1,182 @@
+// Package ladder imports the realm ladder PvPGN/d2cs writes to disk.
+package ladder
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+)
+
+// Character status flags of ladder entries.
+const (
+	statusDead = 0x08
+)
+
+// classes in the order d2cs numbers them.
+var classes = []string{
+	"amazon",
+	"sorceress",
+	"necromancer",
+	"paladin",
+	"barbarian",
+	"druid",
+	"assassin",
+}
+
+// split is a single ladder type of d2cs.
+type split struct {
+	mode  string
+	class string
+}
+
+// splits maps the d2cs ladder types to modes and classes, the first type of each
+// mode is the overall ladder followed by one per class.
+var splits = func() map[uint32]split {
+	splits := make(map[uint32]split)
+
+	add := func(first uint32, mode string, classCount int) {
+		splits[first] = split{mode: mode, class: domain.LadderOverall}
+		for i := 0; i < classCount; i++ {
+			splits[first+1+uint32(i)] = split{mode: mode, class: classes[i]}
+		}
+	}
+
+	// Classic has no druids or assassins.
+	add(0x00, domain.LadderClassicHardcore, 5)
+	add(0x09, domain.LadderClassicSoftcore, 5)
+	add(0x13, domain.LadderExpansionHardcore, 7)
+	add(0x1B, domain.LadderExpansionSoftcore, 7)
+
+	return splits
+}()
+
+// datHeader is the header of ladder.dat, t_d2ladderfile_header in d2cs.
+type datHeader struct {
+	MaxType  uint32
+	Checksum uint32
+}
+
+// datIndex locates the entries of a ladder type, t_d2ladderfile_ladderindex in d2cs.
+type datIndex struct {
+	Type   uint32
+	Offset uint32
+	Number uint32
+}
+
+// datEntry is a single ranked character, t_d2ladderfile_ladderinfo in d2cs.
+type datEntry struct {
+	Experience uint32
+	Status     uint16
+	Level      uint8
+	Class      uint8
+	Name       [16]byte
+}
+
+// ReadDat reads the entries of the binary ladder.dat file.
+func ReadDat(r io.Reader) ([]domain.LadderEntry, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	rd := bytes.NewReader(data)
+
+	var header datHeader
+	if err := binary.Read(rd, binary.LittleEndian, &header); err != nil {
+		return nil, fmt.Errorf("failed to read ladder header: %w", err)
+	}
+
+	// The counts come straight from the file, make sure they fit in it before allocating.
+	if !fits(len(data), int64(binary.Size(header)), uint64(header.MaxType), binary.Size(datIndex{})) {
+		return nil, fmt.Errorf("ladder index of %d types doesn't fit in %d bytes", header.MaxType, len(data))
+	}
+
+	indexes := make([]datIndex, header.MaxType)
+	if err := binary.Read(rd, binary.LittleEndian, indexes); err != nil {
+		return nil, fmt.Errorf("failed to read ladder index: %w", err)
+	}
+
+	entries := make([]domain.LadderEntry, 0)
+	for _, idx := range indexes {
+		s, ok := splits[idx.Type]
+		if !ok || idx.Number == 0 {
+			continue
+		}
+
+		if !fits(len(data), int64(idx.Offset), uint64(idx.Number), binary.Size(datEntry{})) {
+			return nil, fmt.Errorf("%d entries of ladder type %d don't fit in %d bytes", idx.Number, idx.Type, len(data))
+		}
+
+		if _, err := rd.Seek(int64(idx.Offset), io.SeekStart); err != nil {
+			return nil, fmt.Errorf("invalid offset of ladder type %d: %w", idx.Type, err)
+		}
+
+		ranked := make([]datEntry, idx.Number)
+		if err := binary.Read(rd, binary.LittleEndian, ranked); err != nil {
+			return nil, fmt.Errorf("failed to read ladder type %d: %w", idx.Type, err)
+		}
+
+		for i, e := range ranked {
+			name := e.Name[:]
+			if n := bytes.IndexByte(name, 0); n >= 0 {
+				name = name[:n]
+			}
+
+			// Unused slots of the ladder are left empty.
+			if len(name) == 0 {
+				continue
+			}
+
+			entries = append(entries, domain.LadderEntry{
+				Mode:       s.mode,
+				Ladder:     s.class,
+				Rank:       i + 1,
+				Character:  domain.NormalizeName(string(name)),
+				Class:      className(int(e.Class)),
+				Level:      int(e.Level),
+				Experience: e.Experience,
+				Dead:       e.Status&statusDead != 0,
+			})
+		}
+	}
+
+	return entries, nil
+}
+
+// fits reports whether count records of size bytes starting at offset fit in length bytes.
+func fits(length int, offset int64, count uint64, size int) bool {
+	if offset > int64(length) {
+		return false
+	}
+
+	return count <= uint64(int64(length)-offset)/uint64(size)
+}
+
+// className returns the class with the d2cs class number.
+func className(class int) string {
+	if class < 0 || class >= len(classes) {
+		return ""
+	}
+
+	return classes[class]
+}
+
+// validClass reports whether class is a ladder class.
+func validClass(class string) bool {
+	if class == domain.LadderOverall {
+		return true
+	}
+
+	for _, c := range classes {
+		if strings.EqualFold(c, class) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,164 @@
+package ladder
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+	"testing"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+)
+
+// datFile builds a ladder.dat with the given ladder types the way d2cs writes it.
+func datFile(t *testing.T, ladders map[uint32][]datEntry, types ...uint32) []byte {
+	t.Helper()
+
+	header := datHeader{MaxType: uint32(len(types))}
+	offset := uint32(binary.Size(header) + len(types)*binary.Size(datIndex{}))
+
+	var indexes []datIndex
+	for _, typ := range types {
+		indexes = append(indexes, datIndex{Type: typ, Offset: offset, Number: uint32(len(ladders[typ]))})
+		offset += uint32(len(ladders[typ]) * binary.Size(datEntry{}))
+	}
+
+	var buf bytes.Buffer
+	values := []interface{}{header, indexes}
+	for _, typ := range types {
+		values = append(values, ladders[typ])
+	}
+
+	for _, v := range values {
+		if err := binary.Write(&buf, binary.LittleEndian, v); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return buf.Bytes()
+}
+
+func entry(name string, class uint8, level uint8, status uint16) datEntry {
+	e := datEntry{Experience: uint32(level) * 1000, Status: status, Level: level, Class: class}
+	copy(e.Name[:], name)
+	return e
+}
+
+func TestReadDat(t *testing.T) {
+	data := datFile(t, map[uint32][]datEntry{
+		0x1B: {entry("Nokka", 1, 99, 0x20), entry("Dead", 4, 90, 0x28), {}},
+		0x1C: {entry("Ama", 0, 80, 0x20)},
+	}, 0x1B, 0x1C)
+
+	entries, err := ReadDat(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []domain.LadderEntry{
+		{Mode: domain.LadderExpansionSoftcore, Ladder: domain.LadderOverall, Rank: 1, Character: "nokka", Class: "sorceress", Level: 99, Experience: 99000},
+		{Mode: domain.LadderExpansionSoftcore, Ladder: domain.LadderOverall, Rank: 2, Character: "dead", Class: "barbarian", Level: 90, Experience: 90000, Dead: true},
+		{Mode: domain.LadderExpansionSoftcore, Ladder: "amazon", Rank: 1, Character: "ama", Class: "amazon", Level: 80, Experience: 80000},
+	}
+
+	if len(entries) != len(expected) {
+		t.Fatalf("expected %d entries, got %d", len(expected), len(entries))
+	}
+
+	for i := range expected {
+		if entries[i] != expected[i] {
+			t.Errorf("expected %+v, got %+v", expected[i], entries[i])
+		}
+	}
+}
+
+func TestReadDatTruncated(t *testing.T) {
+	data := datFile(t, map[uint32][]datEntry{
+		0x00: {entry("nokka", 1, 99, 0x04)},
+	}, 0x00)
+
+	if _, err := ReadDat(bytes.NewReader(data[:len(data)-4])); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
+
+func TestReadDatCorrupt(t *testing.T) {
+	data := datFile(t, map[uint32][]datEntry{
+		0x00: {entry("nokka", 1, 99, 0x04)},
+	}, 0x00)
+
+	header := binary.Size(datHeader{})
+
+	tests := []struct {
+		name    string
+		corrupt func(data []byte)
+	}{
+		{
+			name: "index count",
+			corrupt: func(data []byte) {
+				binary.LittleEndian.PutUint32(data[0:], 0xFFFFFFFF)
+			},
+		},
+		{
+			name: "entry count",
+			corrupt: func(data []byte) {
+				binary.LittleEndian.PutUint32(data[header+8:], 0xFFFFFFFF)
+			},
+		},
+		{
+			name: "entry offset",
+			corrupt: func(data []byte) {
+				binary.LittleEndian.PutUint32(data[header+4:], 0xFFFFFFFF)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			corrupt := append([]byte(nil), data...)
+			tt.corrupt(corrupt)
+
+			if _, err := ReadDat(bytes.NewReader(corrupt)); err == nil {
+				t.Fatal("expected error, got nil")
+			}
+		})
+	}
+}
+
+func TestReadXML(t *testing.T) {
+	doc := `<?xml version="1.0" encoding="UTF-8" ?>
+<D2_ladders>
+	<ladder>
+		<type>19</type>
+		<mode>Expansion Hardcore</mode>
+		<class>OverAll</class>
+		<char>
+			<rank>1</rank>
+			<name>Nokka</name>
+			<level>95</level>
+			<experience>2000000</experience>
+			<class>Sor</class>
+			<status>dead</status>
+		</char>
+	</ladder>
+</D2_ladders>`
+
+	entries, err := ReadXML(strings.NewReader(doc))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := domain.LadderEntry{
+		Mode:       domain.LadderExpansionHardcore,
+		Ladder:     domain.LadderOverall,
+		Rank:       1,
+		Character:  "nokka",
+		Class:      "sorceress",
+		Level:      95,
+		Experience: 2000000,
+		Dead:       true,
+	}
+
+	if len(entries) != 1 || entries[0] != expected {
+		t.Errorf("expected %+v, got %+v", expected, entries)
+	}
+}
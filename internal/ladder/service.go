@@ -0,0 +1,149 @@
+package ladder
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+)
+
+//go:generate moq -out ./service_mocks.go . ladderRepository characterRepository
+
+// ladderRepository is the interface representation of the ladder data layer
+// the service depend on.
+type ladderRepository interface {
+	Replace(ctx context.Context, entries []domain.LadderEntry, importedAt time.Time) error
+	Find(ctx context.Context, mode string, ladder string) ([]domain.LadderEntry, error)
+}
+
+// characterRepository is the interface representation of the character data layer
+// the service depend on.
+type characterRepository interface {
+	FindSummaries(ctx context.Context, ids []string) ([]domain.CharacterSummary, error)
+}
+
+var validModes = map[string]struct{}{
+	domain.LadderClassicSoftcore:   {},
+	domain.LadderClassicHardcore:   {},
+	domain.LadderExpansionSoftcore: {},
+	domain.LadderExpansionHardcore: {},
+}
+
+// Service performs all operations on the realm ladder.
+type Service struct {
+	path       string
+	ladders    ladderRepository
+	characters characterRepository
+}
+
+// Import reads the ladder file and replaces the stored ladder with it, files
+// with the .xml extension are read as the XML export, others as ladder.dat.
+func (s Service) Import(ctx context.Context) (int, error) {
+	file, err := os.Open(s.path)
+	if err != nil {
+		return 0, fmt.Errorf("failed to open ladder file: %w", err)
+	}
+
+	// Close the file when we're done.
+	defer file.Close()
+
+	var entries []domain.LadderEntry
+	if strings.EqualFold(filepath.Ext(s.path), ".xml") {
+		entries, err = ReadXML(file)
+	} else {
+		entries, err = ReadDat(file)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	// Link the entries to the characters in the armory.
+	seen := make(map[string]struct{})
+	names := make([]string, 0)
+	for _, e := range entries {
+		if _, ok := seen[e.Character]; !ok {
+			seen[e.Character] = struct{}{}
+			names = append(names, e.Character)
+		}
+	}
+
+	summaries, err := s.characters.FindSummaries(ctx, names)
+	if err != nil {
+		return 0, err
+	}
+
+	armory := make(map[string]struct{}, len(summaries))
+	for _, c := range summaries {
+		armory[c.Name] = struct{}{}
+	}
+
+	// MongoDB stores times in milliseconds, the previous import is
+	// identified by an earlier time so it must survive the round trip.
+	importedAt := time.Now().Truncate(time.Millisecond)
+	for i := range entries {
+		_, entries[i].Armory = armory[entries[i].Character]
+		entries[i].ImportedAt = importedAt
+	}
+
+	if err := s.ladders.Replace(ctx, entries, importedAt); err != nil {
+		return 0, err
+	}
+
+	return len(entries), nil
+}
+
+// Get will get a split of the realm ladder, the excluded characters are left out.
+// Defaults to the overall expansion softcore ladder.
+func (s Service) Get(ctx context.Context, mode string, class string, exclude map[string]struct{}) (*domain.RealmLadder, error) {
+	mode = strings.ToLower(mode)
+	if mode == "" {
+		mode = domain.LadderExpansionSoftcore
+	}
+
+	if _, ok := validModes[mode]; !ok {
+		return nil, fmt.Errorf("invalid ladder mode %s: %w", mode, domain.ErrRequest)
+	}
+
+	class = strings.ToLower(class)
+	if class == "" {
+		class = domain.LadderOverall
+	}
+
+	if !validClass(class) {
+		return nil, fmt.Errorf("invalid ladder class %s: %w", class, domain.ErrRequest)
+	}
+
+	entries, err := s.ladders.Find(ctx, mode, class)
+	if err != nil {
+		return nil, err
+	}
+
+	ladder := &domain.RealmLadder{
+		Mode:    mode,
+		Class:   class,
+		Entries: make([]domain.LadderEntry, 0, len(entries)),
+	}
+
+	for _, e := range entries {
+		if _, ok := exclude[e.Character]; ok {
+			continue
+		}
+
+		ladder.Entries = append(ladder.Entries, e)
+	}
+
+	return ladder, nil
+}
+
+// NewService constructs a new ladder service importing the ladder file at path.
+func NewService(path string, ladderRepository ladderRepository, characterRepository characterRepository) *Service {
+	return &Service{
+		path:       path,
+		ladders:    ladderRepository,
+		characters: characterRepository,
+	}
+}
@@ -0,0 +1,146 @@
+package ladder
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+)
+
+func TestImportLadder(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ladder")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "ladder.dat")
+	data := datFile(t, map[uint32][]datEntry{
+		0x1B: {entry("nokka", 1, 99, 0x20), entry("other", 4, 90, 0x20)},
+	}, 0x1B)
+
+	if err := ioutil.WriteFile(path, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	ladders := &ladderRepositoryMock{
+		ReplaceFunc: func(ctx context.Context, entries []domain.LadderEntry, importedAt time.Time) error {
+			return nil
+		},
+	}
+
+	characters := &characterRepositoryMock{
+		FindSummariesFunc: func(ctx context.Context, ids []string) ([]domain.CharacterSummary, error) {
+			return []domain.CharacterSummary{{Name: "nokka"}}, nil
+		},
+	}
+
+	n, err := NewService(path, ladders, characters).Import(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if n != 2 {
+		t.Fatalf("expected 2 entries imported, got %d", n)
+	}
+
+	if len(ladders.ReplaceCalls()) != 1 {
+		t.Fatalf("expected 1 replace call, got %d", len(ladders.ReplaceCalls()))
+	}
+
+	call := ladders.ReplaceCalls()[0]
+	if !call.Entries[0].Armory || call.Entries[1].Armory {
+		t.Errorf("expected only nokka to be linked to the armory, got %+v", call.Entries)
+	}
+
+	for _, e := range call.Entries {
+		if !e.ImportedAt.Equal(call.ImportedAt) {
+			t.Errorf("expected imported at %s, got %s", call.ImportedAt, e.ImportedAt)
+		}
+	}
+}
+
+func TestGetLadder(t *testing.T) {
+	tests := []struct {
+		name            string
+		mode            string
+		class           string
+		exclude         map[string]struct{}
+		expectedMode    string
+		expectedLadder  string
+		expectedEntries int
+		expectedError   error
+	}{
+		{
+			name:            "defaults",
+			expectedMode:    domain.LadderExpansionSoftcore,
+			expectedLadder:  domain.LadderOverall,
+			expectedEntries: 2,
+		},
+		{
+			name:            "class split",
+			mode:            domain.LadderClassicHardcore,
+			class:           "Sorceress",
+			expectedMode:    domain.LadderClassicHardcore,
+			expectedLadder:  "sorceress",
+			expectedEntries: 2,
+		},
+		{
+			name:            "excluded characters are left out",
+			exclude:         map[string]struct{}{"nokka": {}},
+			expectedMode:    domain.LadderExpansionSoftcore,
+			expectedLadder:  domain.LadderOverall,
+			expectedEntries: 1,
+		},
+		{
+			name:          "invalid mode",
+			mode:          "ironman",
+			expectedError: domain.ErrRequest,
+		},
+		{
+			name:          "invalid class",
+			class:         "necro",
+			expectedError: domain.ErrRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ladders := &ladderRepositoryMock{
+				FindFunc: func(ctx context.Context, mode string, ladder string) ([]domain.LadderEntry, error) {
+					return []domain.LadderEntry{
+						{Mode: mode, Ladder: ladder, Rank: 1, Character: "nokka"},
+						{Mode: mode, Ladder: ladder, Rank: 2, Character: "other"},
+					}, nil
+				},
+			}
+
+			ladder, err := NewService("", ladders, &characterRepositoryMock{}).Get(context.Background(), tt.mode, tt.class, tt.exclude)
+			if !errors.Is(err, tt.expectedError) {
+				t.Fatalf("expected error %v, got %v", tt.expectedError, err)
+			}
+
+			if tt.expectedError != nil {
+				return
+			}
+
+			if len(ladders.FindCalls()) != 1 {
+				t.Fatalf("expected 1 find call, got %d", len(ladders.FindCalls()))
+			}
+
+			call := ladders.FindCalls()[0]
+			if call.Mode != tt.expectedMode || call.Ladder != tt.expectedLadder {
+				t.Errorf("expected ladder %s %s, got %s %s", tt.expectedMode, tt.expectedLadder, call.Mode, call.Ladder)
+			}
+
+			if len(ladder.Entries) != tt.expectedEntries {
+				t.Errorf("expected %d entries, got %d", tt.expectedEntries, len(ladder.Entries))
+			}
+		})
+	}
+}
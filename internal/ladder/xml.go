@@ -0,0 +1,73 @@
+package ladder
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+)
+
+// xmlLadders is the XML ladder export of d2cs.
+type xmlLadders struct {
+	Ladders []struct {
+		Type       uint32 `xml:"type"`
+		Characters []struct {
+			Rank       int    `xml:"rank"`
+			Name       string `xml:"name"`
+			Level      int    `xml:"level"`
+			Experience uint32 `xml:"experience"`
+			Class      string `xml:"class"`
+			Status     string `xml:"status"`
+		} `xml:"char"`
+	} `xml:"ladder"`
+}
+
+// ReadXML reads the entries of the XML ladder export.
+func ReadXML(r io.Reader) ([]domain.LadderEntry, error) {
+	var doc xmlLadders
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("failed to read ladder xml: %w", err)
+	}
+
+	entries := make([]domain.LadderEntry, 0)
+	for _, l := range doc.Ladders {
+		s, ok := splits[l.Type]
+		if !ok {
+			continue
+		}
+
+		for _, c := range l.Characters {
+			entries = append(entries, domain.LadderEntry{
+				Mode:       s.mode,
+				Ladder:     s.class,
+				Rank:       c.Rank,
+				Character:  domain.NormalizeName(c.Name),
+				Class:      classByName(c.Class),
+				Level:      c.Level,
+				Experience: c.Experience,
+				Dead:       strings.EqualFold(strings.TrimSpace(c.Status), "dead"),
+			})
+		}
+	}
+
+	return entries, nil
+}
+
+// classByName returns the class matching the name, the export
+// abbreviates classes in some versions, e.g. sor for sorceress.
+func classByName(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if len(name) < 3 {
+		return ""
+	}
+
+	for _, c := range classes {
+		if strings.HasPrefix(c, name[:3]) {
+			return c
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,181 @@
+package live
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+)
+
+// bufferSize is the number of updates kept for a connection that hasn't caught up,
+// further updates are dropped until it has.
+const bufferSize = 16
+
+// Subscription is a single live connection to a character.
+type Subscription struct {
+	character string
+	updates   chan domain.LiveUpdate
+}
+
+// Character returns the normalized name of the character.
+func (s *Subscription) Character() string {
+	return s.character
+}
+
+// Updates returns the updates of the character, the channel is closed when
+// the hub is shutting down.
+func (s *Subscription) Updates() <-chan domain.LiveUpdate {
+	return s.updates
+}
+
+// Hub fans the updates of characters out to their live connections.
+type Hub struct {
+	maxConnections  int
+	maxPerCharacter int
+
+	mu          sync.Mutex
+	subscribers map[string]map[*Subscription]struct{}
+	connections int
+	closed      bool
+}
+
+// Subscribe opens a live connection to the character, it fails when the
+// connection limits are reached or the hub has been closed.
+func (h *Hub) Subscribe(name string) (*Subscription, error) {
+	name = domain.NormalizeName(name)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		return nil, fmt.Errorf("live updates are shutting down: %w", domain.ErrUnavailable)
+	}
+
+	if h.connections >= h.maxConnections {
+		return nil, fmt.Errorf("too many live connections: %w", domain.ErrUnavailable)
+	}
+
+	if len(h.subscribers[name]) >= h.maxPerCharacter {
+		return nil, fmt.Errorf("too many live connections to %s: %w", name, domain.ErrUnavailable)
+	}
+
+	sub := &Subscription{
+		character: name,
+		updates:   make(chan domain.LiveUpdate, bufferSize),
+	}
+
+	if h.subscribers[name] == nil {
+		h.subscribers[name] = make(map[*Subscription]struct{})
+	}
+
+	h.subscribers[name][sub] = struct{}{}
+	h.connections++
+
+	return sub, nil
+}
+
+// Unsubscribe closes the live connection, it's safe to call after the hub is closed.
+func (h *Hub) Unsubscribe(sub *Subscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	subs, ok := h.subscribers[sub.character]
+	if !ok {
+		return
+	}
+
+	if _, ok := subs[sub]; !ok {
+		return
+	}
+
+	delete(subs, sub)
+	if len(subs) == 0 {
+		delete(h.subscribers, sub.character)
+	}
+
+	h.connections--
+	close(sub.updates)
+}
+
+// Close ends every live connection and refuses new ones, used when the server shuts down.
+func (h *Hub) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.closed = true
+
+	for name, subs := range h.subscribers {
+		for sub := range subs {
+			close(sub.updates)
+		}
+		delete(h.subscribers, name)
+	}
+
+	h.connections = 0
+}
+
+// Connections returns the number of open live connections.
+func (h *Hub) Connections() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.connections
+}
+
+// Characters returns the normalized names of the characters with live connections.
+func (h *Hub) Characters() []string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	names := make([]string, 0, len(h.subscribers))
+	for name := range h.subscribers {
+		names = append(names, name)
+	}
+
+	return names
+}
+
+// CharacterPersisted pushes the reparsed character to its live connections.
+func (h *Hub) CharacterPersisted(ctx context.Context, previous *domain.Character, current *domain.Character) error {
+	h.publish(current.ID, domain.LiveUpdate{
+		Type:      domain.LiveCharacter,
+		Character: current,
+	})
+
+	return nil
+}
+
+// StatisticsSubmitted pushes the posted statistics to the live connections of the character.
+func (h *Hub) StatisticsSubmitted(ctx context.Context, previous *domain.CharacterStatistics, submitted domain.StatisticsRequest) error {
+	h.publish(submitted.Character, domain.LiveUpdate{
+		Type:       domain.LiveStatistics,
+		Statistics: &submitted,
+	})
+
+	return nil
+}
+
+// publish sends the update without blocking, connections that haven't caught
+// up miss it, since every character update holds the whole character.
+func (h *Hub) publish(name string, update domain.LiveUpdate) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for sub := range h.subscribers[domain.NormalizeName(name)] {
+		select {
+		case sub.updates <- update:
+		default:
+		}
+	}
+}
+
+// NewHub returns a hub allowing at most maxConnections live connections in total,
+// and at most maxPerCharacter to a single character.
+func NewHub(maxConnections int, maxPerCharacter int) *Hub {
+	return &Hub{
+		maxConnections:  maxConnections,
+		maxPerCharacter: maxPerCharacter,
+		subscribers:     make(map[string]map[*Subscription]struct{}),
+	}
+}
@@ -0,0 +1,123 @@
+package live
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+)
+
+func TestSubscribe(t *testing.T) {
+	tests := []struct {
+		name          string
+		subscribed    []string
+		character     string
+		closed        bool
+		expectedError bool
+	}{
+		{
+			name:      "subscribe successful",
+			character: "nokka",
+		},
+		{
+			name:          "too many connections to the character",
+			subscribed:    []string{"nokka", "Nokka"},
+			character:     "nokka",
+			expectedError: true,
+		},
+		{
+			name:          "too many connections",
+			subscribed:    []string{"nokka", "nokka", "sorc"},
+			character:     "necro",
+			expectedError: true,
+		},
+		{
+			name:          "hub closed",
+			character:     "nokka",
+			closed:        true,
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewHub(3, 2)
+
+			for _, name := range tt.subscribed {
+				if _, err := h.Subscribe(name); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+
+			if tt.closed {
+				h.Close()
+			}
+
+			_, err := h.Subscribe(tt.character)
+			if (err != nil) != tt.expectedError {
+				t.Fatalf("got error = %v, expectedError %v", err, tt.expectedError)
+			}
+
+			if err != nil && !errors.Is(err, domain.ErrUnavailable) {
+				t.Errorf("expected ErrUnavailable, got = %v", err)
+			}
+		})
+	}
+}
+
+func TestPublish(t *testing.T) {
+	h := NewHub(10, 10)
+
+	nokka, _ := h.Subscribe("Nokka")
+	sorc, _ := h.Subscribe("sorc")
+
+	err := h.CharacterPersisted(context.Background(), nil, &domain.Character{ID: "nokka"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	err = h.StatisticsSubmitted(context.Background(), nil, domain.StatisticsRequest{Character: "NOKKA", TotalKills: 10})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if u := <-nokka.Updates(); u.Type != domain.LiveCharacter || u.Character.ID != "nokka" {
+		t.Errorf("expected the character update, got = %+v", u)
+	}
+
+	if u := <-nokka.Updates(); u.Type != domain.LiveStatistics || u.Statistics.TotalKills != 10 {
+		t.Errorf("expected the statistics update, got = %+v", u)
+	}
+
+	if len(sorc.Updates()) != 0 {
+		t.Errorf("didn't expect updates of another character")
+	}
+
+	// Connections that haven't caught up miss updates rather than blocking.
+	for i := 0; i < bufferSize+5; i++ {
+		_ = h.CharacterPersisted(context.Background(), nil, &domain.Character{ID: "sorc"})
+	}
+
+	if len(sorc.Updates()) != bufferSize {
+		t.Errorf("expected %d buffered updates, got = %d", bufferSize, len(sorc.Updates()))
+	}
+}
+
+func TestClose(t *testing.T) {
+	h := NewHub(10, 10)
+
+	sub, _ := h.Subscribe("nokka")
+	h.Close()
+
+	if _, ok := <-sub.Updates(); ok {
+		t.Errorf("expected the updates to be closed")
+	}
+
+	// Connections unsubscribe as they end, after the hub has been closed.
+	h.Unsubscribe(sub)
+
+	if h.Connections() != 0 {
+		t.Errorf("expected no connections, got = %d", h.Connections())
+	}
+}
@@ -0,0 +1,93 @@
+package live
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/nokka/d2-armory-api/internal/charsave"
+	"github.com/nokka/d2-armory-api/internal/domain"
+)
+
+//go:generate moq -out ./watcher_mocks.go . subscriptions saves reparser
+
+// subscriptions is the interface representation of the characters with live connections.
+type subscriptions interface {
+	Characters() []string
+}
+
+// saves is the interface representation of the character binaries on disk.
+type saves interface {
+	Stat(name string) (charsave.Entry, bool)
+}
+
+// reparser is the interface representation of the character service, reparsing
+// a character notifies the hub along with every other listener.
+type reparser interface {
+	Reparse(ctx context.Context, name string) (*domain.Character, error)
+}
+
+// Watcher reparses the characters with live connections as soon as they're saved
+// in game, rather than waiting for the cache to expire on the next request.
+type Watcher struct {
+	subscriptions subscriptions
+	saves         saves
+	characters    reparser
+
+	// saved is the last modification time seen of every watched character.
+	saved map[string]time.Time
+}
+
+// Run compares the modification times of the watched binaries every interval
+// until the context is done.
+func (w *Watcher) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.check(ctx)
+		}
+	}
+}
+
+// check reparses every watched character saved since the last check, the first
+// check of a character only records the time, its connections were just given
+// the character as it is.
+func (w *Watcher) check(ctx context.Context) {
+	watched := make(map[string]time.Time)
+
+	for _, name := range w.subscriptions.Characters() {
+		e, ok := w.saves.Stat(name)
+		if !ok {
+			continue
+		}
+
+		watched[name] = e.ModTime
+
+		last, seen := w.saved[name]
+		if !seen || !e.ModTime.After(last) {
+			continue
+		}
+
+		if _, err := w.characters.Reparse(ctx, name); err != nil {
+			log.Printf("failed to reparse %s for live connections: %s", name, err)
+		}
+	}
+
+	// Characters without live connections are forgotten.
+	w.saved = watched
+}
+
+// NewWatcher returns a watcher of the characters with live connections.
+func NewWatcher(subscriptions subscriptions, saves saves, characters reparser) *Watcher {
+	return &Watcher{
+		subscriptions: subscriptions,
+		saves:         saves,
+		characters:    characters,
+		saved:         make(map[string]time.Time),
+	}
+}
@@ -0,0 +1,63 @@
+package live
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nokka/d2-armory-api/internal/charsave"
+	"github.com/nokka/d2-armory-api/internal/domain"
+)
+
+func TestWatcherCheck(t *testing.T) {
+	now := time.Now()
+	saved := map[string]time.Time{"nokka": now, "sorc": now}
+	watched := []string{"nokka", "sorc", "missing"}
+
+	subscriptions := &subscriptionsMock{
+		CharactersFunc: func() []string {
+			return watched
+		},
+	}
+
+	saves := &savesMock{
+		StatFunc: func(name string) (charsave.Entry, bool) {
+			at, ok := saved[name]
+			return charsave.Entry{Name: name, ModTime: at}, ok
+		},
+	}
+
+	characters := &reparserMock{
+		ReparseFunc: func(ctx context.Context, name string) (*domain.Character, error) {
+			return &domain.Character{ID: name}, nil
+		},
+	}
+
+	w := NewWatcher(subscriptions, saves, characters)
+
+	// The first check only records when the characters were saved.
+	w.check(context.TODO())
+	if calls := len(characters.ReparseCalls()); calls != 0 {
+		t.Fatalf("expected nothing to be reparsed on the first check, got = %d", calls)
+	}
+
+	saved["nokka"] = now.Add(time.Second)
+	w.check(context.TODO())
+
+	calls := characters.ReparseCalls()
+	if len(calls) != 1 || calls[0].Name != "nokka" {
+		t.Fatalf("expected only nokka to be reparsed, got = %+v", calls)
+	}
+
+	// A character that's subscribed to again starts over.
+	watched = []string{"sorc"}
+	w.check(context.TODO())
+
+	watched = []string{"nokka", "sorc"}
+	saved["nokka"] = now.Add(2 * time.Second)
+	w.check(context.TODO())
+
+	if calls := len(characters.ReparseCalls()); calls != 1 {
+		t.Errorf("expected nokka to be watched from scratch, got = %d reparses", calls)
+	}
+}
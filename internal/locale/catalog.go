@@ -0,0 +1,207 @@
+package locale
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Default is the language of the names in the d2s payload, it's always
+// available and needs no string table.
+const Default = "en"
+
+// Table is the string table of a language, strings missing from it are
+// left in English.
+type Table struct {
+	// Items are the names of the base items by item code, e.g. "uap".
+	Items map[string]string `json:"items"`
+
+	// Names are the names of uniques, sets, runewords and magic and rare
+	// affixes by their English name, e.g. "Harlequin Crest" or "Burning".
+	Names map[string]string `json:"names"`
+
+	// Stats are the descriptions of item properties by stat ID, with the
+	// same {0}, {1} placeholders for the values as the English ones.
+	Stats map[string]string `json:"stats"`
+
+	// Skills are the names of skills by skill ID.
+	Skills map[string]string `json:"skills"`
+
+	// strings are the string tables of the game for the language, used for
+	// anything missing from the maps above.
+	strings *tblStrings
+}
+
+// Catalog holds the string tables of every language, read from a directory
+// of JSON files named by language, e.g. de.json or pt-br.json, and of
+// directories with the string tables of the game, e.g. deu/string.tbl.
+type Catalog struct {
+	path string
+
+	mu     sync.RWMutex
+	tables map[string]*Table
+}
+
+// Load reads every string table in the directory, replacing the ones read before.
+func (c *Catalog) Load() error {
+	files, err := filepath.Glob(filepath.Join(c.path, "*.json"))
+	if err != nil {
+		return err
+	}
+
+	tables := make(map[string]*Table, len(files))
+	if err := c.loadTbl(tables); err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return err
+		}
+
+		var t Table
+		if err := json.Unmarshal(data, &t); err != nil {
+			return fmt.Errorf("invalid string table %s: %w", file, err)
+		}
+
+		// The JSON table takes precedence over the game's tables of the language.
+		lang := normalize(strings.TrimSuffix(filepath.Base(file), ".json"))
+		if existing, ok := tables[lang]; ok {
+			t.strings = existing.strings
+		}
+
+		tables[lang] = &t
+	}
+
+	c.mu.Lock()
+	c.tables = tables
+	c.mu.Unlock()
+
+	return nil
+}
+
+// loadTbl reads the string tables of the game in the language directories, named
+// by the language codes of the game, e.g. deu, or by language, e.g. de. Names are
+// looked up by the key of the English name, so the English tables are needed to
+// translate the names that aren't keyed by themselves, such as runewords and skills.
+func (c *Catalog) loadTbl(tables map[string]*Table) error {
+	entries, err := ioutil.ReadDir(c.path)
+	if err != nil {
+		return err
+	}
+
+	languages := make(map[string]map[string]string)
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+
+		strs, ok, err := readTblDir(filepath.Join(c.path, e.Name()))
+		if err != nil {
+			return err
+		}
+
+		if !ok {
+			continue
+		}
+
+		lang := normalize(e.Name())
+		if tag, ok := tblLanguages[lang]; ok {
+			lang = tag
+		}
+
+		languages[lang] = strs
+	}
+
+	// Keys of the English strings, the first key of a string wins to be deterministic.
+	keys := make(map[string]string)
+	for key, str := range languages[Default] {
+		if existing, ok := keys[str]; !ok || key < existing {
+			keys[str] = key
+		}
+	}
+
+	for lang, strs := range languages {
+		if lang == Default {
+			continue
+		}
+
+		tables[lang] = &Table{strings: &tblStrings{localized: strs, keys: keys}}
+	}
+
+	return nil
+}
+
+// Languages returns the available languages, including the default.
+func (c *Catalog) Languages() []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	languages := []string{Default}
+	for lang := range c.tables {
+		if lang != Default {
+			languages = append(languages, lang)
+		}
+	}
+
+	sort.Strings(languages[1:])
+
+	return languages
+}
+
+// Match returns the first available language of the preferences, in order of
+// preference. A region falls back to the language without it, e.g. de-AT to
+// de, and the default language is returned when none of them are available.
+func (c *Catalog) Match(preferences ...string) string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for _, p := range preferences {
+		lang := normalize(p)
+		if lang == Default {
+			return Default
+		}
+
+		if _, ok := c.tables[lang]; ok {
+			return lang
+		}
+
+		if i := strings.Index(lang, "-"); i > 0 {
+			if lang[:i] == Default {
+				return Default
+			}
+
+			if _, ok := c.tables[lang[:i]]; ok {
+				return lang[:i]
+			}
+		}
+	}
+
+	return Default
+}
+
+// table returns the string table of the language, nil for the default language.
+func (c *Catalog) table(lang string) *Table {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.tables[lang]
+}
+
+// normalize returns the canonical form of a language tag, e.g. pt_BR to pt-br.
+func normalize(lang string) string {
+	return strings.ToLower(strings.Replace(strings.TrimSpace(lang), "_", "-", -1))
+}
+
+// NewCatalog constructs a new catalog of the string tables in the directory.
+func NewCatalog(path string) *Catalog {
+	return &Catalog{
+		path:   path,
+		tables: make(map[string]*Table),
+	}
+}
@@ -0,0 +1,150 @@
+package locale
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+	"github.com/nokka/d2s"
+)
+
+const germanTable = `{
+	"items": {"uap": "Tschako"},
+	"names": {"Harlequin Crest": "Harlekinskrone"},
+	"stats": {"127": "+{0} auf alle Fertigkeiten"},
+	"skills": {"54": "Teleportieren"}
+}`
+
+func newTestCatalog(t *testing.T) *Catalog {
+	t.Helper()
+
+	dir, err := ioutil.TempDir("", "locale")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	files := map[string]string{
+		"de.json":    germanTable,
+		"pt_BR.json": `{}`,
+		"es.json":    `{}`,
+	}
+
+	for name, content := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0600); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	catalog := NewCatalog(dir)
+	if err := catalog.Load(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	return catalog
+}
+
+func TestMatch(t *testing.T) {
+	catalog := newTestCatalog(t)
+
+	if languages := catalog.Languages(); !reflect.DeepEqual(languages, []string{"en", "de", "es", "pt-br"}) {
+		t.Errorf("unexpected languages %v", languages)
+	}
+
+	tests := []struct {
+		name        string
+		preferences []string
+		expected    string
+	}{
+		{name: "no preferences", expected: "en"},
+		{name: "exact", preferences: []string{"de"}, expected: "de"},
+		{name: "region falls back", preferences: []string{"de-AT"}, expected: "de"},
+		{name: "region", preferences: []string{"pt-BR"}, expected: "pt-br"},
+		{name: "first available", preferences: []string{"pl", "es", "de"}, expected: "es"},
+		{name: "english preferred", preferences: []string{"en-US", "de"}, expected: "en"},
+		{name: "unavailable", preferences: []string{"fr"}, expected: "en"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if lang := catalog.Match(tt.preferences...); lang != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, lang)
+			}
+		})
+	}
+}
+
+func TestParseAcceptLanguage(t *testing.T) {
+	tests := []struct {
+		header   string
+		expected []string
+	}{
+		{header: "", expected: []string{}},
+		{header: "de", expected: []string{"de"}},
+		{header: "en;q=0.5, pl, es;q=0.8", expected: []string{"pl", "es", "en"}},
+		{header: "de-DE,de;q=0.9,*;q=0.1", expected: []string{"de-DE", "de"}},
+		{header: "fr;q=0, es", expected: []string{"es"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.header, func(t *testing.T) {
+			if languages := ParseAcceptLanguage(tt.header); !reflect.DeepEqual(languages, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, languages)
+			}
+		})
+	}
+}
+
+func TestTranslateCharacter(t *testing.T) {
+	catalog := newTestCatalog(t)
+
+	var helm d2s.Item
+	doc := `{"type": "uap", "type_name": "Shako", "unique_name": "Harlequin Crest",
+		"magic_attributes": [{"id": 127, "name": "+{0} to All Skills", "values": [2]}, {"id": 80, "name": "{0}% Better Chance of Getting Magic Items", "values": [50]}]}`
+	if err := json.Unmarshal([]byte(doc), &helm); err != nil {
+		t.Fatal(err)
+	}
+
+	character := &domain.Character{
+		ID: "nokka",
+		D2s: &d2s.Character{
+			Items:  []d2s.Item{helm},
+			Skills: []d2s.Skill{{ID: 54, Name: "Teleport", Points: 1}, {ID: 59, Name: "Blizzard", Points: 20}},
+		},
+	}
+
+	translated := catalog.Character("de", character)
+
+	item := translated.D2s.Items[0]
+	if item.TypeName != "Tschako" || item.UniqueName != "Harlekinskrone" {
+		t.Errorf("unexpected item names %s, %s", item.TypeName, item.UniqueName)
+	}
+
+	if item.MagicAttributes[0].Name != "+{0} auf alle Fertigkeiten" {
+		t.Errorf("unexpected stat description %s", item.MagicAttributes[0].Name)
+	}
+
+	// Strings missing from the table are left in English.
+	if item.MagicAttributes[1].Name != "{0}% Better Chance of Getting Magic Items" {
+		t.Errorf("unexpected stat description %s", item.MagicAttributes[1].Name)
+	}
+
+	if translated.D2s.Skills[0].Name != "Teleportieren" || translated.D2s.Skills[1].Name != "Blizzard" {
+		t.Errorf("unexpected skills %v", translated.D2s.Skills)
+	}
+
+	// The original character must be left untouched.
+	original := character.D2s.Items[0]
+	if original.UniqueName != "Harlequin Crest" || original.MagicAttributes[0].Name != "+{0} to All Skills" || character.D2s.Skills[0].Name != "Teleport" {
+		t.Errorf("original character was changed")
+	}
+
+	// The default language has no string table.
+	if catalog.Character(Default, character) != character {
+		t.Errorf("expected the character as is for the default language")
+	}
+}
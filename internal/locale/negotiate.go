@@ -0,0 +1,53 @@
+package locale
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ParseAcceptLanguage returns the languages of an Accept-Language header in
+// order of preference, languages with a quality of zero are left out.
+func ParseAcceptLanguage(header string) []string {
+	type preference struct {
+		lang    string
+		quality float64
+	}
+
+	var prefs []preference
+	for _, part := range strings.Split(header, ",") {
+		fields := strings.Split(part, ";")
+		lang := strings.TrimSpace(fields[0])
+		if lang == "" || lang == "*" {
+			continue
+		}
+
+		quality := 1.0
+		for _, f := range fields[1:] {
+			f = strings.TrimSpace(f)
+			if strings.HasPrefix(f, "q=") {
+				q, err := strconv.ParseFloat(f[2:], 64)
+				if err != nil {
+					q = 0
+				}
+				quality = q
+			}
+		}
+
+		if quality > 0 {
+			prefs = append(prefs, preference{lang: lang, quality: quality})
+		}
+	}
+
+	// Keep the order of the header between languages of the same quality.
+	sort.SliceStable(prefs, func(i, j int) bool {
+		return prefs[i].quality > prefs[j].quality
+	})
+
+	languages := make([]string, len(prefs))
+	for i, p := range prefs {
+		languages[i] = p.lang
+	}
+
+	return languages
+}
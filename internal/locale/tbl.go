@@ -0,0 +1,185 @@
+package locale
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// tblFiles are the string tables of the game, in the order the game reads them,
+// the strings of later tables replace the ones before them.
+var tblFiles = []string{"string.tbl", "expansionstring.tbl", "patchstring.tbl"}
+
+// tblLanguages are the language directories of the game, by language tag.
+var tblLanguages = map[string]string{
+	"eng": "en",
+	"deu": "de",
+	"fra": "fr",
+	"esp": "es",
+	"ita": "it",
+	"pol": "pl",
+}
+
+// Sizes of the parts of a tbl file.
+const (
+	tblHeaderSize = 21
+	tblNodeSize   = 17
+)
+
+// tblStrings are the strings of a language read from the string tables of the game,
+// the tables are keyed by the string keys of the game, so names are looked up by
+// the key the English name has in the English tables.
+type tblStrings struct {
+	localized map[string]string
+	keys      map[string]string
+}
+
+// translate returns the localized string of the English one, or false if it's missing.
+func (s *tblStrings) translate(english string) (string, bool) {
+	if key, ok := s.keys[english]; ok {
+		if str, ok := s.localized[key]; ok {
+			return str, true
+		}
+	}
+
+	// Uniques and sets are keyed by their English name.
+	str, ok := s.localized[english]
+
+	return str, ok
+}
+
+// readTblDir reads the string tables in the directory, it returns false if
+// the directory has none of them.
+func readTblDir(dir string) (map[string]string, bool, error) {
+	strs := make(map[string]string)
+	found := false
+
+	for _, name := range tblFiles {
+		data, err := ioutil.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return nil, false, err
+		}
+
+		table, err := readTbl(data)
+		if err != nil {
+			return nil, false, fmt.Errorf("invalid string table %s: %w", filepath.Join(dir, name), err)
+		}
+
+		for key, str := range table {
+			strs[key] = str
+		}
+
+		found = true
+	}
+
+	return strs, found, nil
+}
+
+// readTbl reads the strings of a tbl file by key. The strings are decoded as
+// Windows-1252, the code page of the western releases of the game.
+func readTbl(data []byte) (map[string]string, error) {
+	if len(data) < tblHeaderSize {
+		return nil, errors.New("file is too short")
+	}
+
+	elements := int(binary.LittleEndian.Uint16(data[2:]))
+	nodes := uint64(binary.LittleEndian.Uint32(data[4:]))
+
+	// Bound the number of nodes by the size of the file before reading them.
+	start := uint64(tblHeaderSize + 2*elements)
+	if start+nodes*tblNodeSize > uint64(len(data)) {
+		return nil, fmt.Errorf("%d hash nodes don't fit in %d bytes", nodes, len(data))
+	}
+
+	strs := make(map[string]string, elements)
+	for i := uint64(0); i < nodes; i++ {
+		node := data[start+i*tblNodeSize:]
+		if node[0] == 0 {
+			continue
+		}
+
+		key, err := cString(data, binary.LittleEndian.Uint32(node[7:]))
+		if err != nil {
+			return nil, err
+		}
+
+		str, err := cString(data, binary.LittleEndian.Uint32(node[11:]))
+		if err != nil {
+			return nil, err
+		}
+
+		strs[key] = clean(str)
+	}
+
+	return strs, nil
+}
+
+// cString returns the null terminated string at the offset.
+func cString(data []byte, offset uint32) (string, error) {
+	if uint64(offset) >= uint64(len(data)) {
+		return "", fmt.Errorf("string offset %d is out of bounds", offset)
+	}
+
+	b := data[offset:]
+	if end := bytes.IndexByte(b, 0); end >= 0 {
+		b = b[:end]
+	}
+
+	return decodeWindows1252(b), nil
+}
+
+// clean removes the color codes and grammatical gender markers of the string,
+// strings with a form per gender, e.g. [ms]Brennender[fs]Brennende, keep the first.
+func clean(s string) string {
+	for {
+		i := strings.Index(s, "ÿc")
+		if i < 0 || i+len("ÿc") >= len(s) {
+			break
+		}
+		s = s[:i] + s[i+len("ÿc")+1:]
+	}
+
+	if strings.HasPrefix(s, "[") {
+		if end := strings.Index(s, "]"); end > 0 {
+			s = s[end+1:]
+		}
+
+		if next := strings.Index(s, "["); next >= 0 {
+			s = s[:next]
+		}
+	}
+
+	return s
+}
+
+// windows1252 are the characters of Windows-1252 that differ from Latin-1.
+var windows1252 = map[byte]rune{
+	0x80: '€', 0x82: '‚', 0x83: 'ƒ', 0x84: '„', 0x85: '…', 0x86: '†', 0x87: '‡',
+	0x88: 'ˆ', 0x89: '‰', 0x8a: 'Š', 0x8b: '‹', 0x8c: 'Œ', 0x8e: 'Ž',
+	0x91: '‘', 0x92: '’', 0x93: '“', 0x94: '”', 0x95: '•', 0x96: '–', 0x97: '—',
+	0x98: '˜', 0x99: '™', 0x9a: 'š', 0x9b: '›', 0x9c: 'œ', 0x9e: 'ž', 0x9f: 'Ÿ',
+}
+
+func decodeWindows1252(b []byte) string {
+	var sb strings.Builder
+	sb.Grow(len(b))
+
+	for _, c := range b {
+		if r, ok := windows1252[c]; ok {
+			sb.WriteRune(r)
+			continue
+		}
+
+		sb.WriteRune(rune(c))
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,200 @@
+package locale
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/nokka/d2s"
+)
+
+// tbl returns a tbl file of the strings, as pairs of key and string encoded as Windows-1252.
+func tbl(pairs ...string) []byte {
+	n := len(pairs) / 2
+	start := tblHeaderSize + 2*n
+	data := make([]byte, start+n*tblNodeSize)
+
+	binary.LittleEndian.PutUint16(data[2:], uint16(n))
+	binary.LittleEndian.PutUint32(data[4:], uint32(n))
+
+	for i := 0; i < n; i++ {
+		binary.LittleEndian.PutUint16(data[tblHeaderSize+2*i:], uint16(i))
+
+		node := data[start+i*tblNodeSize:]
+		node[0] = 1
+		binary.LittleEndian.PutUint16(node[1:], uint16(i))
+
+		binary.LittleEndian.PutUint32(node[7:], uint32(len(data)))
+		data = append(data, pairs[2*i]...)
+		data = append(data, 0)
+
+		node = data[start+i*tblNodeSize:]
+		binary.LittleEndian.PutUint32(node[11:], uint32(len(data)))
+		binary.LittleEndian.PutUint16(node[15:], uint16(len(pairs[2*i+1])+1))
+		data = append(data, pairs[2*i+1]...)
+		data = append(data, 0)
+	}
+
+	return data
+}
+
+func writeTbl(t *testing.T, dir string, files map[string][]byte) {
+	t.Helper()
+
+	for name, data := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if err := ioutil.WriteFile(path, data, 0600); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+}
+
+func TestReadTbl(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     []byte
+		expected map[string]string
+		err      bool
+	}{
+		{
+			name:     "strings",
+			data:     tbl("uap", "Tschako", "Runeword58", "R\xe4tsel"),
+			expected: map[string]string{"uap": "Tschako", "Runeword58": "Rätsel"},
+		},
+		{
+			name:     "color codes",
+			data:     tbl("Cube", "\xffc4W\xfcrfel\xffc0"),
+			expected: map[string]string{"Cube": "Würfel"},
+		},
+		{
+			name:     "gender forms",
+			data:     tbl("Burning", "[ms]Brennender[fs]Brennende"),
+			expected: map[string]string{"Burning": "Brennender"},
+		},
+		{
+			name:     "windows-1252",
+			data:     tbl("Coin", "\x80 \x9cuvre"),
+			expected: map[string]string{"Coin": "€ œuvre"},
+		},
+		{
+			name: "too short",
+			data: []byte{0, 0, 1},
+			err:  true,
+		},
+		{
+			name: "nodes out of bounds",
+			data: func() []byte {
+				data := tbl("uap", "Tschako")
+				binary.LittleEndian.PutUint32(data[4:], 1<<31)
+				return data
+			}(),
+			err: true,
+		},
+		{
+			name: "string out of bounds",
+			data: func() []byte {
+				data := tbl("uap", "Tschako")
+				binary.LittleEndian.PutUint32(data[tblHeaderSize+2+11:], uint32(len(data)))
+				return data
+			}(),
+			err: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			strs, err := readTbl(tt.data)
+			if (err != nil) != tt.err {
+				t.Fatalf("expected error %t, got %v", tt.err, err)
+			}
+
+			if tt.err {
+				return
+			}
+
+			if len(strs) != len(tt.expected) {
+				t.Fatalf("expected %v, got %v", tt.expected, strs)
+			}
+
+			for key, str := range tt.expected {
+				if strs[key] != str {
+					t.Errorf("expected %s for %s, got %s", str, key, strs[key])
+				}
+			}
+		})
+	}
+}
+
+func TestLoadTbl(t *testing.T) {
+	dir, err := ioutil.TempDir("", "locale")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	writeTbl(t, dir, map[string][]byte{
+		"eng/string.tbl":          tbl("uap", "Shako", "skillname54", "Teleport", "Burning", "Burning"),
+		"eng/expansionstring.tbl": tbl("Runeword58", "Enigma"),
+		"deu/string.tbl":          tbl("uap", "Tschako", "skillname54", "Teleportieren", "Burning", "[ms]Brennender[fs]Brennende"),
+		"deu/expansionstring.tbl": tbl("Runeword58", "Raetsel", "Harlequin Crest", "Harlekinskrone"),
+		"deu/patchstring.tbl":     tbl("Runeword58", "R\xe4tsel"),
+		"de.json":                 []byte(`{"names": {"Harlequin Crest": "Harlekinhaube"}}`),
+		"pol/string.tbl":          tbl("uap", "Czako"),
+	})
+
+	catalog := NewCatalog(dir)
+	if err := catalog.Load(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if lang := catalog.Match("pl"); lang != "pl" {
+		t.Errorf("expected pl to be available, got %s", lang)
+	}
+
+	var item d2s.Item
+	doc := `{"type": "uap", "type_name": "Shako", "unique_name": "Harlequin Crest", "runeword_name": "Enigma", "magic_prefix_name": "Burning"}`
+	if err := json.Unmarshal([]byte(doc), &item); err != nil {
+		t.Fatal(err)
+	}
+
+	table := catalog.table("de")
+	if table == nil {
+		t.Fatalf("expected a table for de")
+	}
+
+	translated := table.item(item)
+
+	if translated.TypeName != "Tschako" {
+		t.Errorf("unexpected type name %s", translated.TypeName)
+	}
+
+	// Runewords are keyed by their English key, and patches replace the strings before them.
+	if translated.RunewordName != "Rätsel" {
+		t.Errorf("unexpected runeword name %s", translated.RunewordName)
+	}
+
+	// JSON tables take precedence over the tables of the game.
+	if translated.UniqueName != "Harlekinhaube" {
+		t.Errorf("unexpected unique name %s", translated.UniqueName)
+	}
+
+	if translated.MagicPrefixName != "Brennender" {
+		t.Errorf("unexpected prefix name %s", translated.MagicPrefixName)
+	}
+
+	if name := table.skill(54, "Teleport"); name != "Teleportieren" {
+		t.Errorf("unexpected skill name %s", name)
+	}
+
+	writeTbl(t, dir, map[string][]byte{"fra/string.tbl": []byte("corrupt")})
+	if err := catalog.Load(); err == nil {
+		t.Errorf("expected an error for a corrupt string table")
+	}
+}
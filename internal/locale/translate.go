@@ -0,0 +1,213 @@
+package locale
+
+import (
+	"strconv"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+	"github.com/nokka/d2s"
+)
+
+// Character returns a copy of the character with the names of its items and
+// skills in the language, the character itself is left untouched.
+func (c *Catalog) Character(lang string, character *domain.Character) *domain.Character {
+	t := c.table(lang)
+	if t == nil || character == nil || character.D2s == nil {
+		return character
+	}
+
+	d := *character.D2s
+	d.Items = t.items(d.Items)
+	d.CorpseItems = t.items(d.CorpseItems)
+	d.MercItems = t.items(d.MercItems)
+	if d.GolemItem != nil {
+		golem := t.item(*d.GolemItem)
+		d.GolemItem = &golem
+	}
+
+	if d.Skills != nil {
+		skills := make([]d2s.Skill, len(d.Skills))
+		for i, s := range d.Skills {
+			s.Name = t.skill(s.ID, s.Name)
+			skills[i] = s
+		}
+		d.Skills = skills
+	}
+
+	translated := *character
+	translated.D2s = &d
+
+	return &translated
+}
+
+// Mercenary returns a copy of the mercenary with the names of its items and
+// auras in the language.
+func (c *Catalog) Mercenary(lang string, merc *domain.Mercenary) *domain.Mercenary {
+	t := c.table(lang)
+	if t == nil || merc == nil {
+		return merc
+	}
+
+	translated := *merc
+	translated.Items = t.items(merc.Items)
+
+	if merc.Auras != nil {
+		translated.Auras = make([]domain.Aura, len(merc.Auras))
+		for i, a := range merc.Auras {
+			a.Name = t.skill(a.SkillID, a.Name)
+			translated.Auras[i] = a
+		}
+	}
+
+	return &translated
+}
+
+// SkillTree returns a copy of the skill tree with the names of the skills in the language.
+func (c *Catalog) SkillTree(lang string, tree *domain.SkillTree) *domain.SkillTree {
+	t := c.table(lang)
+	if t == nil || tree == nil {
+		return tree
+	}
+
+	translated := *tree
+	translated.OSkills = t.skillLevels(tree.OSkills)
+
+	if tree.Tabs != nil {
+		translated.Tabs = make([]domain.SkillTab, len(tree.Tabs))
+		for i, tab := range tree.Tabs {
+			tab.Skills = t.skillLevels(tab.Skills)
+			translated.Tabs[i] = tab
+		}
+	}
+
+	return &translated
+}
+
+func (t *Table) items(items []d2s.Item) []d2s.Item {
+	if items == nil {
+		return nil
+	}
+
+	translated := make([]d2s.Item, len(items))
+	for i, item := range items {
+		translated[i] = t.item(item)
+	}
+
+	return translated
+}
+
+// item translates the names and properties of the item, the attributes are
+// copied before they're changed since the slices are shared with the original.
+func (t *Table) item(item d2s.Item) d2s.Item {
+	item.TypeName = t.itemName(item.Type, item.TypeName)
+
+	item.UniqueName = t.name(item.UniqueName)
+	item.SetName = t.name(item.SetName)
+	item.RunewordName = t.name(item.RunewordName)
+	item.RareName = t.name(item.RareName)
+	item.RareName2 = t.name(item.RareName2)
+	item.MagicPrefixName = t.name(item.MagicPrefixName)
+	item.MagicSuffixName = t.name(item.MagicSuffixName)
+
+	if item.MagicAttributes != nil {
+		item.MagicAttributes = append(item.MagicAttributes[:0:0], item.MagicAttributes...)
+		for i, a := range item.MagicAttributes {
+			item.MagicAttributes[i].Name = t.stat(a.ID, a.Name)
+		}
+	}
+
+	if item.RunewordAttributes != nil {
+		item.RunewordAttributes = append(item.RunewordAttributes[:0:0], item.RunewordAttributes...)
+		for i, a := range item.RunewordAttributes {
+			item.RunewordAttributes[i].Name = t.stat(a.ID, a.Name)
+		}
+	}
+
+	if item.SetAttributes != nil {
+		item.SetAttributes = append(item.SetAttributes[:0:0], item.SetAttributes...)
+		for i, list := range item.SetAttributes {
+			item.SetAttributes[i] = append(list[:0:0], list...)
+			for j, a := range list {
+				item.SetAttributes[i][j].Name = t.stat(a.ID, a.Name)
+			}
+		}
+	}
+
+	item.SocketedItems = t.items(item.SocketedItems)
+
+	return item
+}
+
+func (t *Table) skillLevels(levels []domain.SkillLevel) []domain.SkillLevel {
+	if levels == nil {
+		return nil
+	}
+
+	translated := make([]domain.SkillLevel, len(levels))
+	for i, l := range levels {
+		l.Name = t.skill(l.ID, l.Name)
+
+		if l.Synergies != nil {
+			synergies := make([]domain.SynergyBonus, len(l.Synergies))
+			for j, s := range l.Synergies {
+				s.Name = t.skill(s.SkillID, s.Name)
+				synergies[j] = s
+			}
+			l.Synergies = synergies
+		}
+
+		translated[i] = l
+	}
+
+	return translated
+}
+
+// itemName returns the translation of the base item, base items are keyed by
+// their code in the string tables of the game as well.
+func (t *Table) itemName(code string, english string) string {
+	if name, ok := t.Items[code]; ok {
+		return name
+	}
+
+	if t.strings != nil {
+		if name, ok := t.strings.localized[code]; ok && code != "" {
+			return name
+		}
+	}
+
+	return t.name(english)
+}
+
+// name returns the translation of the English name, or the name itself if it's missing.
+func (t *Table) name(english string) string {
+	if english == "" {
+		return english
+	}
+
+	if name, ok := t.Names[english]; ok {
+		return name
+	}
+
+	if t.strings != nil {
+		if name, ok := t.strings.translate(english); ok {
+			return name
+		}
+	}
+
+	return english
+}
+
+func (t *Table) stat(id uint64, english string) string {
+	if desc, ok := t.Stats[strconv.FormatUint(id, 10)]; ok {
+		return desc
+	}
+
+	return english
+}
+
+func (t *Table) skill(id int, english string) string {
+	if name, ok := t.Skills[strconv.Itoa(id)]; ok {
+		return name
+	}
+
+	return t.name(english)
+}
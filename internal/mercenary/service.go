@@ -0,0 +1,145 @@
+package mercenary
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+	"github.com/nokka/d2-armory-api/internal/gear"
+	"github.com/nokka/d2s"
+)
+
+//go:generate moq -out ./service_mocks.go . characterService
+
+// characterService is the interface representation of the characters
+// the service derive mercenaries from.
+type characterService interface {
+	Parse(ctx context.Context, name string) (*domain.Character, error)
+}
+
+// maxLevel is the highest level a mercenary can reach.
+const maxLevel = 98
+
+// hireling describes a mercenary type, the rows of hireling.txt.
+type hireling struct {
+	act        int
+	class      string
+	variant    string
+	difficulty string
+	expPerLvl  uint64
+}
+
+// Mercenary classes for each act.
+const (
+	rogue     = "Rogue Scout"
+	desert    = "Desert Mercenary"
+	sorceror  = "Eastern Sorceror"
+	barbarian = "Barbarian"
+)
+
+// hirelings maps the mercenary type of the save file to the hireling.
+var hirelings = map[uint16]hireling{
+	0:  {act: 1, class: rogue, variant: "Fire Arrow", difficulty: "normal", expPerLvl: 100},
+	1:  {act: 1, class: rogue, variant: "Cold Arrow", difficulty: "normal", expPerLvl: 100},
+	2:  {act: 1, class: rogue, variant: "Fire Arrow", difficulty: "nightmare", expPerLvl: 100},
+	3:  {act: 1, class: rogue, variant: "Cold Arrow", difficulty: "nightmare", expPerLvl: 100},
+	4:  {act: 1, class: rogue, variant: "Fire Arrow", difficulty: "hell", expPerLvl: 100},
+	5:  {act: 1, class: rogue, variant: "Cold Arrow", difficulty: "hell", expPerLvl: 100},
+	6:  {act: 2, class: desert, variant: "Combat (Prayer)", difficulty: "normal", expPerLvl: 110},
+	7:  {act: 2, class: desert, variant: "Defensive (Defiance)", difficulty: "normal", expPerLvl: 110},
+	8:  {act: 2, class: desert, variant: "Offensive (Blessed Aim)", difficulty: "normal", expPerLvl: 110},
+	9:  {act: 2, class: desert, variant: "Combat (Thorns)", difficulty: "nightmare", expPerLvl: 110},
+	10: {act: 2, class: desert, variant: "Defensive (Holy Freeze)", difficulty: "nightmare", expPerLvl: 110},
+	11: {act: 2, class: desert, variant: "Offensive (Might)", difficulty: "nightmare", expPerLvl: 110},
+	12: {act: 2, class: desert, variant: "Combat (Prayer)", difficulty: "hell", expPerLvl: 110},
+	13: {act: 2, class: desert, variant: "Defensive (Defiance)", difficulty: "hell", expPerLvl: 110},
+	14: {act: 2, class: desert, variant: "Offensive (Blessed Aim)", difficulty: "hell", expPerLvl: 110},
+	15: {act: 3, class: sorceror, variant: "Fire", difficulty: "normal", expPerLvl: 110},
+	16: {act: 3, class: sorceror, variant: "Cold", difficulty: "normal", expPerLvl: 110},
+	17: {act: 3, class: sorceror, variant: "Lightning", difficulty: "normal", expPerLvl: 110},
+	18: {act: 3, class: sorceror, variant: "Fire", difficulty: "nightmare", expPerLvl: 110},
+	19: {act: 3, class: sorceror, variant: "Cold", difficulty: "nightmare", expPerLvl: 110},
+	20: {act: 3, class: sorceror, variant: "Lightning", difficulty: "nightmare", expPerLvl: 110},
+	21: {act: 3, class: sorceror, variant: "Fire", difficulty: "hell", expPerLvl: 110},
+	22: {act: 3, class: sorceror, variant: "Cold", difficulty: "hell", expPerLvl: 110},
+	23: {act: 3, class: sorceror, variant: "Lightning", difficulty: "hell", expPerLvl: 110},
+	24: {act: 5, class: barbarian, variant: "Bash", difficulty: "normal", expPerLvl: 120},
+	25: {act: 5, class: barbarian, variant: "Bash", difficulty: "normal", expPerLvl: 120},
+	26: {act: 5, class: barbarian, variant: "Bash", difficulty: "nightmare", expPerLvl: 120},
+	27: {act: 5, class: barbarian, variant: "Bash", difficulty: "nightmare", expPerLvl: 120},
+	28: {act: 5, class: barbarian, variant: "Bash", difficulty: "hell", expPerLvl: 120},
+	29: {act: 5, class: barbarian, variant: "Bash", difficulty: "hell", expPerLvl: 120},
+}
+
+// Service derives mercenaries from characters.
+type Service struct {
+	characterService characterService
+}
+
+// Get will get the mercenary of the character.
+func (s Service) Get(ctx context.Context, name string) (*domain.Mercenary, error) {
+	character, err := s.characterService.Parse(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	merc := Of(character.D2s)
+	if merc == nil {
+		return nil, fmt.Errorf("character %s has no mercenary: %w", name, domain.ErrNotFound)
+	}
+
+	return merc, nil
+}
+
+// Of derives the mercenary of the character, nil if the character has none.
+func Of(c *d2s.Character) *domain.Mercenary {
+	header := c.Header
+	if header.MercID == 0 {
+		return nil
+	}
+
+	merc := &domain.Mercenary{
+		ID:         fmt.Sprintf("%x", header.MercID),
+		NameID:     int(header.MercNameID),
+		Type:       int(header.MercType),
+		Experience: header.MercExp,
+		Dead:       header.DeadMerc != 0,
+		Items:      gear.Equipped(c.MercItems, gear.WeaponSetPrimary),
+	}
+
+	// Unknown types are still served, without what's derived from the type.
+	if h, ok := hirelings[header.MercType]; ok {
+		merc.Act = h.act
+		merc.Class = h.class
+		merc.Variant = h.variant
+		merc.Difficulty = h.difficulty
+		merc.Level = Level(header.MercExp, h.expPerLvl)
+	}
+
+	attrs := gear.AllAttributes(merc.Items)
+	merc.Resistances = gear.ResistancesOf(attrs)
+	merc.Auras = gear.AurasOf(attrs)
+
+	return merc
+}
+
+// Level returns the level of a mercenary with the experience, the experience
+// required for a level is expPerLvl * level^2 * (level + 1).
+func Level(exp uint32, expPerLvl uint64) int {
+	level := 1
+	for l := uint64(2); l <= maxLevel; l++ {
+		if expPerLvl*l*l*(l+1) > uint64(exp) {
+			break
+		}
+		level = int(l)
+	}
+
+	return level
+}
+
+// NewService constructs a new mercenary service with all the dependencies.
+func NewService(characterService characterService) *Service {
+	return &Service{
+		characterService: characterService,
+	}
+}
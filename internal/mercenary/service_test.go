@@ -0,0 +1,116 @@
+package mercenary
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+	"github.com/nokka/d2s"
+)
+
+func TestGetMercenary(t *testing.T) {
+	insight := d2s.Item{
+		LocationID:   1,
+		EquippedID:   4,
+		Type:         "7o7",
+		RunewordName: "Insight",
+	}
+
+	tests := []struct {
+		name          string
+		header        d2s.Header
+		items         []d2s.Item
+		expected      domain.Mercenary
+		expectedError error
+	}{
+		{
+			name: "act 2 mercenary",
+			header: d2s.Header{
+				MercID:   0xcafe,
+				MercType: 10,
+				MercExp:  100 * 110 * 100 * 101,
+			},
+			items: []d2s.Item{insight, {LocationID: 0, Type: "hp1"}},
+			expected: domain.Mercenary{
+				ID:         "cafe",
+				Type:       10,
+				Act:        2,
+				Class:      "Desert Mercenary",
+				Variant:    "Defensive (Holy Freeze)",
+				Difficulty: "nightmare",
+				Level:      98,
+			},
+		},
+		{
+			name: "unknown type",
+			header: d2s.Header{
+				MercID:   1,
+				MercType: 99,
+				DeadMerc: 1,
+			},
+			expected: domain.Mercenary{
+				ID:   "1",
+				Type: 99,
+				Dead: true,
+			},
+		},
+		{
+			name:          "no mercenary",
+			header:        d2s.Header{},
+			expectedError: domain.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			characterService := &characterServiceMock{
+				ParseFunc: func(ctx context.Context, name string) (*domain.Character, error) {
+					return &domain.Character{
+						ID:  name,
+						D2s: &d2s.Character{Header: tt.header, MercItems: tt.items},
+					}, nil
+				},
+			}
+
+			merc, err := NewService(characterService).Get(context.Background(), "nokka")
+			if !errors.Is(err, tt.expectedError) {
+				t.Fatalf("expected error %v, got %v", tt.expectedError, err)
+			}
+
+			if tt.expectedError != nil {
+				return
+			}
+
+			if merc.ID != tt.expected.ID || merc.Type != tt.expected.Type || merc.Act != tt.expected.Act ||
+				merc.Class != tt.expected.Class || merc.Variant != tt.expected.Variant ||
+				merc.Difficulty != tt.expected.Difficulty || merc.Level != tt.expected.Level || merc.Dead != tt.expected.Dead {
+				t.Errorf("expected %+v, got %+v", tt.expected, merc)
+			}
+
+			if len(merc.Items) != len(tt.items)/2 {
+				t.Errorf("expected only equipped items, got %d items", len(merc.Items))
+			}
+		})
+	}
+}
+
+func TestLevel(t *testing.T) {
+	tests := []struct {
+		exp      uint32
+		expected int
+	}{
+		{exp: 0, expected: 1},
+		{exp: 1439, expected: 1},
+		{exp: 1440, expected: 2},
+		{exp: 120 * 50 * 50 * 51, expected: 50},
+		{exp: 120*50*50*51 - 1, expected: 49},
+		{exp: 4294967295, expected: 98},
+	}
+
+	for _, tt := range tests {
+		if level := Level(tt.exp, 120); level != tt.expected {
+			t.Errorf("expected level %d for %d experience, got %d", tt.expected, tt.exp, level)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package mgo
+
+import (
+	"context"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var (
+	// achievementCollectionName is the name of the collection we'll use for all queries.
+	achievementCollectionName = "achievement"
+)
+
+// AchievementRepository handles all operations on unlocked achievements.
+type AchievementRepository struct {
+	db     string
+	client *mongo.Client
+}
+
+// Unlock will store the unlocks, achievements the character has already
+// unlocked keep the time they were first unlocked.
+func (r *AchievementRepository) Unlock(ctx context.Context, unlocks []domain.AchievementUnlock) error {
+	if len(unlocks) == 0 {
+		return nil
+	}
+
+	models := make([]mongo.WriteModel, 0, len(unlocks))
+	for _, u := range unlocks {
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"character": u.Character, "achievement": u.Achievement}).
+			SetUpdate(bson.M{"$setOnInsert": u}).
+			SetUpsert(true))
+	}
+
+	_, err := r.client.Database(r.db).Collection(achievementCollectionName).
+		BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+
+	// Concurrent evaluations may race to unlock the same achievement, the first one wins.
+	if err != nil && !mongo.IsDuplicateKeyError(err) {
+		return mongoErr(err)
+	}
+
+	return nil
+}
+
+// FindByCharacter will find the achievements the character has unlocked.
+func (r *AchievementRepository) FindByCharacter(ctx context.Context, character string) ([]domain.AchievementUnlock, error) {
+	cur, err := r.client.Database(r.db).Collection(achievementCollectionName).
+		Find(ctx, bson.M{"character": character})
+	if err != nil {
+		return nil, mongoErr(err)
+	}
+
+	unlocks := make([]domain.AchievementUnlock, 0)
+	if err := cur.All(ctx, &unlocks); err != nil {
+		return nil, mongoErr(err)
+	}
+
+	return unlocks, nil
+}
+
+// Tally will count the characters that have unlocked each achievement, along with
+// the first and latest unlock, leaving out the excluded characters.
+func (r *AchievementRepository) Tally(ctx context.Context, exclude map[string]struct{}) ([]domain.AchievementTally, error) {
+	excluded := make([]string, 0, len(exclude))
+	for name := range exclude {
+		excluded = append(excluded, name)
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"character": bson.M{"$nin": excluded}}}},
+		{{Key: "$sort", Value: bson.D{{Key: "unlocked_at", Value: 1}}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":      "$achievement",
+			"unlocked": bson.M{"$sum": 1},
+			"first":    bson.M{"$first": "$$ROOT"},
+			"latest":   bson.M{"$last": "$$ROOT"},
+		}}},
+	}
+
+	cur, err := r.client.Database(r.db).Collection(achievementCollectionName).Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, mongoErr(err)
+	}
+
+	tallies := make([]domain.AchievementTally, 0)
+	if err := cur.All(ctx, &tallies); err != nil {
+		return nil, mongoErr(err)
+	}
+
+	return tallies, nil
+}
+
+// NewAchievementRepository returns a new instance of a MongoDB achievement repository.
+func NewAchievementRepository(db string, client *mongo.Client) *AchievementRepository {
+	return &AchievementRepository{
+		db:     db,
+		client: client,
+	}
+}
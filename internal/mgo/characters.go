@@ -2,16 +2,22 @@ package mgo
 
 import (
 	"context"
+	"fmt"
+	"sort"
 	"time"
 
 	"github.com/nokka/d2-armory-api/internal/domain"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 var (
 	// characterCollectionName is the name of the collection we'll use for all queries.
 	characterCollectionName = "character"
+
+	// takedownCollectionName is the name of the collection of characters taken down.
+	takedownCollectionName = "takedown"
 )
 
 // CharacterRepository handles all operations on characters.
@@ -27,7 +33,7 @@ func (r *CharacterRepository) Find(ctx context.Context, id string) (*domain.Char
 
 	// Find the character by id in the collection.
 	err := r.client.Database(r.db).Collection(characterCollectionName).
-		FindOne(ctx, bson.M{"id": id}).Decode(&char)
+		FindOne(ctx, bson.M{"id": domain.NormalizeName(id)}).Decode(&char)
 	if err != nil {
 		return nil, mongoErr(err)
 	}
@@ -37,16 +43,28 @@ func (r *CharacterRepository) Find(ctx context.Context, id string) (*domain.Char
 
 // Update will update the given resource.
 func (r *CharacterRepository) Update(ctx context.Context, character *domain.Character) error {
-	// Changeset, update the binary and time of parsing.
+	// Changeset, update the binary and time of parsing, the binary exists
+	// so the character is no longer deleted if it was before.
+	set := bson.M{
+		"d2s":        character.D2s,
+		"info":       character.Info,
+		"lastparsed": time.Now(),
+	}
+
+	// Characters stored before the first sighting was kept track of stay unknown.
+	if character.FirstSeen != nil {
+		set["firstseen"] = character.FirstSeen
+	}
+
 	change := bson.M{
-		"$set": bson.M{
-			"d2s":        character.D2s,
-			"lastparsed": time.Now(),
+		"$set": set,
+		"$unset": bson.M{
+			"deletedat": "",
 		},
 	}
 
 	_, err := r.client.Database(r.db).Collection(characterCollectionName).
-		UpdateOne(ctx, bson.M{"id": character.ID}, change)
+		UpdateOne(ctx, bson.M{"id": domain.NormalizeName(character.ID)}, change)
 	if err != nil {
 		return mongoErr(err)
 	}
@@ -56,8 +74,99 @@ func (r *CharacterRepository) Update(ctx context.Context, character *domain.Char
 
 // Store will store the new resource.
 func (r *CharacterRepository) Store(ctx context.Context, character *domain.Character) error {
+	// Store a copy with the normalized id, to keep the callers value untouched.
+	doc := *character
+	doc.ID = domain.NormalizeName(character.ID)
+
+	_, err := r.client.Database(r.db).Collection(characterCollectionName).
+		InsertOne(ctx, doc)
+	if err != nil {
+		return mongoErr(err)
+	}
+
+	return nil
+}
+
+// FindSummaries will find the cached metadata of the given characters, characters
+// that have never been parsed are left out of the result.
+func (r *CharacterRepository) FindSummaries(ctx context.Context, ids []string) ([]domain.CharacterSummary, error) {
+	// Only read the fields we need, the items make up most of the document.
+	opts := options.Find().SetProjection(bson.M{
+		"id":               1,
+		"lastparsed":       1,
+		"d2s.header.class": 1,
+		"d2s.header.level": 1,
+	})
+
+	cur, err := r.client.Database(r.db).Collection(characterCollectionName).
+		Find(ctx, bson.M{"id": bson.M{"$in": ids}}, opts)
+	if err != nil {
+		return nil, mongoErr(err)
+	}
+
+	defer cur.Close(ctx)
+
+	summaries := make([]domain.CharacterSummary, 0, len(ids))
+
+	for cur.Next(ctx) {
+		var char domain.Character
+		if err := cur.Decode(&char); err != nil {
+			return nil, mongoErr(err)
+		}
+
+		lastParsed := char.LastParsed
+
+		summary := domain.CharacterSummary{
+			Name:       char.ID,
+			LastParsed: &lastParsed,
+		}
+
+		if char.D2s != nil {
+			summary.Class = char.D2s.Header.Class.String()
+			summary.Level = int(char.D2s.Header.Level)
+		}
+
+		summaries = append(summaries, summary)
+	}
+
+	if err := cur.Err(); err != nil {
+		return nil, mongoErr(err)
+	}
+
+	return summaries, nil
+}
+
+// FindIDs will find the ids of all characters that aren't marked as deleted.
+func (r *CharacterRepository) FindIDs(ctx context.Context) ([]string, error) {
+	ids, err := r.client.Database(r.db).Collection(characterCollectionName).
+		Distinct(ctx, "id", bson.M{"deletedat": nil})
+	if err != nil {
+		return nil, mongoErr(err)
+	}
+
+	return stringValues(ids), nil
+}
+
+// FindDeletedBefore will find the ids of all characters marked as deleted before the given time.
+func (r *CharacterRepository) FindDeletedBefore(ctx context.Context, before time.Time) ([]string, error) {
+	ids, err := r.client.Database(r.db).Collection(characterCollectionName).
+		Distinct(ctx, "id", bson.M{"deletedat": bson.M{"$lt": before}})
+	if err != nil {
+		return nil, mongoErr(err)
+	}
+
+	return stringValues(ids), nil
+}
+
+// MarkDeleted will mark the character as deleted, unless it already is.
+func (r *CharacterRepository) MarkDeleted(ctx context.Context, id string, at time.Time) error {
+	query := bson.M{
+		"id":        domain.NormalizeName(id),
+		"deletedat": nil,
+	}
+
 	_, err := r.client.Database(r.db).Collection(characterCollectionName).
-		InsertOne(ctx, character)
+		UpdateMany(ctx, query, bson.M{"$set": bson.M{"deletedat": at}})
 	if err != nil {
 		return mongoErr(err)
 	}
@@ -65,6 +174,203 @@ func (r *CharacterRepository) Store(ctx context.Context, character *domain.Chara
 	return nil
 }
 
+// Purge will remove the character together with its statistics in a single transaction.
+func (r *CharacterRepository) Purge(ctx context.Context, id string) error {
+	id = domain.NormalizeName(id)
+
+	var deleted int64
+
+	err := transaction(ctx, r.client, func(sc mongo.SessionContext) error {
+		var err error
+		deleted, err = r.purge(sc, id)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if deleted == 0 {
+		return fmt.Errorf("nothing to purge for %s: %w", id, domain.ErrNotFound)
+	}
+
+	return nil
+}
+
+// TakeDown will purge the character and its statistics, along with its graves,
+// last known area and achievements, and leave a tombstone behind in the same
+// transaction so the character is never parsed again. The items it found stay
+// in the Holy Grail of the account, without naming the character.
+func (r *CharacterRepository) TakeDown(ctx context.Context, id string, at time.Time) error {
+	id = domain.NormalizeName(id)
+
+	return transaction(ctx, r.client, func(sc mongo.SessionContext) error {
+		if _, err := r.purge(sc, id); err != nil {
+			return err
+		}
+
+		for _, collection := range []string{graveyardCollectionName, lastAreaCollectionName, achievementCollectionName} {
+			if _, err := r.client.Database(r.db).Collection(collection).DeleteMany(sc, bson.M{"character": id}); err != nil {
+				return err
+			}
+		}
+
+		_, err := r.client.Database(r.db).Collection(grailCollectionName).
+			UpdateMany(sc, bson.M{"character": id}, bson.M{"$set": bson.M{"character": ""}})
+		if err != nil {
+			return err
+		}
+
+		_, err = r.client.Database(r.db).Collection(takedownCollectionName).
+			UpdateOne(sc, bson.M{"character": id}, bson.M{"$set": bson.M{"character": id, "at": at}}, options.Update().SetUpsert(true))
+
+		return err
+	})
+}
+
+// IsTakenDown will tell if the character has been taken down.
+func (r *CharacterRepository) IsTakenDown(ctx context.Context, id string) (bool, error) {
+	n, err := r.client.Database(r.db).Collection(takedownCollectionName).
+		CountDocuments(ctx, bson.M{"character": domain.NormalizeName(id)})
+	if err != nil {
+		return false, mongoErr(err)
+	}
+
+	return n > 0, nil
+}
+
+// NormalizeIDs will lowercase the ids of characters stored before ids were normalized,
+// merging them with the characters stored by the normalized id since. The most recently
+// parsed character of each name is kept, and the earliest first sighting of them.
+// It returns the number of names normalized.
+func (r *CharacterRepository) NormalizeIDs(ctx context.Context) (int, error) {
+	values, err := r.client.Database(r.db).Collection(characterCollectionName).
+		Distinct(ctx, "id", bson.M{})
+	if err != nil {
+		return 0, mongoErr(err)
+	}
+
+	// Group the ids that aren't normalized by the normalized id.
+	variants := make(map[string][]string)
+	for _, id := range stringValues(values) {
+		if name := domain.NormalizeName(id); name != id {
+			variants[name] = append(variants[name], id)
+		}
+	}
+
+	names := make([]string, 0, len(variants))
+	for name := range variants {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for i, name := range names {
+		ids := append(variants[name], name)
+
+		err := transaction(ctx, r.client, func(sc mongo.SessionContext) error {
+			return r.mergeIDs(sc, name, ids)
+		})
+		if err != nil {
+			return i, fmt.Errorf("failed to normalize %s: %w", name, err)
+		}
+	}
+
+	return len(names), nil
+}
+
+// mergeIDs keeps the most recently parsed character of the ids as the character
+// of the name, and deletes the others.
+func (r *CharacterRepository) mergeIDs(ctx context.Context, name string, ids []string) error {
+	collection := r.client.Database(r.db).Collection(characterCollectionName)
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "lastparsed", Value: -1}}).
+		SetProjection(bson.M{"_id": 1, "firstseen": 1})
+
+	cur, err := collection.Find(ctx, bson.M{"id": bson.M{"$in": ids}}, opts)
+	if err != nil {
+		return err
+	}
+
+	var docs []struct {
+		ObjectID  interface{} `bson:"_id"`
+		FirstSeen *time.Time  `bson:"firstseen"`
+	}
+
+	if err := cur.All(ctx, &docs); err != nil {
+		return err
+	}
+
+	if len(docs) == 0 {
+		return nil
+	}
+
+	set := bson.M{"id": name}
+	duplicates := make([]interface{}, 0, len(docs)-1)
+
+	for i, doc := range docs {
+		if doc.FirstSeen != nil {
+			if first, ok := set["firstseen"].(time.Time); !ok || doc.FirstSeen.Before(first) {
+				set["firstseen"] = *doc.FirstSeen
+			}
+		}
+
+		if i > 0 {
+			duplicates = append(duplicates, doc.ObjectID)
+		}
+	}
+
+	if len(duplicates) > 0 {
+		if _, err := collection.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": duplicates}}); err != nil {
+			return err
+		}
+	}
+
+	_, err = collection.UpdateOne(ctx, bson.M{"_id": docs[0].ObjectID}, bson.M{"$set": set})
+
+	return err
+}
+
+// FindTakenDown will find the ids of all characters that have been taken down.
+func (r *CharacterRepository) FindTakenDown(ctx context.Context) ([]string, error) {
+	ids, err := r.client.Database(r.db).Collection(takedownCollectionName).
+		Distinct(ctx, "character", bson.M{})
+	if err != nil {
+		return nil, mongoErr(err)
+	}
+
+	return stringValues(ids), nil
+}
+
+// purge deletes the character and its statistics, returning the number of documents deleted.
+func (r *CharacterRepository) purge(ctx context.Context, id string) (int64, error) {
+	stats, err := r.client.Database(r.db).Collection(statCollectionName).
+		DeleteMany(ctx, bson.M{"character": id})
+	if err != nil {
+		return 0, err
+	}
+
+	chars, err := r.client.Database(r.db).Collection(characterCollectionName).
+		DeleteMany(ctx, bson.M{"id": id})
+	if err != nil {
+		return 0, err
+	}
+
+	return stats.DeletedCount + chars.DeletedCount, nil
+}
+
+// stringValues returns the string values of a distinct query result.
+func stringValues(values []interface{}) []string {
+	strs := make([]string, 0, len(values))
+	for _, v := range values {
+		if s, ok := v.(string); ok {
+			strs = append(strs, s)
+		}
+	}
+
+	return strs
+}
+
 // NewCharacterRepository returns a new instance of a MongoDB character repository.
 func NewCharacterRepository(db string, client *mongo.Client) *CharacterRepository {
 	return &CharacterRepository{
@@ -10,6 +10,7 @@ import (
 	"github.com/nokka/d2-armory-api/internal/domain"
 	"github.com/nokka/d2-armory-api/pkg/env"
 	"github.com/nokka/d2s"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.mongodb.org/mongo-driver/mongo/readpref"
@@ -74,4 +75,135 @@ func TestCharacterRepository(t *testing.T) {
 			t.Error("failed to get character by the ID")
 		}
 	})
+
+	t.Run("mark character as deleted", func(t *testing.T) {
+		if err := characterRepository.MarkDeleted(mgoCtx, "nokka", time.Now()); err != nil {
+			t.Error("failed to mark character as deleted")
+		}
+
+		ids, err := characterRepository.FindDeletedBefore(mgoCtx, time.Now().Add(time.Minute))
+		if err != nil || len(ids) == 0 {
+			t.Error("failed to find deleted characters")
+		}
+	})
+
+	t.Run("find character summaries", func(t *testing.T) {
+		summaries, err := characterRepository.FindSummaries(mgoCtx, []string{"nokka", "unknown"})
+		if err != nil {
+			t.Error("failed to get character summaries")
+		}
+
+		if len(summaries) != 1 || summaries[0].Name != "nokka" {
+			t.Error("failed to get character summaries by the IDs")
+		}
+	})
+
+	t.Run("purge character", func(t *testing.T) {
+		if err := characterRepository.Purge(mgoCtx, "nokka"); err != nil {
+			t.Error("failed to purge character")
+		}
+
+		if _, err := characterRepository.Find(mgoCtx, "nokka"); err == nil {
+			t.Error("expected character to be purged")
+		}
+	})
+
+	t.Run("take down character", func(t *testing.T) {
+		err := characterRepository.Store(mgoCtx, &domain.Character{ID: "takendown", D2s: &d2s.Character{}, LastParsed: time.Now()})
+		if err != nil {
+			t.Error("failed to store character")
+		}
+
+		graveyardRepository := NewGraveyardRepository("armory", client)
+		if err := graveyardRepository.Bury(mgoCtx, domain.Grave{Character: "takendown", Level: 90, DiedAt: time.Now()}); err != nil {
+			t.Error("failed to bury character", err)
+		}
+
+		if err := graveyardRepository.SetLastArea(mgoCtx, domain.LastArea{Character: "takendown", Area: "Chaos Sanctuary"}); err != nil {
+			t.Error("failed to set last area", err)
+		}
+
+		achievementRepository := NewAchievementRepository("armory", client)
+		if err := achievementRepository.Unlock(mgoCtx, []domain.AchievementUnlock{{Character: "takendown", Achievement: "level-90"}}); err != nil {
+			t.Error("failed to unlock achievement", err)
+		}
+
+		grailRepository := NewGrailRepository("armory", client)
+		if err := grailRepository.Record(mgoCtx, []domain.GrailEntry{{Account: "takendownaccount", Kind: "unique", Name: "Harlequin Crest", Character: "takendown"}}); err != nil {
+			t.Error("failed to record grail entry", err)
+		}
+
+		if err := characterRepository.TakeDown(mgoCtx, "TakenDown", time.Now()); err != nil {
+			t.Error("failed to take down character", err)
+		}
+
+		if _, err := characterRepository.Find(mgoCtx, "takendown"); err == nil {
+			t.Error("expected character to be purged")
+		}
+
+		if down, err := characterRepository.IsTakenDown(mgoCtx, "takendown"); err != nil || !down {
+			t.Error("expected character to be taken down")
+		}
+
+		takenDown, err := characterRepository.FindTakenDown(mgoCtx)
+		if err != nil || len(takenDown) == 0 {
+			t.Error("failed to find characters taken down")
+		}
+
+		graves, _, err := graveyardRepository.Find(mgoCtx, domain.GraveyardOptions{Limit: 100})
+		if err != nil {
+			t.Error("failed to find graves", err)
+		}
+
+		for _, g := range graves {
+			if g.Character == "takendown" {
+				t.Error("expected the graves of the character to be removed")
+			}
+		}
+
+		if _, err := graveyardRepository.LastArea(mgoCtx, "takendown"); err == nil {
+			t.Error("expected the last area of the character to be removed")
+		}
+
+		if unlocks, err := achievementRepository.FindByCharacter(mgoCtx, "takendown"); err != nil || len(unlocks) != 0 {
+			t.Error("expected the achievements of the character to be removed")
+		}
+
+		entries, err := grailRepository.Find(mgoCtx, "takendownaccount", "")
+		if err != nil || len(entries) != 1 || entries[0].Character != "" {
+			t.Errorf("expected the grail entry to be kept without the character, got %+v", entries)
+		}
+	})
+
+	t.Run("normalize character ids", func(t *testing.T) {
+		collection := client.Database("armory").Collection(characterCollectionName)
+
+		// Characters stored before ids were normalized, and a duplicate stored since.
+		docs := []interface{}{
+			domain.Character{ID: "Mixed", D2s: &d2s.Character{}, LastParsed: time.Now().Add(-time.Hour)},
+			domain.Character{ID: "MIXED", D2s: &d2s.Character{}, LastParsed: time.Now().Add(-2 * time.Hour)},
+			domain.Character{ID: "mixed", D2s: &d2s.Character{}, LastParsed: time.Now()},
+		}
+
+		if _, err := collection.InsertMany(mgoCtx, docs); err != nil {
+			t.Fatal("failed to store characters", err)
+		}
+
+		if _, err := characterRepository.NormalizeIDs(mgoCtx); err != nil {
+			t.Error("failed to normalize character ids", err)
+		}
+
+		n, err := collection.CountDocuments(mgoCtx, bson.M{"id": bson.M{"$in": []string{"Mixed", "MIXED", "mixed"}}})
+		if err != nil || n != 1 {
+			t.Errorf("expected a single character, got %d", n)
+		}
+
+		if _, err := characterRepository.Find(mgoCtx, "Mixed"); err != nil {
+			t.Error("failed to get the normalized character")
+		}
+
+		if err := characterRepository.Purge(mgoCtx, "mixed"); err != nil {
+			t.Error("failed to purge character")
+		}
+	})
 }
@@ -0,0 +1,35 @@
+package mgo
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+)
+
+// Connect will connect to the MongoDB host and verify the connection by pinging the primary,
+// credentials are only used if a username is supplied.
+func Connect(ctx context.Context, host, db, username, password string) (*mongo.Client, error) {
+	clientOptions := options.Client().ApplyURI("mongodb://" + host)
+
+	// If a username is supplied, auth with it.
+	if username != "" {
+		clientOptions.SetAuth(options.Credential{
+			AuthSource: db,
+			Username:   username,
+			Password:   password,
+		})
+	}
+
+	client, err := mongo.Connect(ctx, clientOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.Ping(ctx, readpref.Primary()); err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
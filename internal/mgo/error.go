@@ -1,6 +1,7 @@
 package mgo
 
 import (
+	"errors"
 	"fmt"
 	"net"
 
@@ -16,27 +17,31 @@ func (e Error) Error() string {
 }
 
 func mongoErr(err error) error {
-	// If the error is a timeout or temporary error, return temporary error.
-	if err, ok := err.(net.Error); ok {
-		if err.Temporary() || err.Timeout() {
-			return fmt.Errorf("temporary error while performing query: %w", domain.ErrTemporary)
-		}
+	switch {
+	case errors.Is(err, mongo.ErrNoDocuments),
+		errors.Is(err, mongo.ErrNilDocument):
+		return fmt.Errorf("%w", domain.ErrNotFound)
+
+	// Timeouts and network errors, wrapped by the driver or not, are worth retrying.
+	case mongo.IsTimeout(err),
+		mongo.IsNetworkError(err):
+		return fmt.Errorf("temporary error while performing query: %s, %w", err, domain.ErrTemporary)
+
+	case errors.Is(err, mongo.ErrClientDisconnected),
+		errors.Is(err, mongo.ErrUnacknowledgedWrite):
+		return fmt.Errorf("%w", domain.ErrTemporary)
 	}
 
-	// if the error is a dial error, return temporary.
-	if err, ok := err.(*net.OpError); ok {
-		if err.Op == "dial" {
-			return fmt.Errorf("dial error: %w", domain.ErrTemporary)
-		}
+	// If the error is a temporary network error, return temporary error.
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Temporary() {
+		return fmt.Errorf("temporary error while performing query: %s, %w", err, domain.ErrTemporary)
 	}
 
-	switch err {
-	case mongo.ErrNoDocuments,
-		mongo.ErrNilDocument:
-		return fmt.Errorf("%w", domain.ErrNotFound)
-	case mongo.ErrClientDisconnected,
-		mongo.ErrUnacknowledgedWrite:
-		return fmt.Errorf("%w", domain.ErrTemporary)
+	// if the error is a dial error, return temporary.
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return fmt.Errorf("dial error: %s, %w", err, domain.ErrTemporary)
 	}
 
 	return fmt.Errorf("unspecified error: %s, %w", err, domain.ErrInternal)
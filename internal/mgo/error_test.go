@@ -0,0 +1,35 @@
+package mgo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestMongoErr(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		err  error
+		want error
+	}{
+		{name: "no documents", err: mongo.ErrNoDocuments, want: domain.ErrNotFound},
+		{name: "wrapped no documents", err: fmt.Errorf("find: %w", mongo.ErrNoDocuments), want: domain.ErrNotFound},
+		{name: "deadline", err: fmt.Errorf("find: %w", context.DeadlineExceeded), want: domain.ErrTemporary},
+		{name: "network error", err: mongo.CommandError{Message: "connection reset", Labels: []string{"NetworkError"}}, want: domain.ErrTemporary},
+		{name: "timeout label", err: mongo.CommandError{Message: "timed out", Labels: []string{"NetworkTimeoutError"}}, want: domain.ErrTemporary},
+		{name: "wrapped dial", err: fmt.Errorf("connect: %w", &net.OpError{Op: "dial", Err: errors.New("connection refused")}), want: domain.ErrTemporary},
+		{name: "disconnected", err: mongo.ErrClientDisconnected, want: domain.ErrTemporary},
+		{name: "unknown", err: errors.New("something went terribly wrong"), want: domain.ErrInternal},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mongoErr(tt.err); !errors.Is(got, tt.want) {
+				t.Errorf("mongoErr() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package mgo
+
+import (
+	"context"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var (
+	// grailCollectionName is the name of the collection we'll use for all queries.
+	grailCollectionName = "grail"
+)
+
+// GrailRepository handles all operations on the Holy Grail.
+type GrailRepository struct {
+	db     string
+	client *mongo.Client
+}
+
+// Record will store the entries that haven't been found by the account on the
+// realm before, entries that have keep the time and character of the first find.
+func (r *GrailRepository) Record(ctx context.Context, entries []domain.GrailEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	models := make([]mongo.WriteModel, 0, len(entries))
+	for _, e := range entries {
+		filter := bson.M{"account": e.Account, "realm": e.Realm, "kind": e.Kind, "name": e.Name}
+
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(filter).
+			SetUpdate(bson.M{"$setOnInsert": e}).
+			SetUpsert(true))
+	}
+
+	_, err := r.client.Database(r.db).Collection(grailCollectionName).
+		BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+
+	// Concurrent parses may race to insert the same entry, the first one wins.
+	if err != nil && !mongo.IsDuplicateKeyError(err) {
+		return mongoErr(err)
+	}
+
+	return nil
+}
+
+// Find will find the entries of the account, on every realm if realm is empty.
+func (r *GrailRepository) Find(ctx context.Context, account string, realm string) ([]domain.GrailEntry, error) {
+	filter := bson.M{"account": account}
+	if realm != "" {
+		filter["realm"] = realm
+	}
+
+	cur, err := r.client.Database(r.db).Collection(grailCollectionName).Find(ctx, filter)
+	if err != nil {
+		return nil, mongoErr(err)
+	}
+
+	entries := make([]domain.GrailEntry, 0)
+	if err := cur.All(ctx, &entries); err != nil {
+		return nil, mongoErr(err)
+	}
+
+	return entries, nil
+}
+
+// NewGrailRepository returns a new instance of a MongoDB grail repository.
+func NewGrailRepository(db string, client *mongo.Client) *GrailRepository {
+	return &GrailRepository{
+		db:     db,
+		client: client,
+	}
+}
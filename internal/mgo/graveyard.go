@@ -0,0 +1,116 @@
+package mgo
+
+import (
+	"context"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var (
+	// graveyardCollectionName is the name of the collection we'll use for graves.
+	graveyardCollectionName = "graveyard"
+
+	// lastAreaCollectionName is the name of the collection we'll use for the last known areas.
+	lastAreaCollectionName = "last_area"
+)
+
+// GraveyardRepository handles all operations on the hardcore graveyard.
+type GraveyardRepository struct {
+	db     string
+	client *mongo.Client
+}
+
+// Bury will store the grave unless the character has one already, the first
+// grave is kept as is. Graves of the name dug before the character was born
+// belong to a previous character with the same name.
+func (r *GraveyardRepository) Bury(ctx context.Context, grave domain.Grave) error {
+	_, err := r.client.Database(r.db).Collection(graveyardCollectionName).UpdateOne(ctx,
+		bson.M{"character": grave.Character, "died_at": bson.M{"$gte": grave.Born}},
+		bson.M{"$setOnInsert": grave},
+		options.Update().SetUpsert(true),
+	)
+
+	// Concurrent parses may race to dig the same grave, the first one wins.
+	if err != nil && !mongo.IsDuplicateKeyError(err) {
+		return mongoErr(err)
+	}
+
+	return nil
+}
+
+// Find will find a page of graves and the total number of graves, leaving out the excluded characters.
+func (r *GraveyardRepository) Find(ctx context.Context, opts domain.GraveyardOptions) ([]domain.Grave, int, error) {
+	filter := bson.M{}
+	if len(opts.Exclude) > 0 {
+		exclude := make([]string, 0, len(opts.Exclude))
+		for name := range opts.Exclude {
+			exclude = append(exclude, name)
+		}
+		filter["character"] = bson.M{"$nin": exclude}
+	}
+
+	sort := bson.D{{Key: "died_at", Value: -1}}
+	if opts.Sort == domain.GraveyardSortLevel {
+		sort = bson.D{{Key: "level", Value: -1}, {Key: "experience", Value: -1}, {Key: "died_at", Value: -1}}
+	}
+
+	collection := r.client.Database(r.db).Collection(graveyardCollectionName)
+
+	total, err := collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, 0, mongoErr(err)
+	}
+
+	cur, err := collection.Find(ctx, filter, options.Find().
+		SetSort(sort).
+		SetSkip(int64(opts.Offset)).
+		SetLimit(int64(opts.Limit)))
+	if err != nil {
+		return nil, 0, mongoErr(err)
+	}
+
+	graves := make([]domain.Grave, 0, opts.Limit)
+	if err := cur.All(ctx, &graves); err != nil {
+		return nil, 0, mongoErr(err)
+	}
+
+	return graves, int(total), nil
+}
+
+// SetLastArea will store where the character was last seen, replacing where it was seen before.
+func (r *GraveyardRepository) SetLastArea(ctx context.Context, area domain.LastArea) error {
+	_, err := r.client.Database(r.db).Collection(lastAreaCollectionName).ReplaceOne(ctx,
+		bson.M{"character": area.Character},
+		area,
+		options.Replace().SetUpsert(true),
+	)
+	if err != nil {
+		return mongoErr(err)
+	}
+
+	return nil
+}
+
+// LastArea will find where the character was last seen.
+func (r *GraveyardRepository) LastArea(ctx context.Context, character string) (*domain.LastArea, error) {
+	var area domain.LastArea
+
+	err := r.client.Database(r.db).Collection(lastAreaCollectionName).
+		FindOne(ctx, bson.M{"character": character}).Decode(&area)
+	if err != nil {
+		return nil, mongoErr(err)
+	}
+
+	return &area, nil
+}
+
+// NewGraveyardRepository returns a new instance of a MongoDB graveyard repository.
+func NewGraveyardRepository(db string, client *mongo.Client) *GraveyardRepository {
+	return &GraveyardRepository{
+		db:     db,
+		client: client,
+	}
+}
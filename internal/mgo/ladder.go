@@ -0,0 +1,73 @@
+package mgo
+
+import (
+	"context"
+	"time"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var (
+	// ladderCollectionName is the name of the collection we'll use for all queries.
+	ladderCollectionName = "ladder"
+)
+
+// LadderRepository handles all operations on the realm ladder.
+type LadderRepository struct {
+	db     string
+	client *mongo.Client
+}
+
+// Replace will store the entries of a new import and remove the previous import
+// in a single transaction, so readers never see both imports at once.
+func (r *LadderRepository) Replace(ctx context.Context, entries []domain.LadderEntry, importedAt time.Time) error {
+	collection := r.client.Database(r.db).Collection(ladderCollectionName)
+
+	return transaction(ctx, r.client, func(sc mongo.SessionContext) error {
+		if _, err := collection.DeleteMany(sc, bson.M{"imported_at": bson.M{"$lt": importedAt}}); err != nil {
+			return err
+		}
+
+		if len(entries) == 0 {
+			return nil
+		}
+
+		docs := make([]interface{}, 0, len(entries))
+		for _, e := range entries {
+			docs = append(docs, e)
+		}
+
+		_, err := collection.InsertMany(sc, docs)
+
+		return err
+	})
+}
+
+// Find will find the entries of the ladder split, ordered by rank.
+func (r *LadderRepository) Find(ctx context.Context, mode string, ladder string) ([]domain.LadderEntry, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "rank", Value: 1}})
+
+	cur, err := r.client.Database(r.db).Collection(ladderCollectionName).
+		Find(ctx, bson.M{"mode": mode, "ladder": ladder}, opts)
+	if err != nil {
+		return nil, mongoErr(err)
+	}
+
+	entries := make([]domain.LadderEntry, 0)
+	if err := cur.All(ctx, &entries); err != nil {
+		return nil, mongoErr(err)
+	}
+
+	return entries, nil
+}
+
+// NewLadderRepository returns a new instance of a MongoDB ladder repository.
+func NewLadderRepository(db string, client *mongo.Client) *LadderRepository {
+	return &LadderRepository{
+		db:     db,
+		client: client,
+	}
+}
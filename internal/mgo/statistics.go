@@ -25,7 +25,7 @@ type StatisticsRepository struct {
 func (r *StatisticsRepository) GetByCharacter(ctx context.Context, character string) (*domain.CharacterStatistics, error) {
 	var char domain.CharacterStatistics
 	err := r.client.Database(r.db).Collection(statCollectionName).
-		FindOne(ctx, bson.M{"character": character}).Decode(&char)
+		FindOne(ctx, bson.M{"character": domain.NormalizeName(character)}).Decode(&char)
 	if err != nil {
 		return nil, mongoErr(err)
 	}
@@ -33,8 +33,39 @@ func (r *StatisticsRepository) GetByCharacter(ctx context.Context, character str
 	return &char, nil
 }
 
+// FindByAccount will return statistics for all characters on the account,
+// and for the given characters known to belong to it.
+func (r *StatisticsRepository) FindByAccount(ctx context.Context, account string, characters []string) ([]domain.CharacterStatistics, error) {
+	names := make([]string, 0, len(characters))
+	for _, c := range characters {
+		names = append(names, domain.NormalizeName(c))
+	}
+
+	query := bson.M{
+		"$or": []bson.M{
+			{"account": domain.NormalizeName(account)},
+			{"character": bson.M{"$in": names}},
+		},
+	}
+
+	cur, err := r.client.Database(r.db).Collection(statCollectionName).Find(ctx, query)
+	if err != nil {
+		return nil, mongoErr(err)
+	}
+
+	stats := make([]domain.CharacterStatistics, 0)
+	if err := cur.All(ctx, &stats); err != nil {
+		return nil, mongoErr(err)
+	}
+
+	return stats, nil
+}
+
 // Upsert will upsert statistics about the given character.
 func (r *StatisticsRepository) Upsert(ctx context.Context, stat domain.StatisticsRequest) error {
+	// Names are normalized to keep them consistent with the characters.
+	stat.Character = domain.NormalizeName(stat.Character)
+
 	// Query to find document on.
 	query := bson.M{
 		"character": stat.Character,
@@ -80,7 +111,7 @@ func (r *StatisticsRepository) Upsert(ctx context.Context, stat domain.Statistic
 func (r *StatisticsRepository) Delete(ctx context.Context, character string) error {
 	// Query to find document on.
 	query := bson.M{
-		"character": character,
+		"character": domain.NormalizeName(character),
 	}
 
 	_, err := r.client.Database(r.db).Collection(statCollectionName).
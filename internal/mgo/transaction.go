@@ -0,0 +1,28 @@
+package mgo
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// transaction runs fn in a transaction, every operation of fn must be given the
+// session context for it to be part of the transaction. Transactions require
+// MongoDB to run as a replica set, a single member is enough.
+func transaction(ctx context.Context, client *mongo.Client, fn func(ctx mongo.SessionContext) error) error {
+	session, err := client.StartSession()
+	if err != nil {
+		return mongoErr(err)
+	}
+
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sc mongo.SessionContext) (interface{}, error) {
+		return nil, fn(sc)
+	})
+	if err != nil {
+		return mongoErr(err)
+	}
+
+	return nil
+}
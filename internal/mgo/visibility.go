@@ -0,0 +1,66 @@
+package mgo
+
+import (
+	"context"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var (
+	// visibilityCollectionName is the name of the collection we'll use for all queries.
+	visibilityCollectionName = "visibility"
+)
+
+// VisibilityRepository handles all operations on visibility settings.
+type VisibilityRepository struct {
+	db     string
+	client *mongo.Client
+}
+
+// Find will find the visibility setting of the character.
+func (r *VisibilityRepository) Find(ctx context.Context, character string) (*domain.VisibilitySetting, error) {
+	var setting domain.VisibilitySetting
+
+	err := r.client.Database(r.db).Collection(visibilityCollectionName).
+		FindOne(ctx, bson.M{"character": domain.NormalizeName(character)}).Decode(&setting)
+	if err != nil {
+		return nil, mongoErr(err)
+	}
+
+	return &setting, nil
+}
+
+// FindHidden will find the names of all characters that aren't public.
+func (r *VisibilityRepository) FindHidden(ctx context.Context) ([]string, error) {
+	names, err := r.client.Database(r.db).Collection(visibilityCollectionName).
+		Distinct(ctx, "character", bson.M{"visibility": bson.M{"$ne": domain.VisibilityPublic}})
+	if err != nil {
+		return nil, mongoErr(err)
+	}
+
+	return stringValues(names), nil
+}
+
+// Upsert will store the setting, replacing the previous setting of the character.
+func (r *VisibilityRepository) Upsert(ctx context.Context, setting domain.VisibilitySetting) error {
+	setting.Character = domain.NormalizeName(setting.Character)
+
+	_, err := r.client.Database(r.db).Collection(visibilityCollectionName).
+		ReplaceOne(ctx, bson.M{"character": setting.Character}, setting, options.Replace().SetUpsert(true))
+	if err != nil {
+		return mongoErr(err)
+	}
+
+	return nil
+}
+
+// NewVisibilityRepository returns a new instance of a MongoDB visibility repository.
+func NewVisibilityRepository(db string, client *mongo.Client) *VisibilityRepository {
+	return &VisibilityRepository{
+		db:     db,
+		client: client,
+	}
+}
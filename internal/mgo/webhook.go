@@ -0,0 +1,160 @@
+package mgo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var (
+	// webhookCollectionName is the name of the collection we'll use for webhooks.
+	webhookCollectionName = "webhook"
+
+	// deadLetterCollectionName is the name of the collection we'll use for failed deliveries.
+	deadLetterCollectionName = "dead_letter"
+)
+
+// WebhookRepository handles all operations on webhooks.
+type WebhookRepository struct {
+	db     string
+	client *mongo.Client
+}
+
+// Insert will store a new webhook.
+func (r *WebhookRepository) Insert(ctx context.Context, webhook domain.Webhook) error {
+	_, err := r.client.Database(r.db).Collection(webhookCollectionName).InsertOne(ctx, webhook)
+	if err != nil {
+		return mongoErr(err)
+	}
+
+	return nil
+}
+
+// Find will find the webhook by id.
+func (r *WebhookRepository) Find(ctx context.Context, id string) (*domain.Webhook, error) {
+	var webhook domain.Webhook
+
+	err := r.client.Database(r.db).Collection(webhookCollectionName).
+		FindOne(ctx, bson.M{"id": id}).Decode(&webhook)
+	if err != nil {
+		return nil, mongoErr(err)
+	}
+
+	return &webhook, nil
+}
+
+// FindAll will find every webhook, oldest first.
+func (r *WebhookRepository) FindAll(ctx context.Context) ([]domain.Webhook, error) {
+	return r.find(ctx, bson.M{})
+}
+
+// FindByEvent will find the webhooks subscribed to the event.
+func (r *WebhookRepository) FindByEvent(ctx context.Context, event string) ([]domain.Webhook, error) {
+	return r.find(ctx, bson.M{"events": event})
+}
+
+func (r *WebhookRepository) find(ctx context.Context, filter bson.M) ([]domain.Webhook, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}})
+
+	cur, err := r.client.Database(r.db).Collection(webhookCollectionName).Find(ctx, filter, opts)
+	if err != nil {
+		return nil, mongoErr(err)
+	}
+
+	webhooks := make([]domain.Webhook, 0)
+	if err := cur.All(ctx, &webhooks); err != nil {
+		return nil, mongoErr(err)
+	}
+
+	return webhooks, nil
+}
+
+// Delete will delete the webhook by id.
+func (r *WebhookRepository) Delete(ctx context.Context, id string) error {
+	res, err := r.client.Database(r.db).Collection(webhookCollectionName).DeleteOne(ctx, bson.M{"id": id})
+	if err != nil {
+		return mongoErr(err)
+	}
+
+	if res.DeletedCount == 0 {
+		return fmt.Errorf("webhook %s: %w", id, domain.ErrNotFound)
+	}
+
+	return nil
+}
+
+// NewWebhookRepository returns a new instance of a MongoDB webhook repository.
+func NewWebhookRepository(db string, client *mongo.Client) *WebhookRepository {
+	return &WebhookRepository{
+		db:     db,
+		client: client,
+	}
+}
+
+// DeadLetterRepository handles all operations on deliveries that failed for good.
+type DeadLetterRepository struct {
+	db     string
+	client *mongo.Client
+}
+
+// Insert will store the dead letter.
+func (r *DeadLetterRepository) Insert(ctx context.Context, letter domain.DeadLetter) error {
+	_, err := r.client.Database(r.db).Collection(deadLetterCollectionName).InsertOne(ctx, letter)
+	if err != nil {
+		return mongoErr(err)
+	}
+
+	return nil
+}
+
+// Find will find the dead letter by id.
+func (r *DeadLetterRepository) Find(ctx context.Context, id string) (*domain.DeadLetter, error) {
+	var letter domain.DeadLetter
+
+	err := r.client.Database(r.db).Collection(deadLetterCollectionName).
+		FindOne(ctx, bson.M{"id": id}).Decode(&letter)
+	if err != nil {
+		return nil, mongoErr(err)
+	}
+
+	return &letter, nil
+}
+
+// FindAll will find every dead letter, most recent first.
+func (r *DeadLetterRepository) FindAll(ctx context.Context) ([]domain.DeadLetter, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "failed_at", Value: -1}})
+
+	cur, err := r.client.Database(r.db).Collection(deadLetterCollectionName).Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, mongoErr(err)
+	}
+
+	letters := make([]domain.DeadLetter, 0)
+	if err := cur.All(ctx, &letters); err != nil {
+		return nil, mongoErr(err)
+	}
+
+	return letters, nil
+}
+
+// Delete will delete the dead letter by id.
+func (r *DeadLetterRepository) Delete(ctx context.Context, id string) error {
+	_, err := r.client.Database(r.db).Collection(deadLetterCollectionName).DeleteOne(ctx, bson.M{"id": id})
+	if err != nil {
+		return mongoErr(err)
+	}
+
+	return nil
+}
+
+// NewDeadLetterRepository returns a new instance of a MongoDB dead letter repository.
+func NewDeadLetterRepository(db string, client *mongo.Client) *DeadLetterRepository {
+	return &DeadLetterRepository{
+		db:     db,
+		client: client,
+	}
+}
@@ -0,0 +1,223 @@
+// Package openapi describes HTTP APIs as OpenAPI 3 documents and validates
+// requests and responses against them.
+package openapi
+
+import (
+	"sort"
+	"strings"
+)
+
+// Version is the version of the OpenAPI specification documents are written in.
+const Version = "3.0.3"
+
+// Document is an OpenAPI document, describing every operation of an API.
+type Document struct {
+	OpenAPI    string                `json:"openapi"`
+	Info       Info                  `json:"info"`
+	Paths      map[string]PathItem   `json:"paths"`
+	Components Components            `json:"components"`
+	Security   []map[string][]string `json:"security,omitempty"`
+}
+
+// Info is the metadata of the API.
+type Info struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+// PathItem holds the operations of a path by lower cased method.
+type PathItem map[string]*Operation
+
+// Operation is a single method on a path.
+type Operation struct {
+	OperationID string                `json:"operationId"`
+	Summary     string                `json:"summary,omitempty"`
+	Description string                `json:"description,omitempty"`
+	Tags        []string              `json:"tags,omitempty"`
+	Deprecated  bool                  `json:"deprecated,omitempty"`
+	Parameters  []Parameter           `json:"parameters,omitempty"`
+	RequestBody *RequestBody          `json:"requestBody,omitempty"`
+	Responses   map[string]*Response  `json:"responses"`
+	Security    []map[string][]string `json:"security,omitempty"`
+}
+
+// Locations of parameters.
+const (
+	InPath   = "path"
+	InQuery  = "query"
+	InHeader = "header"
+)
+
+// Parameter is a path, query or header parameter of an operation.
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required,omitempty"`
+	Schema      *Schema `json:"schema"`
+}
+
+// RequestBody is the body of an operation by content type.
+type RequestBody struct {
+	Description string               `json:"description,omitempty"`
+	Required    bool                 `json:"required,omitempty"`
+	Content     map[string]MediaType `json:"content"`
+}
+
+// Response is a response of an operation by content type.
+type Response struct {
+	Description string               `json:"description"`
+	Headers     map[string]Header    `json:"headers,omitempty"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+// Header is a header of a response.
+type Header struct {
+	Description string  `json:"description,omitempty"`
+	Schema      *Schema `json:"schema"`
+}
+
+// MediaType is the schema of a body in a content type.
+type MediaType struct {
+	Schema *Schema `json:"schema,omitempty"`
+}
+
+// Components holds the schemas and security schemes operations refer to.
+type Components struct {
+	Schemas         map[string]*Schema         `json:"schemas,omitempty"`
+	SecuritySchemes map[string]*SecurityScheme `json:"securitySchemes,omitempty"`
+}
+
+// SecurityScheme is a way operations are authenticated.
+type SecurityScheme struct {
+	Type        string `json:"type"`
+	Scheme      string `json:"scheme,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// Add adds the operation on the method and path, paths use the {name}
+// placeholders of path parameters.
+func (d *Document) Add(method string, path string, op *Operation) {
+	if d.Paths == nil {
+		d.Paths = make(map[string]PathItem)
+	}
+
+	item, ok := d.Paths[path]
+	if !ok {
+		item = make(PathItem)
+		d.Paths[path] = item
+	}
+
+	item[strings.ToLower(method)] = op
+}
+
+// Operation returns the operation on the method and path if it's documented.
+func (d *Document) Operation(method string, path string) *Operation {
+	item, ok := d.Paths[path]
+	if !ok {
+		return nil
+	}
+
+	return item[strings.ToLower(method)]
+}
+
+// Routes returns the method and path of every operation, sorted.
+func (d *Document) Routes() []string {
+	var routes []string
+	for path, item := range d.Paths {
+		for method := range item {
+			routes = append(routes, strings.ToUpper(method)+" "+path)
+		}
+	}
+
+	sort.Strings(routes)
+
+	return routes
+}
+
+// Only returns a copy of the document with only the routes, as "METHOD /path".
+func (d *Document) Only(routes []string) *Document {
+	keep := make(map[string]struct{}, len(routes))
+	for _, route := range routes {
+		keep[route] = struct{}{}
+	}
+
+	only := *d
+	only.Paths = make(map[string]PathItem)
+
+	for path, item := range d.Paths {
+		for method, op := range item {
+			if _, ok := keep[strings.ToUpper(method)+" "+path]; ok {
+				only.Add(method, path, op)
+			}
+		}
+	}
+
+	return &only
+}
+
+// Match returns the operation the request path is served by and the values of its
+// path parameters, literal segments take precedence over parameters.
+func (d *Document) Match(method string, path string) (*Operation, map[string]string) {
+	segments := splitPath(path)
+
+	var (
+		match   *Operation
+		params  map[string]string
+		literal = -1
+	)
+
+	for template, item := range d.Paths {
+		op, ok := item[strings.ToLower(method)]
+		if !ok {
+			continue
+		}
+
+		values, literals, ok := matchPath(splitPath(template), segments)
+		if !ok || literals <= literal {
+			continue
+		}
+
+		match, params, literal = op, values, literals
+	}
+
+	return match, params
+}
+
+// matchPath matches the segments of a path to the segments of a template, returning
+// the values of the parameters and the number of literal segments matched.
+func matchPath(template []string, segments []string) (map[string]string, int, bool) {
+	if len(template) != len(segments) {
+		return nil, 0, false
+	}
+
+	values := make(map[string]string)
+	literals := 0
+
+	for i, t := range template {
+		if strings.HasPrefix(t, "{") && strings.HasSuffix(t, "}") {
+			if segments[i] == "" {
+				return nil, 0, false
+			}
+			values[strings.Trim(t, "{}")] = segments[i]
+			continue
+		}
+
+		if t != segments[i] {
+			return nil, 0, false
+		}
+		literals++
+	}
+
+	return values, literals, true
+}
+
+func splitPath(path string) []string {
+	path = strings.Trim(path, "/")
+	if path == "" {
+		return nil
+	}
+
+	return strings.Split(path, "/")
+}
@@ -0,0 +1,169 @@
+package openapi
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+	"time"
+)
+
+type testItem struct {
+	Name string `json:"name"`
+}
+
+type testCharacter struct {
+	Name     string            `json:"name"`
+	Level    uint8             `json:"level"`
+	Items    []testItem        `json:"items"`
+	Best     *testItem         `json:"best,omitempty"`
+	Kills    map[string]int    `json:"kills"`
+	Played   time.Time         `json:"played"`
+	Ignored  string            `json:"-"`
+	Raw      json.RawMessage   `json:"raw,omitempty"`
+	Children []*testCharacter  `json:"children,omitempty"`
+	Tags     map[string]string `json:"tags,omitempty"`
+}
+
+func TestGenerator(t *testing.T) {
+	g := NewGenerator()
+
+	ref := g.Schema(testCharacter{})
+	if ref.Ref != refPrefix+"TestCharacter" {
+		t.Fatalf("expected a reference to the component, got = %+v", ref)
+	}
+
+	s := g.Schemas()["TestCharacter"]
+	if s == nil {
+		t.Fatal("expected the struct to be a component")
+	}
+
+	expected := map[string]string{
+		"name":     "string",
+		"level":    "integer",
+		"items":    "array",
+		"kills":    "object",
+		"played":   "string",
+		"raw":      "",
+		"children": "array",
+		"tags":     "object",
+		"best":     "",
+	}
+
+	if len(s.Properties) != len(expected) {
+		t.Errorf("expected %d properties, got = %d", len(expected), len(s.Properties))
+	}
+
+	for name, typ := range expected {
+		prop, ok := s.Properties[name]
+		if !ok {
+			t.Errorf("expected property %s", name)
+			continue
+		}
+		if prop.Type != typ {
+			t.Errorf("expected %s to be %q, got = %q", name, typ, prop.Type)
+		}
+	}
+
+	if got := s.Required; len(got) != 5 || got[0] != "items" || got[4] != "played" {
+		t.Errorf("expected the fields without omitempty to be required, got = %v", got)
+	}
+
+	if best := s.Properties["best"]; !best.Nullable || len(best.OneOf) != 1 || best.OneOf[0].Ref != refPrefix+"TestItem" {
+		t.Errorf("expected a nullable reference to the item, got = %+v", best)
+	}
+
+	if children := s.Properties["children"]; children.Items.OneOf[0].Ref != refPrefix+"TestCharacter" {
+		t.Errorf("expected the children to refer back to the character, got = %+v", children.Items)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	g := NewGenerator()
+	character := g.Schema(testCharacter{})
+
+	doc := &Document{Components: Components{Schemas: g.Schemas()}}
+
+	tests := []struct {
+		name  string
+		value string
+		valid bool
+	}{
+		{name: "valid", value: `{"name":"nokka","level":90,"items":[{"name":"Shako"}],"kills":{"Baal":2},"played":"2021-03-01T12:00:00Z"}`, valid: true},
+		{name: "nullable fields", value: `{"name":"nokka","level":90,"items":null,"kills":null,"played":"2021-03-01T12:00:00Z","best":null}`, valid: true},
+		{name: "missing required", value: `{"name":"nokka","level":90,"items":[],"kills":{}}`},
+		{name: "wrong type", value: `{"name":1,"level":90,"items":[],"kills":{},"played":""}`},
+		{name: "negative unsigned", value: `{"name":"nokka","level":-1,"items":[],"kills":{},"played":""}`},
+		{name: "fraction for integer", value: `{"name":"nokka","level":1.5,"items":[],"kills":{},"played":""}`},
+		{name: "invalid item", value: `{"name":"nokka","level":90,"items":[{}],"kills":{},"played":""}`},
+		{name: "invalid map value", value: `{"name":"nokka","level":90,"items":[],"kills":{"Baal":"two"},"played":""}`},
+		{name: "null", value: `null`},
+		{name: "invalid json", value: `{`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := doc.ValidateJSON(character, "character", []byte(tt.value))
+			if (err == nil) != tt.valid {
+				t.Errorf("expected valid to be %t, got = %v", tt.valid, err)
+			}
+		})
+	}
+}
+
+func TestMatch(t *testing.T) {
+	doc := &Document{}
+	doc.Add("GET", "/characters", &Operation{OperationID: "list"})
+	doc.Add("DELETE", "/webhooks/{id}", &Operation{OperationID: "delete"})
+	doc.Add("GET", "/webhooks/{id}", &Operation{OperationID: "get"})
+	doc.Add("GET", "/webhooks/dead-letters", &Operation{OperationID: "letters"})
+
+	tests := []struct {
+		method    string
+		path      string
+		operation string
+		id        string
+	}{
+		{method: "GET", path: "/characters", operation: "list"},
+		{method: "GET", path: "/characters/", operation: "list"},
+		{method: "GET", path: "/webhooks/abc", operation: "get", id: "abc"},
+		{method: "DELETE", path: "/webhooks/abc", operation: "delete", id: "abc"},
+		{method: "GET", path: "/webhooks/dead-letters", operation: "letters"},
+		{method: "POST", path: "/characters"},
+		{method: "GET", path: "/characters/nokka/items"},
+	}
+
+	for _, tt := range tests {
+		op, params := doc.Match(tt.method, tt.path)
+
+		if tt.operation == "" {
+			if op != nil {
+				t.Errorf("%s %s: expected no operation, got = %s", tt.method, tt.path, op.OperationID)
+			}
+			continue
+		}
+
+		if op == nil || op.OperationID != tt.operation {
+			t.Errorf("%s %s: expected operation %s, got = %+v", tt.method, tt.path, tt.operation, op)
+			continue
+		}
+
+		if params["id"] != tt.id {
+			t.Errorf("%s %s: expected id %q, got = %q", tt.method, tt.path, tt.id, params["id"])
+		}
+	}
+
+	op := &Operation{Parameters: []Parameter{
+		{Name: "limit", In: InQuery, Schema: Integer(0)},
+		{Name: "sort", In: InQuery, Schema: String("name", "level")},
+		{Name: "character", In: InQuery, Required: true, Schema: String()},
+	}}
+
+	if err := doc.ValidateParameters(op, nil, url.Values{"limit": {"10"}, "sort": {"name"}, "character": {"nokka"}}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	err := doc.ValidateParameters(op, nil, url.Values{"limit": {"-1"}, "sort": {"class"}})
+	if verr, ok := err.(*ValidationError); !ok || len(verr.Problems) != 3 {
+		t.Errorf("expected three problems, got = %v", err)
+	}
+}
@@ -0,0 +1,285 @@
+package openapi
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Schema is the subset of the OpenAPI schema object used to describe JSON values.
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Nullable             bool               `json:"nullable,omitempty"`
+	Enum                 []interface{}      `json:"enum,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty"`
+	Maximum              *float64           `json:"maximum,omitempty"`
+	MinLength            *int               `json:"minLength,omitempty"`
+	MinItems             *int               `json:"minItems,omitempty"`
+	MaxItems             *int               `json:"maxItems,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	OneOf                []*Schema          `json:"oneOf,omitempty"`
+}
+
+// refPrefix is the prefix of references to component schemas.
+const refPrefix = "#/components/schemas/"
+
+// Ref returns a reference to the component schema by name.
+func Ref(name string) *Schema {
+	return &Schema{Ref: refPrefix + name}
+}
+
+// String returns a string schema, limited to the values when there are any.
+func String(values ...string) *Schema {
+	s := &Schema{Type: "string"}
+	for _, v := range values {
+		s.Enum = append(s.Enum, v)
+	}
+
+	return s
+}
+
+// Integer returns an integer schema, at least the minimum.
+func Integer(minimum float64) *Schema {
+	return &Schema{Type: "integer", Minimum: &minimum}
+}
+
+// Object returns an object schema with the properties, all of them required.
+func Object(properties map[string]*Schema) *Schema {
+	s := &Schema{Type: "object", Properties: properties}
+	for name := range properties {
+		s.Required = append(s.Required, name)
+	}
+	sort.Strings(s.Required)
+
+	return s
+}
+
+// Array returns an array schema of the items.
+func Array(items *Schema) *Schema {
+	return &Schema{Type: "array", Items: items}
+}
+
+// Generator generates schemas from Go types the way encoding/json marshals them,
+// struct types become component schemas that are referred to by name.
+type Generator struct {
+	schemas map[string]*Schema
+	names   map[reflect.Type]string
+}
+
+// Schema returns the schema of the value, a reference for structs.
+func (g *Generator) Schema(v interface{}) *Schema {
+	return g.schemaOf(reflect.TypeOf(v))
+}
+
+// Component returns the component schema of the struct value by name, for
+// the schema to be refined beyond what its type tells.
+func (g *Generator) Component(v interface{}) (string, *Schema) {
+	t := reflect.TypeOf(v)
+	g.schemaOf(t)
+
+	name := g.names[t]
+
+	return name, g.schemas[name]
+}
+
+// Define defines the component schema of the value's type by hand, for types
+// that marshal themselves.
+func (g *Generator) Define(v interface{}, s *Schema) {
+	t := reflect.TypeOf(v)
+	name := g.nameOf(t)
+
+	g.names[t] = name
+	g.schemas[name] = s
+}
+
+// Schemas returns the component schemas by name.
+func (g *Generator) Schemas() map[string]*Schema {
+	return g.schemas
+}
+
+var (
+	timeType      = reflect.TypeOf(time.Time{})
+	marshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	rawType       = reflect.TypeOf(json.RawMessage{})
+)
+
+func (g *Generator) schemaOf(t reflect.Type) *Schema {
+	if t == nil {
+		return &Schema{}
+	}
+
+	if t.Kind() == reflect.Ptr {
+		s := g.schemaOf(t.Elem())
+		if s.Ref != "" {
+			// Siblings of references are ignored, so the reference is wrapped to be nullable.
+			return &Schema{OneOf: []*Schema{s}, Nullable: true}
+		}
+		s.Nullable = true
+		return s
+	}
+
+	if name, ok := g.names[t]; ok {
+		return Ref(name)
+	}
+
+	switch {
+	case t == timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case t == rawType:
+		return &Schema{}
+	case t.Implements(marshalerType) || reflect.PtrTo(t).Implements(marshalerType):
+		// The type marshals itself into anything unless it's been defined by hand.
+		return &Schema{}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return &Schema{Type: "integer", Format: intFormat(t)}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		minimum := 0.0
+		return &Schema{Type: "integer", Format: intFormat(t), Minimum: &minimum}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte", Nullable: true}
+		}
+		// Nil slices are marshaled as null.
+		return &Schema{Type: "array", Items: g.schemaOf(t.Elem()), Nullable: true}
+	case reflect.Array:
+		n := t.Len()
+		return &Schema{Type: "array", Items: g.schemaOf(t.Elem()), MinItems: &n, MaxItems: &n}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: g.schemaOf(t.Elem()), Nullable: true}
+	case reflect.Struct:
+		return g.structOf(t)
+	}
+
+	// Interfaces hold anything.
+	return &Schema{}
+}
+
+// structOf registers the struct as a component schema and returns a reference to it,
+// anonymous structs are described inline.
+func (g *Generator) structOf(t reflect.Type) *Schema {
+	s := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+
+	if t.Name() == "" {
+		g.fieldsOf(t, s)
+		sort.Strings(s.Required)
+		return s
+	}
+
+	// The schema is registered before its fields to let them refer back to it.
+	name := g.nameOf(t)
+	g.names[t] = name
+	g.schemas[name] = s
+
+	g.fieldsOf(t, s)
+	sort.Strings(s.Required)
+
+	return Ref(name)
+}
+
+// fieldsOf adds the fields of the struct to the schema, promoting the fields of embedded structs.
+func (g *Generator) fieldsOf(t reflect.Type, s *Schema) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name, opts := parseTag(tag)
+
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				g.fieldsOf(ft, s)
+				continue
+			}
+		}
+
+		if f.PkgPath != "" {
+			continue
+		}
+
+		if name == "" {
+			name = f.Name
+		}
+
+		s.Properties[name] = g.schemaOf(f.Type)
+
+		if !strings.Contains(opts, "omitempty") {
+			s.Required = append(s.Required, name)
+		}
+	}
+}
+
+// nameOf returns the component name of the type, prefixed with the package when
+// another type by the same name has already been registered.
+func (g *Generator) nameOf(t reflect.Type) string {
+	name := exported(t.Name())
+	if _, taken := g.schemas[name]; !taken {
+		return name
+	}
+
+	pkg := t.PkgPath()
+	if i := strings.LastIndex(pkg, "/"); i >= 0 {
+		pkg = pkg[i+1:]
+	}
+
+	return exported(pkg) + name
+}
+
+func parseTag(tag string) (string, string) {
+	if i := strings.Index(tag, ","); i >= 0 {
+		return tag[:i], tag[i+1:]
+	}
+	return tag, ""
+}
+
+func intFormat(t reflect.Type) string {
+	if t.Bits() > 32 {
+		return "int64"
+	}
+	return "int32"
+}
+
+func exported(name string) string {
+	if name == "" {
+		return name
+	}
+
+	r := []rune(name)
+	r[0] = unicode.ToUpper(r[0])
+
+	return string(r)
+}
+
+// NewGenerator returns a generator without any component schemas.
+func NewGenerator() *Generator {
+	return &Generator{
+		schemas: make(map[string]*Schema),
+		names:   make(map[reflect.Type]string),
+	}
+}
@@ -0,0 +1,290 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// ValidationError lists everything about a value that doesn't match its schema.
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return strings.Join(e.Problems, ", ")
+}
+
+// ValidateJSON validates the JSON document against the schema, the document is
+// named by the root of the paths to the problems.
+func (d *Document) ValidateJSON(s *Schema, root string, data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var value interface{}
+	if err := dec.Decode(&value); err != nil {
+		return &ValidationError{Problems: []string{fmt.Sprintf("%s: invalid JSON", root)}}
+	}
+
+	return d.Validate(s, root, value)
+}
+
+// Validate validates the decoded JSON value against the schema, references are
+// resolved against the component schemas of the document.
+func (d *Document) Validate(s *Schema, root string, value interface{}) error {
+	v := validator{schemas: d.Components.Schemas}
+	v.validate(s, root, value)
+
+	if len(v.problems) > 0 {
+		return &ValidationError{Problems: v.problems}
+	}
+
+	return nil
+}
+
+// ValidateParameters validates the path and query parameters of a request to the operation.
+func (d *Document) ValidateParameters(op *Operation, path map[string]string, query url.Values) error {
+	v := validator{schemas: d.Components.Schemas}
+
+	for _, p := range op.Parameters {
+		var (
+			raw string
+			ok  bool
+		)
+
+		switch p.In {
+		case InPath:
+			raw, ok = path[p.Name]
+		case InQuery:
+			raw, ok = query.Get(p.Name), query.Get(p.Name) != ""
+		default:
+			continue
+		}
+
+		name := fmt.Sprintf("%s parameter %s", p.In, p.Name)
+
+		if !ok {
+			if p.Required {
+				v.problems = append(v.problems, name+": is required")
+			}
+			continue
+		}
+
+		v.validate(p.Schema, name, parameterValue(v.resolve(p.Schema), raw))
+	}
+
+	if len(v.problems) > 0 {
+		return &ValidationError{Problems: v.problems}
+	}
+
+	return nil
+}
+
+// parameterValue returns the raw parameter as the JSON value of the type of the schema,
+// values that aren't of the type are left as strings to fail validation.
+func parameterValue(s *Schema, raw string) interface{} {
+	if s == nil {
+		return raw
+	}
+
+	switch s.Type {
+	case "integer":
+		if _, err := strconv.ParseInt(raw, 10, 64); err == nil {
+			return json.Number(raw)
+		}
+	case "number":
+		if _, err := strconv.ParseFloat(raw, 64); err == nil {
+			return json.Number(raw)
+		}
+	case "boolean":
+		if b, err := strconv.ParseBool(raw); err == nil {
+			return b
+		}
+	}
+
+	return raw
+}
+
+type validator struct {
+	schemas  map[string]*Schema
+	problems []string
+}
+
+func (v *validator) problem(path string, format string, args ...interface{}) {
+	v.problems = append(v.problems, path+": "+fmt.Sprintf(format, args...))
+}
+
+// resolve follows the reference of the schema to the component schema.
+func (v *validator) resolve(s *Schema) *Schema {
+	for s != nil && s.Ref != "" {
+		s = v.schemas[strings.TrimPrefix(s.Ref, refPrefix)]
+	}
+
+	return s
+}
+
+func (v *validator) validate(s *Schema, path string, value interface{}) {
+	if s == nil {
+		return
+	}
+
+	if s.Ref != "" {
+		resolved := v.resolve(s)
+		if resolved == nil {
+			v.problem(path, "unknown schema %s", s.Ref)
+			return
+		}
+		s = resolved
+	}
+
+	if value == nil {
+		if !s.Nullable && (s.Type != "" || len(s.OneOf) > 0) {
+			v.problem(path, "must not be null")
+		}
+		return
+	}
+
+	if len(s.OneOf) > 0 {
+		matches := 0
+		for _, one := range s.OneOf {
+			branch := validator{schemas: v.schemas}
+			branch.validate(one, path, value)
+			if len(branch.problems) == 0 {
+				matches++
+			}
+		}
+
+		if matches != 1 {
+			v.problem(path, "must match exactly one schema, matched %d", matches)
+			return
+		}
+	}
+
+	if len(s.Enum) > 0 && !inEnum(s.Enum, value) {
+		v.problem(path, "must be one of %s", enumString(s.Enum))
+	}
+
+	switch s.Type {
+	case "object":
+		v.object(s, path, value)
+	case "array":
+		v.array(s, path, value)
+	case "string":
+		str, ok := value.(string)
+		if !ok {
+			v.problem(path, "must be a string")
+			return
+		}
+		if s.MinLength != nil && len([]rune(str)) < *s.MinLength {
+			v.problem(path, "must be at least %d characters", *s.MinLength)
+		}
+	case "integer", "number":
+		v.number(s, path, value)
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			v.problem(path, "must be a boolean")
+		}
+	}
+}
+
+func (v *validator) object(s *Schema, path string, value interface{}) {
+	obj, ok := value.(map[string]interface{})
+	if !ok {
+		v.problem(path, "must be an object")
+		return
+	}
+
+	for _, name := range s.Required {
+		if _, ok := obj[name]; !ok {
+			v.problem(path+"."+name, "is required")
+		}
+	}
+
+	for name, field := range obj {
+		if prop, ok := s.Properties[name]; ok {
+			v.validate(prop, path+"."+name, field)
+			continue
+		}
+
+		if s.AdditionalProperties != nil {
+			v.validate(s.AdditionalProperties, path+"."+name, field)
+		}
+	}
+}
+
+func (v *validator) array(s *Schema, path string, value interface{}) {
+	arr, ok := value.([]interface{})
+	if !ok {
+		v.problem(path, "must be an array")
+		return
+	}
+
+	if s.MinItems != nil && len(arr) < *s.MinItems {
+		v.problem(path, "must have at least %d items", *s.MinItems)
+	}
+
+	if s.MaxItems != nil && len(arr) > *s.MaxItems {
+		v.problem(path, "must have at most %d items", *s.MaxItems)
+	}
+
+	for i, item := range arr {
+		v.validate(s.Items, fmt.Sprintf("%s[%d]", path, i), item)
+	}
+}
+
+func (v *validator) number(s *Schema, path string, value interface{}) {
+	var (
+		f       float64
+		integer bool
+	)
+
+	switch n := value.(type) {
+	case json.Number:
+		var err error
+		if f, err = n.Float64(); err != nil {
+			v.problem(path, "must be a number")
+			return
+		}
+		integer = !strings.ContainsAny(n.String(), ".eE")
+	case float64:
+		f, integer = n, n == float64(int64(n))
+	default:
+		v.problem(path, "must be a number")
+		return
+	}
+
+	if s.Type == "integer" && !integer {
+		v.problem(path, "must be an integer")
+		return
+	}
+
+	if s.Minimum != nil && f < *s.Minimum {
+		v.problem(path, "must be at least %v", *s.Minimum)
+	}
+
+	if s.Maximum != nil && f > *s.Maximum {
+		v.problem(path, "must be at most %v", *s.Maximum)
+	}
+}
+
+func inEnum(enum []interface{}, value interface{}) bool {
+	for _, e := range enum {
+		if fmt.Sprint(e) == fmt.Sprint(value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func enumString(enum []interface{}) string {
+	values := make([]string, 0, len(enum))
+	for _, e := range enum {
+		values = append(values, fmt.Sprint(e))
+	}
+
+	return strings.Join(values, ", ")
+}
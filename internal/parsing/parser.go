@@ -5,19 +5,37 @@ import (
 	"os"
 	"time"
 
+	"github.com/nokka/d2-armory-api/internal/charsave"
 	"github.com/nokka/d2-armory-api/internal/domain"
 	"github.com/nokka/d2s"
 )
 
+// index resolves character names to the binaries on disk.
+type index interface {
+	Lookup(name string) (charsave.Entry, bool)
+	Path(e charsave.Entry) string
+}
+
+// realm resolves the metadata the realm keeps about characters.
+type realm interface {
+	Info(name string) (*domain.CharacterInfo, error)
+}
+
 // Parser performs all parsing from d2s data to our domain model.
 type Parser struct {
-	d2spath string
+	index index
+	realm realm
 }
 
 // Parse will parse the given character on disk into a character in our domain model.
 func (p Parser) Parse(name string) (*domain.Character, error) {
-	// Character path on disk.
-	file, err := os.Open(fmt.Sprintf("%s/%s", p.d2spath, name))
+	// Resolve the name case insensitively to the actual file on disk.
+	entry, ok := p.index.Lookup(name)
+	if !ok {
+		return nil, fmt.Errorf("character binary does not exist: %w", domain.ErrNotFound)
+	}
+
+	file, err := os.Open(p.index.Path(entry))
 	if err != nil {
 		return nil, fmt.Errorf("character binary does not exist: %w", domain.ErrNotFound)
 	}
@@ -32,17 +50,26 @@ func (p Parser) Parse(name string) (*domain.Character, error) {
 	}
 
 	character := domain.Character{
-		ID:         name,
+		ID:         entry.Name,
 		D2s:        d2schar,
 		LastParsed: time.Now(),
 	}
 
+	// The realm metadata is optional, the character is still served without it.
+	if p.realm != nil {
+		if info, err := p.realm.Info(entry.Name); err == nil {
+			character.Info = info
+		}
+	}
+
 	return &character, nil
 }
 
-// NewParser constructs a new parser with dependencies.
-func NewParser(d2spath string) *Parser {
+// NewParser constructs a new parser with dependencies, realm is
+// nil when there's no realm metadata available.
+func NewParser(index index, realm realm) *Parser {
 	return &Parser{
-		d2spath: d2spath,
+		index: index,
+		realm: realm,
 	}
 }
@@ -0,0 +1,126 @@
+package progress
+
+import (
+	"reflect"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+)
+
+// questState is implemented by the quests of d2s.
+type questState interface {
+	IsCompleted() bool
+	IsRequirementCompleted() bool
+}
+
+// questInfo locates a quest within the quests of a difficulty in d2s.
+type questInfo struct {
+	act   int
+	field string
+	name  string
+}
+
+// actFields are the fields of each act in the quests of d2s.
+var actFields = map[int]string{
+	1: "ActI",
+	2: "ActII",
+	3: "ActIII",
+	4: "ActIV",
+	5: "ActV",
+}
+
+// questList are all quests in the order they appear in game.
+var questList = []questInfo{
+	{act: 1, field: "DenOfEvil", name: "Den of Evil"},
+	{act: 1, field: "SistersBurialGrounds", name: "Sisters' Burial Grounds"},
+	{act: 1, field: "ToolsOfTheTrade", name: "Tools of the Trade"},
+	{act: 1, field: "TheSearchForCain", name: "The Search for Cain"},
+	{act: 1, field: "TheForgottenTower", name: "The Forgotten Tower"},
+	{act: 1, field: "SistersToTheSlaughter", name: "Sisters to the Slaughter"},
+	{act: 2, field: "RadamentsLair", name: "Radament's Lair"},
+	{act: 2, field: "TheHoradricStaff", name: "The Horadric Staff"},
+	{act: 2, field: "TaintedSun", name: "Tainted Sun"},
+	{act: 2, field: "ArcaneSanctuary", name: "Arcane Sanctuary"},
+	{act: 2, field: "TheSummoner", name: "The Summoner"},
+	{act: 2, field: "TheSevenTombs", name: "The Seven Tombs"},
+	{act: 3, field: "LamEsensTome", name: "Lam Esen's Tome"},
+	{act: 3, field: "KhalimsWill", name: "Khalim's Will"},
+	{act: 3, field: "BladeOfTheOldReligion", name: "Blade of the Old Religion"},
+	{act: 3, field: "TheGoldenBird", name: "The Golden Bird"},
+	{act: 3, field: "TheBlackenedTemple", name: "The Blackened Temple"},
+	{act: 3, field: "TheGuardian", name: "The Guardian"},
+	{act: 4, field: "TheFallenAngel", name: "The Fallen Angel"},
+	{act: 4, field: "TerrorsEnd", name: "Terror's End"},
+	{act: 4, field: "HellForge", name: "Hell's Forge"},
+	{act: 5, field: "SiegeOnHarrogath", name: "Siege on Harrogath"},
+	{act: 5, field: "RescueOnMountArreat", name: "Rescue on Mount Arreat"},
+	{act: 5, field: "PrisonOfIce", name: "Prison of Ice"},
+	{act: 5, field: "BetrayalOfHarrogath", name: "Betrayal of Harrogath"},
+	{act: 5, field: "RiteOfPassage", name: "Rite of Passage"},
+	{act: 5, field: "EveOfDestruction", name: "Eve of Destruction"},
+}
+
+// consumedScroll is the bit of the Prison of Ice quest set once Anya's scroll of resistance is read.
+const consumedScroll = 1 << 7
+
+// difficulty derives the progress of a single difficulty from the quests and waypoints of d2s.
+// The quest types of d2s are unexported, so they're read through reflection.
+func difficulty(quests interface{}, waypoints [24]byte, expansion bool) domain.DifficultyProgress {
+	v := reflect.ValueOf(quests)
+
+	d := domain.DifficultyProgress{
+		Quests:    make([]domain.QuestProgress, 0, len(questList)),
+		Waypoints: unlockedWaypoints(waypoints, expansion),
+	}
+
+	completed := make(map[string]bool, len(questList))
+	for _, q := range questList {
+		// Act V only exists in the expansion.
+		if q.act == 5 && !expansion {
+			continue
+		}
+
+		state := v.FieldByName(actFields[q.act]).FieldByName(q.field).Interface().(questState)
+		completed[q.field] = state.IsCompleted()
+
+		d.Quests = append(d.Quests, domain.QuestProgress{
+			Act:                  q.act,
+			Name:                 q.name,
+			Completed:            state.IsCompleted(),
+			RequirementCompleted: state.IsRequirementCompleted(),
+		})
+	}
+
+	d.Rewards = domain.QuestRewards{
+		DenOfEvil:     completed["DenOfEvil"],
+		RadamentsBook: completed["RadamentsLair"],
+		LamEsensTome:  completed["LamEsensTome"],
+		IzualsSoul:    completed["TheFallenAngel"],
+	}
+
+	if expansion {
+		prison := v.FieldByName("ActV").FieldByName("PrisonOfIce")
+		d.Rewards.AnyasScroll = prison.Index(0).Uint()&consumedScroll != 0
+	}
+
+	if !d.Rewards.DenOfEvil {
+		d.RemainingSkillPoints += denOfEvilSkillPoints
+	}
+
+	if !d.Rewards.RadamentsBook {
+		d.RemainingSkillPoints += radamentSkillPoints
+	}
+
+	if !d.Rewards.IzualsSoul {
+		d.RemainingSkillPoints += izualSkillPoints
+	}
+
+	if !d.Rewards.LamEsensTome {
+		d.RemainingStatPoints += lamEsenStatPoints
+	}
+
+	if expansion && !d.Rewards.AnyasScroll {
+		d.RemainingResistance += anyaResistance
+	}
+
+	return d
+}
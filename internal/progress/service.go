@@ -0,0 +1,69 @@
+package progress
+
+import (
+	"context"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+	"github.com/nokka/d2s"
+)
+
+//go:generate moq -out ./service_mocks.go . characterService
+
+// characterService is the interface representation of the characters
+// the service derive progress from.
+type characterService interface {
+	Parse(ctx context.Context, name string) (*domain.Character, error)
+}
+
+// Points granted by quest rewards.
+const (
+	denOfEvilSkillPoints = 1
+	radamentSkillPoints  = 1
+	izualSkillPoints     = 2
+	lamEsenStatPoints    = 5
+	anyaResistance       = 10
+)
+
+// Service derives quest and waypoint progression from characters.
+type Service struct {
+	characterService characterService
+}
+
+// Get will get the progress of the character.
+func (s Service) Get(ctx context.Context, name string) (*domain.Progress, error) {
+	character, err := s.characterService.Parse(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return Of(character.D2s.Header), nil
+}
+
+// Of derives the progress from the character header.
+func Of(h d2s.Header) *domain.Progress {
+	status := h.Status.Readable()
+
+	p := &domain.Progress{
+		Normal:    difficulty(h.QuestsNormal, h.WaypointsNormal, status.Expansion),
+		Nightmare: difficulty(h.QuestsNm, h.WaypointsNm, status.Expansion),
+		Hell:      difficulty(h.QuestsHell, h.WaypointsHell, status.Expansion),
+	}
+
+	for _, d := range []domain.DifficultyProgress{p.Normal, p.Nightmare, p.Hell} {
+		p.RemainingSkillPoints += d.RemainingSkillPoints
+		p.RemainingStatPoints += d.RemainingStatPoints
+		p.RemainingResistance += d.RemainingResistance
+	}
+
+	p.CompletedDifficulties = completedDifficulties(byte(h.Progression), status.Expansion)
+	p.Title = title(p.CompletedDifficulties, h.Class.String(), status.Expansion, status.Hardcore)
+
+	return p
+}
+
+// NewService constructs a new progress service with all the dependencies.
+func NewService(characterService characterService) *Service {
+	return &Service{
+		characterService: characterService,
+	}
+}
@@ -0,0 +1,104 @@
+package progress
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+	"github.com/nokka/d2s"
+)
+
+// Status flags of the d2s header.
+const (
+	statusHardcore  = 1 << 2
+	statusExpansion = 1 << 5
+)
+
+func TestGetProgress(t *testing.T) {
+	// Expansion sorceress that has completed normal and nightmare, and
+	// claimed everything in normal except for Anya's scroll.
+	var header d2s.Header
+	header.Status = statusExpansion
+	header.Class = d2s.Sorceress
+	header.Progression = 10
+	header.QuestsNormal.ActI.DenOfEvil[0] = 1
+	header.QuestsNormal.ActII.RadamentsLair[0] = 1
+	header.QuestsNormal.ActIII.LamEsensTome[0] = 1
+	header.QuestsNormal.ActIV.TheFallenAngel[0] = 1
+	header.QuestsNormal.ActV.PrisonOfIce[0] = 0x03
+	header.QuestsNm.ActV.PrisonOfIce[0] = 0x81
+	header.WaypointsNormal[2] = 0x03
+	header.WaypointsNormal[6] = 0x10
+
+	characterService := &characterServiceMock{
+		ParseFunc: func(ctx context.Context, name string) (*domain.Character, error) {
+			return &domain.Character{ID: name, D2s: &d2s.Character{Header: header}}, nil
+		},
+	}
+
+	p, err := NewService(characterService).Get(context.Background(), "nokka")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if p.Title != "Champion" || p.CompletedDifficulties != 2 {
+		t.Errorf("expected Champion with 2 completed difficulties, got %s with %d", p.Title, p.CompletedDifficulties)
+	}
+
+	// Normal has everything but Anya, nightmare only Anya, hell nothing.
+	if p.RemainingSkillPoints != 8 || p.RemainingStatPoints != 10 || p.RemainingResistance != 20 {
+		t.Errorf("unexpected remaining rewards %d skill points, %d stat points, %d resistance",
+			p.RemainingSkillPoints, p.RemainingStatPoints, p.RemainingResistance)
+	}
+
+	if !p.Normal.Rewards.DenOfEvil || p.Normal.Rewards.AnyasScroll || !p.Nightmare.Rewards.AnyasScroll {
+		t.Errorf("unexpected rewards %+v, %+v", p.Normal.Rewards, p.Nightmare.Rewards)
+	}
+
+	if len(p.Normal.Quests) != 27 || len(p.Normal.Waypoints) != 39 {
+		t.Fatalf("expected 27 quests and 39 waypoints, got %d and %d", len(p.Normal.Quests), len(p.Normal.Waypoints))
+	}
+
+	unlocked := make([]string, 0)
+	for _, wp := range p.Normal.Waypoints {
+		if wp.Unlocked {
+			unlocked = append(unlocked, wp.Name)
+		}
+	}
+
+	expected := []string{"Rogue Encampment", "Cold Plains", "Frozen Tundra"}
+	if len(unlocked) != len(expected) {
+		t.Fatalf("expected waypoints %v, got %v", expected, unlocked)
+	}
+
+	for i := range expected {
+		if unlocked[i] != expected[i] {
+			t.Errorf("expected waypoints %v, got %v", expected, unlocked)
+		}
+	}
+}
+
+func TestTitle(t *testing.T) {
+	tests := []struct {
+		progression byte
+		class       string
+		expansion   bool
+		hardcore    bool
+		expected    string
+	}{
+		{progression: 3, class: "Paladin", expected: ""},
+		{progression: 4, class: "Paladin", expected: "Sir"},
+		{progression: 8, class: "Amazon", expected: "Lady"},
+		{progression: 12, class: "Sorceress", hardcore: true, expected: "Queen"},
+		{progression: 4, class: "Druid", expansion: true, expected: ""},
+		{progression: 15, class: "Assassin", expansion: true, expected: "Matriarch"},
+		{progression: 10, class: "Barbarian", expansion: true, hardcore: true, expected: "Conqueror"},
+	}
+
+	for _, tt := range tests {
+		completed := completedDifficulties(tt.progression, tt.expansion)
+		if got := title(completed, tt.class, tt.expansion, tt.hardcore); got != tt.expected {
+			t.Errorf("expected title %q for progression %d, got %q", tt.expected, tt.progression, got)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package progress
+
+// Titles for each number of completed difficulties, the first is male and the second female.
+var (
+	classicTitles           = [][2]string{{"Sir", "Dame"}, {"Lord", "Lady"}, {"Baron", "Baroness"}}
+	classicHardcoreTitles   = [][2]string{{"Count", "Countess"}, {"Duke", "Duchess"}, {"King", "Queen"}}
+	expansionTitles         = [][2]string{{"Slayer", "Slayer"}, {"Champion", "Champion"}, {"Patriarch", "Matriarch"}}
+	expansionHardcoreTitles = [][2]string{{"Destroyer", "Destroyer"}, {"Conqueror", "Conqueror"}, {"Guardian", "Guardian"}}
+)
+
+// femaleClasses are the classes using the female titles.
+var femaleClasses = map[string]struct{}{
+	"Amazon":    {},
+	"Sorceress": {},
+	"Assassin":  {},
+}
+
+// completedDifficulties returns the number of completed difficulties from the
+// progression of the character, classic has 4 acts per difficulty and expansion 5.
+func completedDifficulties(progression byte, expansion bool) int {
+	acts := byte(4)
+	if expansion {
+		acts = 5
+	}
+
+	completed := int(progression / acts)
+	if completed > 3 {
+		completed = 3
+	}
+
+	return completed
+}
+
+// title returns the title the character has earned by completing difficulties.
+func title(completed int, class string, expansion bool, hardcore bool) string {
+	if completed == 0 {
+		return ""
+	}
+
+	titles := classicTitles
+	switch {
+	case expansion && hardcore:
+		titles = expansionHardcoreTitles
+	case expansion:
+		titles = expansionTitles
+	case hardcore:
+		titles = classicHardcoreTitles
+	}
+
+	gender := 0
+	if _, ok := femaleClasses[class]; ok {
+		gender = 1
+	}
+
+	return titles[completed-1][gender]
+}
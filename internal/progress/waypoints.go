@@ -0,0 +1,67 @@
+package progress
+
+import "github.com/nokka/d2-armory-api/internal/domain"
+
+// waypointOffset is the offset of the waypoint bitfield within the waypoints of a difficulty,
+// following a 2 byte header.
+const waypointOffset = 2
+
+// waypointList are all waypoints in the order of the bitfield.
+var waypointList = []domain.Waypoint{
+	{Act: 1, Name: "Rogue Encampment"},
+	{Act: 1, Name: "Cold Plains"},
+	{Act: 1, Name: "Stony Field"},
+	{Act: 1, Name: "Dark Wood"},
+	{Act: 1, Name: "Black Marsh"},
+	{Act: 1, Name: "Outer Cloister"},
+	{Act: 1, Name: "Jail Level 1"},
+	{Act: 1, Name: "Inner Cloister"},
+	{Act: 1, Name: "Catacombs Level 2"},
+	{Act: 2, Name: "Lut Gholein"},
+	{Act: 2, Name: "Sewers Level 2"},
+	{Act: 2, Name: "Dry Hills"},
+	{Act: 2, Name: "Halls of the Dead Level 2"},
+	{Act: 2, Name: "Far Oasis"},
+	{Act: 2, Name: "Lost City"},
+	{Act: 2, Name: "Palace Cellar Level 1"},
+	{Act: 2, Name: "Arcane Sanctuary"},
+	{Act: 2, Name: "Canyon of the Magi"},
+	{Act: 3, Name: "Kurast Docks"},
+	{Act: 3, Name: "Spider Forest"},
+	{Act: 3, Name: "Great Marsh"},
+	{Act: 3, Name: "Flayer Jungle"},
+	{Act: 3, Name: "Lower Kurast"},
+	{Act: 3, Name: "Kurast Bazaar"},
+	{Act: 3, Name: "Upper Kurast"},
+	{Act: 3, Name: "Travincal"},
+	{Act: 3, Name: "Durance of Hate Level 2"},
+	{Act: 4, Name: "The Pandemonium Fortress"},
+	{Act: 4, Name: "City of the Damned"},
+	{Act: 4, Name: "River of Flame"},
+	{Act: 5, Name: "Harrogath"},
+	{Act: 5, Name: "Frigid Highlands"},
+	{Act: 5, Name: "Arreat Plateau"},
+	{Act: 5, Name: "Crystalline Passage"},
+	{Act: 5, Name: "Halls of Pain"},
+	{Act: 5, Name: "Glacial Trail"},
+	{Act: 5, Name: "Frozen Tundra"},
+	{Act: 5, Name: "The Ancients' Way"},
+	{Act: 5, Name: "Worldstone Keep Level 2"},
+}
+
+// unlockedWaypoints returns every waypoint of the difficulty and whether it's unlocked.
+func unlockedWaypoints(waypoints [24]byte, expansion bool) []domain.Waypoint {
+	list := make([]domain.Waypoint, 0, len(waypointList))
+	for i, wp := range waypointList {
+		// Act V only exists in the expansion.
+		if wp.Act == 5 && !expansion {
+			continue
+		}
+
+		b := waypoints[waypointOffset+i/8]
+		wp.Unlocked = (b>>(uint(i)%8))&1 > 0
+		list = append(list, wp)
+	}
+
+	return list
+}
@@ -0,0 +1,118 @@
+// Package pvpgn reads the files PvPGN/d2cs keeps about realm characters.
+package pvpgn
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// charInfoMagic is the magic word every charinfo file starts with.
+const charInfoMagic = 0x12345678
+
+// nonLadder is the ladder byte of the portrait for characters not on the ladder.
+const nonLadder = 0xFF
+
+// ErrInvalidCharInfo is returned when the file isn't a charinfo file.
+var ErrInvalidCharInfo = errors.New("invalid charinfo file")
+
+// charInfoHeader is the header of the file, t_d2charinfo_header in d2cs.
+type charInfoHeader struct {
+	Magic         uint32
+	Version       uint32
+	CreateTime    uint32
+	LastTime      uint32
+	Checksum      uint32
+	TotalPlayTime uint32
+	Reserved      [6]uint32
+	CharName      [16]byte
+	Account       [16]byte
+	RealmName     [32]byte
+}
+
+// charInfoPortrait is the portrait shown in character selection, t_d2charinfo_portrait in d2cs.
+type charInfoPortrait struct {
+	Header uint16
+	Gfx    [11]byte
+	Class  byte
+	Color  [11]byte
+	Level  byte
+	Status byte
+	U1     [3]byte
+	Ladder byte
+	U2     [2]byte
+	End    byte
+}
+
+// charInfoSummary is the summary following the portrait, t_d2charinfo_summary in d2cs.
+type charInfoSummary struct {
+	Experience uint32
+	Status     uint32
+	Level      uint32
+	Class      uint32
+}
+
+// CharInfo is the metadata d2cs keeps about a character.
+type CharInfo struct {
+	Name       string
+	Account    string
+	Realm      string
+	Created    time.Time
+	LastLogin  time.Time
+	PlayTime   time.Duration
+	Ladder     bool
+	Experience uint32
+	Level      uint32
+}
+
+// ParseCharInfo parses a charinfo file.
+func ParseCharInfo(r io.Reader) (*CharInfo, error) {
+	var header charInfoHeader
+	if err := binary.Read(r, binary.LittleEndian, &header); err != nil {
+		return nil, fmt.Errorf("failed to read charinfo header: %w", err)
+	}
+
+	if header.Magic != charInfoMagic {
+		return nil, ErrInvalidCharInfo
+	}
+
+	var portrait charInfoPortrait
+	if err := binary.Read(r, binary.LittleEndian, &portrait); err != nil {
+		return nil, fmt.Errorf("failed to read charinfo portrait: %w", err)
+	}
+
+	// The summary is preceded by a single byte of padding.
+	var pad [1]byte
+	if _, err := io.ReadFull(r, pad[:]); err != nil {
+		return nil, fmt.Errorf("failed to read charinfo summary: %w", err)
+	}
+
+	var summary charInfoSummary
+	if err := binary.Read(r, binary.LittleEndian, &summary); err != nil {
+		return nil, fmt.Errorf("failed to read charinfo summary: %w", err)
+	}
+
+	return &CharInfo{
+		Name:       cString(header.CharName[:]),
+		Account:    cString(header.Account[:]),
+		Realm:      cString(header.RealmName[:]),
+		Created:    time.Unix(int64(header.CreateTime), 0).UTC(),
+		LastLogin:  time.Unix(int64(header.LastTime), 0).UTC(),
+		PlayTime:   time.Duration(header.TotalPlayTime) * time.Second,
+		Ladder:     portrait.Ladder != nonLadder && portrait.Ladder != 0,
+		Experience: summary.Experience,
+		Level:      summary.Level,
+	}, nil
+}
+
+// cString returns the null terminated string in b.
+func cString(b []byte) string {
+	if i := bytes.IndexByte(b, 0); i >= 0 {
+		b = b[:i]
+	}
+
+	return string(b)
+}
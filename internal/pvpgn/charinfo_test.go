@@ -0,0 +1,176 @@
+package pvpgn
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+)
+
+// charInfoFile builds a charinfo file the way d2cs writes it.
+func charInfoFile(t *testing.T, name string, account string, ladder byte) []byte {
+	t.Helper()
+
+	header := charInfoHeader{
+		Magic:         charInfoMagic,
+		Version:       0x00010000,
+		CreateTime:    1609459200,
+		LastTime:      1612137600,
+		TotalPlayTime: 3600,
+	}
+	copy(header.CharName[:], name)
+	copy(header.Account[:], account)
+	copy(header.RealmName[:], "Slashdiablo")
+
+	portrait := charInfoPortrait{Header: 0x8084, Ladder: ladder, Level: 85}
+	summary := charInfoSummary{Experience: 1000, Level: 85, Class: 1}
+
+	var buf bytes.Buffer
+	for _, v := range []interface{}{header, portrait, byte(0), summary} {
+		if err := binary.Write(&buf, binary.LittleEndian, v); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return buf.Bytes()
+}
+
+func TestParseCharInfo(t *testing.T) {
+	tests := []struct {
+		name          string
+		data          []byte
+		expectedInfo  *CharInfo
+		expectedError bool
+	}{
+		{
+			name: "ladder character",
+			data: charInfoFile(t, "nokka", "Nokka", 1),
+			expectedInfo: &CharInfo{
+				Name:       "nokka",
+				Account:    "Nokka",
+				Realm:      "Slashdiablo",
+				Created:    time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				LastLogin:  time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC),
+				PlayTime:   time.Hour,
+				Ladder:     true,
+				Experience: 1000,
+				Level:      85,
+			},
+		},
+		{
+			name: "non ladder character",
+			data: charInfoFile(t, "nokka", "Nokka", nonLadder),
+			expectedInfo: &CharInfo{
+				Name:       "nokka",
+				Account:    "Nokka",
+				Realm:      "Slashdiablo",
+				Created:    time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				LastLogin:  time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC),
+				PlayTime:   time.Hour,
+				Experience: 1000,
+				Level:      85,
+			},
+		},
+		{
+			name:          "invalid magic",
+			data:          make([]byte, 163),
+			expectedError: true,
+		},
+		{
+			name:          "truncated",
+			data:          charInfoFile(t, "nokka", "Nokka", 1)[:120],
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, err := ParseCharInfo(bytes.NewReader(tt.data))
+			if tt.expectedError {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if *info != *tt.expectedInfo {
+				t.Errorf("expected %+v, got %+v", tt.expectedInfo, info)
+			}
+		})
+	}
+}
+
+func TestDirectory(t *testing.T) {
+	dir, err := ioutil.TempDir("", "charinfo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err := os.Mkdir(filepath.Join(dir, "nokka"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, c := range []string{"nokka", "sorc"} {
+		if err := ioutil.WriteFile(filepath.Join(dir, "nokka", c), charInfoFile(t, c, "Nokka", 1), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Account directories and charinfo files may differ in case from the names.
+	if err := os.Mkdir(filepath.Join(dir, "MeanSki"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(dir, "MeanSki", "Amazona"), charInfoFile(t, "Amazona", "MeanSki", 1), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	d := NewDirectory(dir)
+
+	info, err := d.Info("Sorc")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if info.Account != "nokka" || info.Realm != "Slashdiablo" || !info.Ladder {
+		t.Errorf("unexpected info %+v", info)
+	}
+
+	if _, err := d.Info("amazon"); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("expected not found, got %v", err)
+	}
+
+	characters := d.Characters("NOKKA")
+	if len(characters) != 2 || characters[0] != "nokka" || characters[1] != "sorc" {
+		t.Errorf("unexpected characters %v", characters)
+	}
+
+	info, err = d.Info("amazona")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if info.Account != "meanski" {
+		t.Errorf("unexpected info %+v", info)
+	}
+
+	characters = d.Characters("meanski")
+	if len(characters) != 1 || characters[0] != "amazona" {
+		t.Errorf("unexpected characters %v", characters)
+	}
+
+	if characters := d.Characters("unknown"); characters != nil {
+		t.Errorf("expected no characters, got %v", characters)
+	}
+}
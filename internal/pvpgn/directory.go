@@ -0,0 +1,191 @@
+package pvpgn
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+)
+
+// refreshInterval limits how often a missed lookup rescans the directory,
+// most characters missing from it are simply not created on the realm.
+const refreshInterval = 10 * time.Second
+
+// Directory reads the charinfo directory of d2cs, laid out as charinfo/<account>/<character>.
+type Directory struct {
+	path string
+
+	mu         sync.RWMutex
+	characters map[string]charInfoLocation
+	accounts   map[string]string
+	refreshed  time.Time
+}
+
+// charInfoLocation is where the charinfo of a character is, with the names on disk
+// since they may differ in case from the names of the account and character.
+type charInfoLocation struct {
+	account string
+	file    string
+}
+
+// Refresh rescans the directory for the account directories, and the account
+// each character belongs to.
+func (d *Directory) Refresh() error {
+	dirs, err := ioutil.ReadDir(d.path)
+	if err != nil {
+		return err
+	}
+
+	characters := make(map[string]charInfoLocation)
+	accounts := make(map[string]string)
+	for _, dir := range dirs {
+		if !dir.IsDir() || strings.HasPrefix(dir.Name(), ".") {
+			continue
+		}
+
+		accounts[domain.NormalizeName(dir.Name())] = dir.Name()
+
+		files, err := ioutil.ReadDir(filepath.Join(d.path, dir.Name()))
+		if err != nil {
+			return err
+		}
+
+		for _, f := range files {
+			if f.IsDir() || strings.HasPrefix(f.Name(), ".") {
+				continue
+			}
+
+			characters[domain.NormalizeName(f.Name())] = charInfoLocation{account: dir.Name(), file: f.Name()}
+		}
+	}
+
+	d.mu.Lock()
+	d.characters = characters
+	d.accounts = accounts
+	d.refreshed = time.Now()
+	d.mu.Unlock()
+
+	return nil
+}
+
+// Info returns the realm metadata of the given character.
+func (d *Directory) Info(name string) (*domain.CharacterInfo, error) {
+	name = domain.NormalizeName(name)
+
+	loc, ok := d.character(name)
+	if !ok {
+		return nil, fmt.Errorf("charinfo of %s: %w", name, domain.ErrNotFound)
+	}
+
+	file, err := os.Open(filepath.Join(d.path, loc.account, loc.file))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("charinfo of %s: %w", name, domain.ErrNotFound)
+		}
+		return nil, err
+	}
+
+	// Close the file when we're done.
+	defer file.Close()
+
+	info, err := ParseCharInfo(file)
+	if err != nil {
+		return nil, fmt.Errorf("charinfo of %s: %w", name, err)
+	}
+
+	// The directory is authoritative, the account in the file keeps its original case.
+	return &domain.CharacterInfo{
+		Account:   domain.NormalizeName(loc.account),
+		Realm:     info.Realm,
+		Created:   info.Created,
+		LastLogin: info.LastLogin,
+		Ladder:    info.Ladder,
+	}, nil
+}
+
+// Characters returns the characters created on the given account.
+func (d *Directory) Characters(account string) []string {
+	dir, ok := d.account(domain.NormalizeName(account))
+	if !ok {
+		return nil
+	}
+
+	files, err := ioutil.ReadDir(filepath.Join(d.path, dir))
+	if err != nil {
+		return nil
+	}
+
+	characters := make([]string, 0, len(files))
+	for _, f := range files {
+		if f.IsDir() || strings.HasPrefix(f.Name(), ".") {
+			continue
+		}
+
+		characters = append(characters, domain.NormalizeName(f.Name()))
+	}
+
+	return characters
+}
+
+// character returns where the charinfo of the character is.
+func (d *Directory) character(name string) (charInfoLocation, bool) {
+	var loc charInfoLocation
+	ok := d.lookup(func() (ok bool) {
+		loc, ok = d.characters[name]
+		return ok
+	})
+
+	return loc, ok
+}
+
+// account returns the directory of the account.
+func (d *Directory) account(account string) (string, bool) {
+	var dir string
+	ok := d.lookup(func() (ok bool) {
+		dir, ok = d.accounts[account]
+		return ok
+	})
+
+	return dir, ok
+}
+
+// lookup runs find while holding the lock, rescanning the directory and running
+// it again if nothing was found and the directory hasn't been scanned lately.
+func (d *Directory) lookup(find func() bool) bool {
+	d.mu.RLock()
+	ok := find()
+	stale := time.Since(d.refreshed) > refreshInterval
+	d.mu.RUnlock()
+
+	if ok || !stale {
+		return ok
+	}
+
+	if err := d.Refresh(); err != nil {
+		return false
+	}
+
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	return find()
+}
+
+// NewDirectory returns a new charinfo directory at the given path.
+func NewDirectory(path string) *Directory {
+	return &Directory{
+		path:       path,
+		characters: make(map[string]charInfoLocation),
+		accounts:   make(map[string]string),
+	}
+}
+
+// CharInfoPath returns the charinfo directory d2cs keeps next to the given charsave directory.
+func CharInfoPath(charsavePath string) string {
+	return filepath.Join(filepath.Dir(filepath.Clean(charsavePath)), "charinfo")
+}
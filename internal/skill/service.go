@@ -0,0 +1,177 @@
+package skill
+
+import (
+	"context"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+	"github.com/nokka/d2-armory-api/internal/gear"
+	"github.com/nokka/d2s"
+)
+
+//go:generate moq -out ./service_mocks.go . characterService
+
+// characterService is the interface representation of the characters
+// the service derive skills from.
+type characterService interface {
+	Parse(ctx context.Context, name string) (*domain.Character, error)
+}
+
+// classMask is the part of the tab skill attribute holding the class.
+const classMask = 0x07
+
+// Service derives the skill allocation of characters.
+type Service struct {
+	characterService characterService
+}
+
+// Get will get the skill allocation of the character.
+func (s Service) Get(ctx context.Context, name string) (*domain.SkillTree, error) {
+	character, err := s.characterService.Parse(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return Allocation(character.D2s), nil
+}
+
+// Allocation derives the skill allocation of the character, applying the
+// skill bonuses of the equipped items and carried charms.
+func Allocation(c *d2s.Character) *domain.SkillTree {
+	class := int(c.Header.Class)
+
+	base := make(map[int]int, len(c.Skills))
+	for _, s := range c.Skills {
+		base[s.ID] = s.Points
+	}
+
+	attrs := gear.AllAttributes(gear.Active(c.Items, c.Header.ActiveArms))
+
+	tree := &domain.SkillTree{
+		Class:   gear.ClassName(class),
+		Tabs:    make([]domain.SkillTab, 0, 3),
+		OSkills: make([]domain.SkillLevel, 0),
+	}
+
+	// Bonuses that only apply to skills with base points.
+	tabBonus := make(map[int]int)
+	single := make(map[int]int)
+	oskills := make(map[int]int)
+	oskillOrder := make([]int, 0)
+
+	for _, a := range attrs {
+		switch a.ID {
+		case gear.AttrAllSkills:
+			tree.AllSkills += value(a, 0)
+		case gear.AttrClassSkills:
+			if value(a, 0) == class {
+				tree.ClassSkills += value(a, 1)
+			}
+		case gear.AttrSkillTab:
+			if value(a, 1)&classMask == class {
+				tabBonus[value(a, 0)] += value(a, 2)
+			}
+		case gear.AttrSingleSkill:
+			single[value(a, 0)] += value(a, 1)
+		case gear.AttrOSkill:
+			skill := value(a, 0)
+			if _, ok := oskills[skill]; !ok {
+				oskillOrder = append(oskillOrder, skill)
+			}
+			oskills[skill] += value(a, 1)
+		}
+	}
+
+	classSkills := make(map[int]struct{})
+	for i, skills := range trees[class] {
+		tab := domain.SkillTab{
+			Name:   gear.TabName(class, i),
+			Bonus:  tabBonus[i],
+			Skills: make([]domain.SkillLevel, 0, len(skills)),
+		}
+
+		for _, id := range skills {
+			classSkills[id] = struct{}{}
+
+			level := domain.SkillLevel{
+				ID:         id,
+				Name:       Name(id),
+				BasePoints: base[id],
+				Synergies:  synergiesOf(id, base),
+			}
+
+			if level.BasePoints > 0 {
+				level.BonusPoints += tree.AllSkills + tree.ClassSkills + tab.Bonus
+			}
+
+			// Single skill bonuses of the class and oskills apply regardless.
+			level.BonusPoints += single[id] + oskills[id]
+			level.Level = level.BasePoints + level.BonusPoints
+
+			tab.Skills = append(tab.Skills, level)
+		}
+
+		tree.Tabs = append(tree.Tabs, tab)
+	}
+
+	// Skills of other classes granted by items, all skills bonuses don't apply to them.
+	for _, id := range oskillOrder {
+		if _, ok := classSkills[id]; ok {
+			continue
+		}
+
+		tree.OSkills = append(tree.OSkills, domain.SkillLevel{
+			ID:          id,
+			Name:        Name(id),
+			BonusPoints: oskills[id],
+			Level:       oskills[id],
+		})
+	}
+
+	return tree
+}
+
+// Name returns the in game name of the skill.
+func Name(id int) string {
+	if name, ok := names[id]; ok {
+		return name
+	}
+
+	return gear.SkillName(id)
+}
+
+// synergiesOf returns the synergy bonuses of the skill from the base points of other skills.
+func synergiesOf(id int, base map[int]int) []domain.SynergyBonus {
+	list := synergies[id]
+	if len(list) == 0 {
+		return nil
+	}
+
+	bonuses := make([]domain.SynergyBonus, 0, len(list))
+	for _, s := range list {
+		bonuses = append(bonuses, domain.SynergyBonus{
+			SkillID: s.from,
+			Name:    Name(s.from),
+			Points:  base[s.from],
+			Bonus:   base[s.from] * s.bonus,
+			Effect:  s.effect,
+		})
+	}
+
+	return bonuses
+}
+
+// value returns the value of the attribute at i.
+func value(a gear.Attribute, i int) int {
+	if i >= len(a.Values) {
+		return 0
+	}
+
+	return int(a.Values[i])
+}
+
+// NewService constructs a new skill service with all the dependencies.
+func NewService(characterService characterService) *Service {
+	return &Service{
+		characterService: characterService,
+	}
+}
@@ -0,0 +1,96 @@
+package skill
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+	"github.com/nokka/d2-armory-api/internal/gear/geartest"
+	"github.com/nokka/d2s"
+)
+
+func TestGetSkills(t *testing.T) {
+	var header d2s.Header
+	header.Class = d2s.Sorceress
+
+	character := &d2s.Character{
+		Header: header,
+		Skills: []d2s.Skill{
+			{ID: 36, Points: 10},
+			{ID: 47, Points: 20},
+			{ID: 56, Points: 1},
+		},
+		Items: []d2s.Item{
+			// Helm with +2 to all skills.
+			geartest.Item(t, `{"location_id": 1, "equipped_id": 1, "type": "cap", "magic_attributes": [{"id": 127, "values": [2]}]}`),
+			// Amulet with +3 to sorceress skills and +2 to necromancer skills.
+			geartest.Item(t, `{"location_id": 1, "equipped_id": 2, "type": "amu", "magic_attributes": [{"id": 83, "values": [1, 3]}, {"id": 83, "values": [2, 2]}]}`),
+			// Ring with +3 to Battle Orders.
+			geartest.Item(t, `{"location_id": 1, "equipped_id": 6, "type": "rin", "magic_attributes": [{"id": 97, "values": [149, 3]}]}`),
+			// Weapon with +1 to Teleport, socketed with a jewel of +1 to fire skills.
+			geartest.Item(t, `{"location_id": 1, "equipped_id": 4, "type": "ob1", "magic_attributes": [{"id": 107, "values": [54, 1]}],
+				"socketed_items": [{"location_id": 6, "type": "jew", "magic_attributes": [{"id": 188, "values": [0, 1, 1]}]}]}`),
+			// Weapon swap with +5 to all skills, not active.
+			geartest.Item(t, `{"location_id": 1, "equipped_id": 11, "type": "ob1", "magic_attributes": [{"id": 127, "values": [5]}]}`),
+			// Grand charm with +1 to fire skills in the inventory.
+			geartest.Item(t, `{"location_id": 0, "alt_position_id": 1, "type": "cm3", "magic_attributes": [{"id": 188, "values": [0, 1, 1]}]}`),
+			// Grand charm with +1 to cold skills in the stash.
+			geartest.Item(t, `{"location_id": 0, "alt_position_id": 5, "type": "cm3", "magic_attributes": [{"id": 188, "values": [2, 1, 1]}]}`),
+		},
+	}
+
+	characterService := &characterServiceMock{
+		ParseFunc: func(ctx context.Context, name string) (*domain.Character, error) {
+			return &domain.Character{ID: name, D2s: character}, nil
+		},
+	}
+
+	tree, err := NewService(characterService).Get(context.Background(), "nokka")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if tree.Class != "Sorceress" || tree.AllSkills != 2 || tree.ClassSkills != 3 {
+		t.Errorf("unexpected bonuses %s +%d all skills, +%d class skills", tree.Class, tree.AllSkills, tree.ClassSkills)
+	}
+
+	if len(tree.Tabs) != 3 || tree.Tabs[0].Name != "Fire" || tree.Tabs[0].Bonus != 2 || tree.Tabs[2].Bonus != 0 {
+		t.Fatalf("unexpected tabs %+v", tree.Tabs)
+	}
+
+	levels := make(map[int]domain.SkillLevel)
+	for _, tab := range tree.Tabs {
+		for _, s := range tab.Skills {
+			levels[s.ID] = s
+		}
+	}
+
+	tests := []struct {
+		id       int
+		expected int
+	}{
+		// 20 base, +2 all, +3 class, +2 fire.
+		{id: 47, expected: 27},
+		{id: 56, expected: 8},
+		// Teleport without base points only gets the single skill bonus.
+		{id: 54, expected: 1},
+		// Nothing applies to skills without base points.
+		{id: 39, expected: 0},
+	}
+
+	for _, tt := range tests {
+		if levels[tt.id].Level != tt.expected {
+			t.Errorf("expected %s at level %d, got %d", Name(tt.id), tt.expected, levels[tt.id].Level)
+		}
+	}
+
+	// Fire Ball gets 14% per base point of Fire Bolt and Meteor.
+	fireBall := levels[47]
+	if len(fireBall.Synergies) != 2 || fireBall.Synergies[0].Bonus != 140 || fireBall.Synergies[1].Bonus != 14 {
+		t.Errorf("unexpected Fire Ball synergies %+v", fireBall.Synergies)
+	}
+
+	if len(tree.OSkills) != 1 || tree.OSkills[0].Name != "Battle Orders" || tree.OSkills[0].Level != 3 {
+		t.Errorf("unexpected oskills %+v", tree.OSkills)
+	}
+}
@@ -0,0 +1,261 @@
+package skill
+
+// trees are the skills of each tab of each class, keyed by the class number of d2s
+// with the tabs in the order items refer to them.
+var trees = map[int][][]int{
+	// Amazon.
+	0: {
+		{6, 7, 11, 12, 16, 21, 22, 26, 27, 31},   // Bow and Crossbow
+		{8, 9, 13, 17, 18, 23, 28, 29, 32, 33},   // Passive and Magic
+		{10, 14, 15, 19, 20, 24, 25, 30, 34, 35}, // Javelin and Spear
+	},
+	// Sorceress.
+	1: {
+		{36, 37, 41, 46, 47, 51, 52, 56, 61, 62}, // Fire
+		{38, 42, 43, 48, 49, 53, 54, 57, 58, 63}, // Lightning
+		{39, 40, 44, 45, 50, 55, 59, 60, 64, 65}, // Cold
+	},
+	// Necromancer.
+	2: {
+		{66, 71, 72, 76, 77, 81, 82, 86, 87, 91}, // Curses
+		{67, 68, 73, 74, 78, 83, 84, 88, 92, 93}, // Poison and Bone
+		{69, 70, 75, 79, 80, 85, 89, 90, 94, 95}, // Summoning
+	},
+	// Paladin.
+	3: {
+		{96, 97, 101, 106, 107, 111, 112, 116, 117, 121},  // Combat
+		{98, 102, 103, 108, 113, 114, 118, 119, 122, 123}, // Offensive Auras
+		{99, 100, 104, 105, 109, 110, 115, 120, 124, 125}, // Defensive Auras
+	},
+	// Barbarian.
+	4: {
+		{126, 132, 133, 139, 140, 143, 144, 147, 151, 152}, // Combat
+		{127, 128, 129, 134, 135, 136, 141, 145, 148, 153}, // Combat Masteries
+		{130, 131, 137, 138, 142, 146, 149, 150, 154, 155}, // Warcries
+	},
+	// Druid.
+	5: {
+		{221, 222, 226, 227, 231, 236, 237, 241, 246, 247}, // Summoning
+		{223, 224, 228, 232, 233, 238, 239, 242, 243, 248}, // Shape Shifting
+		{225, 229, 230, 234, 235, 240, 244, 245, 249, 250}, // Elemental
+	},
+	// Assassin.
+	6: {
+		{251, 256, 257, 261, 262, 266, 271, 272, 276, 277}, // Traps
+		{252, 253, 258, 263, 264, 267, 268, 273, 278, 279}, // Shadow Disciplines
+		{254, 255, 259, 260, 265, 269, 270, 274, 275, 280}, // Martial Arts
+	},
+}
+
+// names are the in game names of skills the d2s skill table
+// only knows by their internal names.
+var names = map[int]string{
+	28:  "Decoy",
+	127: "Sword Mastery",
+	128: "Axe Mastery",
+	129: "Mace Mastery",
+	223: "Werewolf",
+	224: "Lycanthropy",
+	227: "Summon Spirit Wolf",
+	228: "Werebear",
+	231: "Carrion Vine",
+	237: "Summon Dire Wolf",
+	241: "Solar Creeper",
+	234: "Fissure",
+	256: "Shock Web",
+	258: "Burst of Speed",
+	272: "Wake of Inferno",
+	280: "Phoenix Strike",
+}
+
+// synergy is a bonus to a skill for every base point in another skill.
+type synergy struct {
+	from   int
+	bonus  int
+	effect string
+}
+
+// synergies of the skills, keyed by the skill receiving the bonus.
+var synergies = map[int][]synergy{
+	// Amazon.
+	15: {{from: 25, bonus: 12, effect: "% poison damage"}},
+	21: {{from: 11, bonus: 8, effect: "% cold damage"}},
+	24: {
+		{from: 14, bonus: 10, effect: "% lightning damage"},
+		{from: 20, bonus: 10, effect: "% lightning damage"},
+		{from: 34, bonus: 10, effect: "% lightning damage"},
+		{from: 35, bonus: 10, effect: "% lightning damage"},
+	},
+	25: {{from: 15, bonus: 10, effect: "% poison damage"}},
+	31: {{from: 11, bonus: 12, effect: "% cold damage"}},
+	34: {
+		{from: 14, bonus: 8, effect: "% lightning damage"},
+		{from: 20, bonus: 8, effect: "% lightning damage"},
+		{from: 24, bonus: 8, effect: "% lightning damage"},
+		{from: 35, bonus: 8, effect: "% lightning damage"},
+	},
+	35: {
+		{from: 14, bonus: 1, effect: "% lightning damage"},
+		{from: 20, bonus: 1, effect: "% lightning damage"},
+		{from: 24, bonus: 1, effect: "% lightning damage"},
+		{from: 34, bonus: 1, effect: "% lightning damage"},
+	},
+
+	// Sorceress.
+	36: {
+		{from: 47, bonus: 16, effect: "% fire damage"},
+		{from: 56, bonus: 16, effect: "% fire damage"},
+	},
+	39: {
+		{from: 45, bonus: 15, effect: "% cold damage"},
+		{from: 55, bonus: 15, effect: "% cold damage"},
+		{from: 59, bonus: 15, effect: "% cold damage"},
+		{from: 64, bonus: 15, effect: "% cold damage"},
+	},
+	45: {{from: 39, bonus: 8, effect: "% cold damage"}},
+	47: {
+		{from: 36, bonus: 14, effect: "% fire damage"},
+		{from: 56, bonus: 14, effect: "% fire damage"},
+	},
+	49: {
+		{from: 38, bonus: 8, effect: "% lightning damage"},
+		{from: 48, bonus: 8, effect: "% lightning damage"},
+		{from: 53, bonus: 8, effect: "% lightning damage"},
+	},
+	52: {{from: 37, bonus: 9, effect: "% fire damage"}},
+	53: {
+		{from: 38, bonus: 4, effect: "% lightning damage"},
+		{from: 48, bonus: 4, effect: "% lightning damage"},
+		{from: 49, bonus: 4, effect: "% lightning damage"},
+	},
+	55: {
+		{from: 39, bonus: 5, effect: "% cold damage"},
+		{from: 45, bonus: 5, effect: "% cold damage"},
+	},
+	56: {
+		{from: 36, bonus: 5, effect: "% fire damage"},
+		{from: 47, bonus: 5, effect: "% fire damage"},
+	},
+	59: {
+		{from: 39, bonus: 5, effect: "% cold damage"},
+		{from: 45, bonus: 5, effect: "% cold damage"},
+		{from: 55, bonus: 5, effect: "% cold damage"},
+	},
+	62: {
+		{from: 36, bonus: 3, effect: "% fire damage"},
+		{from: 47, bonus: 3, effect: "% fire damage"},
+	},
+	64: {{from: 39, bonus: 2, effect: "% cold damage"}},
+
+	// Necromancer.
+	67: {
+		{from: 68, bonus: 15, effect: "% magic damage"},
+		{from: 78, bonus: 15, effect: "% magic damage"},
+		{from: 84, bonus: 15, effect: "% magic damage"},
+		{from: 88, bonus: 15, effect: "% magic damage"},
+		{from: 93, bonus: 15, effect: "% magic damage"},
+	},
+	73: {
+		{from: 83, bonus: 20, effect: "% poison damage"},
+		{from: 92, bonus: 20, effect: "% poison damage"},
+	},
+	83: {
+		{from: 73, bonus: 12, effect: "% poison damage"},
+		{from: 92, bonus: 12, effect: "% poison damage"},
+	},
+	84: {
+		{from: 67, bonus: 7, effect: "% magic damage"},
+		{from: 68, bonus: 7, effect: "% magic damage"},
+		{from: 78, bonus: 7, effect: "% magic damage"},
+		{from: 88, bonus: 7, effect: "% magic damage"},
+		{from: 93, bonus: 7, effect: "% magic damage"},
+	},
+	92: {
+		{from: 73, bonus: 10, effect: "% poison damage"},
+		{from: 83, bonus: 10, effect: "% poison damage"},
+	},
+	93: {
+		{from: 67, bonus: 6, effect: "% magic damage"},
+		{from: 68, bonus: 6, effect: "% magic damage"},
+		{from: 78, bonus: 6, effect: "% magic damage"},
+		{from: 84, bonus: 6, effect: "% magic damage"},
+		{from: 88, bonus: 6, effect: "% magic damage"},
+	},
+
+	// Paladin.
+	101: {{from: 112, bonus: 50, effect: "% magic damage"}},
+	102: {
+		{from: 100, bonus: 18, effect: "% fire damage"},
+		{from: 125, bonus: 6, effect: "% fire damage"},
+	},
+	112: {
+		{from: 108, bonus: 14, effect: "% magic damage"},
+		{from: 115, bonus: 14, effect: "% magic damage"},
+	},
+	114: {
+		{from: 105, bonus: 15, effect: "% cold damage"},
+		{from: 125, bonus: 7, effect: "% cold damage"},
+	},
+	117: {{from: 104, bonus: 15, effect: "% defense"}},
+	118: {
+		{from: 110, bonus: 12, effect: "% lightning damage"},
+		{from: 125, bonus: 4, effect: "% lightning damage"},
+	},
+	121: {
+		{from: 101, bonus: 15, effect: "% holy bolt damage"},
+		{from: 118, bonus: 7, effect: "% lightning damage"},
+	},
+
+	// Barbarian.
+	138: {
+		{from: 149, bonus: 5, effect: " seconds duration"},
+		{from: 155, bonus: 5, effect: " seconds duration"},
+	},
+	143: {{from: 132, bonus: 10, effect: "% damage"}},
+	149: {
+		{from: 138, bonus: 5, effect: " seconds duration"},
+		{from: 155, bonus: 5, effect: " seconds duration"},
+	},
+	154: {
+		{from: 130, bonus: 18, effect: "% damage"},
+		{from: 137, bonus: 18, effect: "% damage"},
+		{from: 146, bonus: 18, effect: "% damage"},
+	},
+
+	// Druid.
+	225: {
+		{from: 229, bonus: 23, effect: "% fire damage"},
+		{from: 234, bonus: 23, effect: "% fire damage"},
+	},
+	238: {{from: 222, bonus: 18, effect: "% poison damage"}},
+	240: {
+		{from: 235, bonus: 10, effect: "% damage"},
+		{from: 245, bonus: 10, effect: "% damage"},
+		{from: 250, bonus: 10, effect: "% damage"},
+	},
+	245: {
+		{from: 235, bonus: 9, effect: "% damage"},
+		{from: 240, bonus: 9, effect: "% damage"},
+		{from: 250, bonus: 9, effect: "% damage"},
+	},
+	250: {
+		{from: 235, bonus: 5, effect: "% cold damage"},
+		{from: 240, bonus: 5, effect: "% cold damage"},
+		{from: 245, bonus: 5, effect: "% cold damage"},
+	},
+
+	// Assassin.
+	262: {
+		{from: 251, bonus: 8, effect: "% fire damage"},
+		{from: 272, bonus: 8, effect: "% fire damage"},
+	},
+	271: {
+		{from: 256, bonus: 12, effect: "% lightning damage"},
+		{from: 261, bonus: 12, effect: "% lightning damage"},
+		{from: 276, bonus: 12, effect: "% lightning damage"},
+	},
+	272: {
+		{from: 251, bonus: 10, effect: "% fire damage"},
+		{from: 262, bonus: 10, effect: "% fire damage"},
+	},
+}
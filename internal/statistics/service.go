@@ -4,8 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log"
 	"sort"
-	"strings"
 
 	"github.com/nokka/d2-armory-api/internal/domain"
 )
@@ -16,7 +16,7 @@ var validDifficulties = map[string]struct{}{
 	domain.DifficultyHell:      {},
 }
 
-//go:generate moq -out ./service_mocks.go . statisticsRepository
+//go:generate moq -out ./service_mocks.go . statisticsRepository Listener
 
 // Max data points is used to limit number of data points being returned
 // since areas for example can host 138 entries.
@@ -30,14 +30,22 @@ type statisticsRepository interface {
 	Delete(ctx context.Context, character string) error
 }
 
+// Listener is notified whenever statistics have been submitted for a character,
+// previous are the statistics before they were added, nil if there were none.
+// Listeners are best effort, errors are logged and never fail the submission.
+type Listener interface {
+	StatisticsSubmitted(ctx context.Context, previous *domain.CharacterStatistics, submitted domain.StatisticsRequest) error
+}
+
 // Service performs all operations on statistics.
 type Service struct {
 	repository statisticsRepository
+	listeners  []Listener
 }
 
 // GetCharacter will get the statistics on a specific character.
 func (s Service) GetCharacter(ctx context.Context, character string) (*domain.CharacterStatistics, error) {
-	char, err := s.repository.GetByCharacter(ctx, character)
+	char, err := s.repository.GetByCharacter(ctx, domain.NormalizeName(character))
 	if err != nil {
 		return nil, err
 	}
@@ -150,14 +158,32 @@ func (s Service) Parse(ctx context.Context, stats []domain.StatisticsRequest) er
 		}
 
 		// Lower case the character name to keep consistency.
-		req.Account = strings.ToLower(req.Account)
-		req.Character = strings.ToLower(req.Character)
+		req.Account = domain.NormalizeName(req.Account)
+		req.Character = domain.NormalizeName(req.Character)
+
+		// The listeners are told what the statistics were before they're added to.
+		var previous *domain.CharacterStatistics
+		if len(s.listeners) > 0 {
+			var err error
+			previous, err = s.repository.GetByCharacter(ctx, req.Character)
+			if err != nil && !errors.Is(err, domain.ErrNotFound) {
+				return err
+			}
+		}
 
 		// Upsert each character stat request.
 		err := s.repository.Upsert(ctx, req)
 		if err != nil {
 			return err
 		}
+
+		// The kills are added to what's stored, so the submission can't safely be
+		// retried once it's been upserted and a failing listener is only logged.
+		for _, l := range s.listeners {
+			if err := l.StatisticsSubmitted(ctx, previous, req); err != nil {
+				log.Printf("failed to notify listener of statistics for %s: %s", req.Character, err)
+			}
+		}
 	}
 
 	return nil
@@ -166,14 +192,16 @@ func (s Service) Parse(ctx context.Context, stats []domain.StatisticsRequest) er
 // DeleteStats will delete the statistics for the given character.
 func (s Service) DeleteStats(ctx context.Context, character string) error {
 	if len(character) < 2 {
-		return errors.New("character name needs a a length of at least 2")
+		return fmt.Errorf("character name needs a length of at least 2: %w", domain.ErrInvalidArgument)
 	}
-	return s.repository.Delete(ctx, strings.ToLower(character))
+	return s.repository.Delete(ctx, domain.NormalizeName(character))
 }
 
-// NewService constructs a new statistics service with all the dependencies.
-func NewService(repository statisticsRepository) *Service {
+// NewService constructs a new statistics service with all the dependencies,
+// the listeners are notified of every statistics submitted.
+func NewService(repository statisticsRepository, listeners ...Listener) *Service {
 	return &Service{
 		repository: repository,
+		listeners:  listeners,
 	}
 }
@@ -177,3 +177,80 @@ func (mock *statisticsRepositoryMock) UpsertCalls() []struct {
 	mock.lockUpsert.RUnlock()
 	return calls
 }
+
+// Ensure, that ListenerMock does implement Listener.
+// If this is not the case, regenerate this file with moq.
+var _ Listener = &ListenerMock{}
+
+// ListenerMock is a mock implementation of Listener.
+//
+// 	func TestSomethingThatUsesListener(t *testing.T) {
+//
+// 		// make and configure a mocked Listener
+// 		mockedListener := &ListenerMock{
+// 			StatisticsSubmittedFunc: func(ctx context.Context, previous *domain.CharacterStatistics, submitted domain.StatisticsRequest) error {
+// 				panic("mock out the StatisticsSubmitted method")
+// 			},
+// 		}
+//
+// 		// use mockedListener in code that requires Listener
+// 		// and then make assertions.
+//
+// 	}
+type ListenerMock struct {
+	// StatisticsSubmittedFunc mocks the StatisticsSubmitted method.
+	StatisticsSubmittedFunc func(ctx context.Context, previous *domain.CharacterStatistics, submitted domain.StatisticsRequest) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// StatisticsSubmitted holds details about calls to the StatisticsSubmitted method.
+		StatisticsSubmitted []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Previous is the previous argument value.
+			Previous *domain.CharacterStatistics
+			// Submitted is the submitted argument value.
+			Submitted domain.StatisticsRequest
+		}
+	}
+	lockStatisticsSubmitted sync.RWMutex
+}
+
+// StatisticsSubmitted calls StatisticsSubmittedFunc.
+func (mock *ListenerMock) StatisticsSubmitted(ctx context.Context, previous *domain.CharacterStatistics, submitted domain.StatisticsRequest) error {
+	if mock.StatisticsSubmittedFunc == nil {
+		panic("ListenerMock.StatisticsSubmittedFunc: method is nil but Listener.StatisticsSubmitted was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Previous  *domain.CharacterStatistics
+		Submitted domain.StatisticsRequest
+	}{
+		Ctx:       ctx,
+		Previous:  previous,
+		Submitted: submitted,
+	}
+	mock.lockStatisticsSubmitted.Lock()
+	mock.calls.StatisticsSubmitted = append(mock.calls.StatisticsSubmitted, callInfo)
+	mock.lockStatisticsSubmitted.Unlock()
+	return mock.StatisticsSubmittedFunc(ctx, previous, submitted)
+}
+
+// StatisticsSubmittedCalls gets all the calls that were made to StatisticsSubmitted.
+// Check the length with:
+//     len(mockedListener.StatisticsSubmittedCalls())
+func (mock *ListenerMock) StatisticsSubmittedCalls() []struct {
+	Ctx       context.Context
+	Previous  *domain.CharacterStatistics
+	Submitted domain.StatisticsRequest
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Previous  *domain.CharacterStatistics
+		Submitted domain.StatisticsRequest
+	}
+	mock.lockStatisticsSubmitted.RLock()
+	calls = mock.calls.StatisticsSubmitted
+	mock.lockStatisticsSubmitted.RUnlock()
+	return calls
+}
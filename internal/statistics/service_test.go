@@ -3,6 +3,7 @@ package statistics
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/nokka/d2-armory-api/internal/domain"
@@ -106,6 +107,51 @@ func TestParse(t *testing.T) {
 	}
 }
 
+func TestParseNotifiesListeners(t *testing.T) {
+	previous := &domain.CharacterStatistics{Character: "nokka", Hell: domain.Stats{TotalKills: 900}}
+
+	repository := &statisticsRepositoryMock{
+		GetByCharacterFunc: func(ctx context.Context, character string) (*domain.CharacterStatistics, error) {
+			if character == "nokka" {
+				return previous, nil
+			}
+			return nil, domain.ErrNotFound
+		},
+		UpsertFunc: func(ctx context.Context, stat domain.StatisticsRequest) error {
+			return nil
+		},
+	}
+
+	listener := &ListenerMock{
+		StatisticsSubmittedFunc: func(ctx context.Context, previous *domain.CharacterStatistics, submitted domain.StatisticsRequest) error {
+			return nil
+		},
+	}
+
+	s := NewService(repository, listener)
+
+	err := s.Parse(context.TODO(), []domain.StatisticsRequest{
+		{Character: "Nokka", Difficulty: domain.DifficultyHell, TotalKills: 200},
+		{Character: "sorc", Difficulty: domain.DifficultyNormal, TotalKills: 10},
+	})
+	if err != nil {
+		t.Fatalf("didn't expect an error, got = %v", err)
+	}
+
+	calls := listener.StatisticsSubmittedCalls()
+	if len(calls) != 2 {
+		t.Fatalf("expected listener to be called twice, got = %d", len(calls))
+	}
+
+	if calls[0].Previous != previous || calls[0].Submitted.Character != "nokka" || calls[0].Submitted.TotalKills != 200 {
+		t.Errorf("unexpected listener call %+v", calls[0])
+	}
+
+	if calls[1].Previous != nil {
+		t.Errorf("expected no previous statistics, got = %+v", calls[1].Previous)
+	}
+}
+
 func TestGetCharacter(t *testing.T) {
 	type args struct {
 		ctx  context.Context
@@ -326,3 +372,34 @@ func TestDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestParseListenerFails(t *testing.T) {
+	repository := &statisticsRepositoryMock{
+		GetByCharacterFunc: func(ctx context.Context, character string) (*domain.CharacterStatistics, error) {
+			return nil, domain.ErrNotFound
+		},
+		UpsertFunc: func(ctx context.Context, stat domain.StatisticsRequest) error {
+			return nil
+		},
+	}
+
+	listener := &ListenerMock{
+		StatisticsSubmittedFunc: func(ctx context.Context, previous *domain.CharacterStatistics, submitted domain.StatisticsRequest) error {
+			return fmt.Errorf("webhook queue: %w", domain.ErrTemporary)
+		},
+	}
+
+	s := NewService(repository, listener)
+
+	err := s.Parse(context.TODO(), []domain.StatisticsRequest{
+		{Character: "nokka", Difficulty: domain.DifficultyHell, TotalKills: 200},
+		{Character: "sorc", Difficulty: domain.DifficultyNormal, TotalKills: 10},
+	})
+	if err != nil {
+		t.Fatalf("didn't expect an error once the statistics are upserted, got = %v", err)
+	}
+
+	if len(repository.UpsertCalls()) != 2 || len(listener.StatisticsSubmittedCalls()) != 2 {
+		t.Errorf("expected every submission to be upserted and notified")
+	}
+}
@@ -0,0 +1,184 @@
+package visibility
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+)
+
+//go:generate moq -out ./service_mocks.go . visibilityRepository
+
+// visibilityRepository is the interface representation of the data layer
+// the service depend on.
+type visibilityRepository interface {
+	Find(ctx context.Context, character string) (*domain.VisibilitySetting, error)
+	FindHidden(ctx context.Context) ([]string, error)
+	Upsert(ctx context.Context, setting domain.VisibilitySetting) error
+}
+
+var validVisibilities = map[string]struct{}{
+	domain.VisibilityPublic:   {},
+	domain.VisibilityUnlisted: {},
+	domain.VisibilityPrivate:  {},
+}
+
+// tokenBytes is the number of random bytes in a share token.
+const tokenBytes = 16
+
+// Service performs all operations on character visibility.
+type Service struct {
+	repository visibilityRepository
+}
+
+// Get will get the visibility setting of the character, characters
+// without a setting are public.
+func (s Service) Get(ctx context.Context, character string) (*domain.VisibilitySetting, error) {
+	setting, err := s.repository.Find(ctx, character)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return &domain.VisibilitySetting{
+				Character:  domain.NormalizeName(character),
+				Visibility: domain.VisibilityPublic,
+			}, nil
+		}
+
+		return nil, err
+	}
+
+	return setting, nil
+}
+
+// Set will set the visibility of the character, private characters get a share
+// token which is kept between changes unless it's rotated.
+func (s Service) Set(ctx context.Context, character string, visibility string, rotateToken bool) (*domain.VisibilitySetting, error) {
+	if _, ok := validVisibilities[visibility]; !ok {
+		return nil, fmt.Errorf("unknown visibility %s: %w", visibility, domain.ErrRequest)
+	}
+
+	current, err := s.Get(ctx, character)
+	if err != nil {
+		return nil, err
+	}
+
+	setting := domain.VisibilitySetting{
+		Character:       current.Character,
+		Visibility:      visibility,
+		ShareToken:      current.ShareToken,
+		UpdatedAt:       time.Now(),
+		OwnerSecretHash: current.OwnerSecretHash,
+	}
+
+	if visibility == domain.VisibilityPrivate && (setting.ShareToken == "" || rotateToken) {
+		setting.ShareToken, err = newToken()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.repository.Upsert(ctx, setting); err != nil {
+		return nil, err
+	}
+
+	return &setting, nil
+}
+
+// Authorize will return an error if the character isn't visible with the given token,
+// private characters are reported as not found to avoid revealing they exist.
+func (s Service) Authorize(ctx context.Context, character string, token string) error {
+	setting, err := s.Get(ctx, character)
+	if err != nil {
+		return err
+	}
+
+	if setting.Visibility != domain.VisibilityPrivate {
+		return nil
+	}
+
+	if token != "" && subtle.ConstantTimeCompare([]byte(token), []byte(setting.ShareToken)) == 1 {
+		return nil
+	}
+
+	return fmt.Errorf("character %s: %w", setting.Character, domain.ErrNotFound)
+}
+
+// IssueOwnerSecret will give the character a new owner secret, replacing the previous
+// one. Only the hash of the secret is stored, so the secret is only returned once.
+func (s Service) IssueOwnerSecret(ctx context.Context, character string) (string, error) {
+	setting, err := s.Get(ctx, character)
+	if err != nil {
+		return "", err
+	}
+
+	secret, err := newToken()
+	if err != nil {
+		return "", err
+	}
+
+	setting.OwnerSecretHash = hashSecret(secret)
+	setting.UpdatedAt = time.Now()
+
+	if err := s.repository.Upsert(ctx, *setting); err != nil {
+		return "", err
+	}
+
+	return secret, nil
+}
+
+// IsOwner reports whether the secret is the owner secret of the character.
+func (s Service) IsOwner(ctx context.Context, character string, secret string) (bool, error) {
+	setting, err := s.Get(ctx, character)
+	if err != nil {
+		return false, err
+	}
+
+	if secret == "" || setting.OwnerSecretHash == "" {
+		return false, nil
+	}
+
+	return subtle.ConstantTimeCompare([]byte(hashSecret(secret)), []byte(setting.OwnerSecretHash)) == 1, nil
+}
+
+// Hidden returns the names of all characters that must be left out of listings.
+func (s Service) Hidden(ctx context.Context) (map[string]struct{}, error) {
+	names, err := s.repository.FindHidden(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	hidden := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		hidden[name] = struct{}{}
+	}
+
+	return hidden, nil
+}
+
+func newToken() (string, error) {
+	b := make([]byte, tokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate share token: %w", domain.ErrInternal)
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+// hashSecret returns the hex encoded SHA-256 of the secret, secrets are random
+// so there's no need for a slow hash.
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// NewService constructs a new visibility service with all the dependencies.
+func NewService(repository visibilityRepository) *Service {
+	return &Service{
+		repository: repository,
+	}
+}
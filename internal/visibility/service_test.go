@@ -0,0 +1,198 @@
+package visibility
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+)
+
+func TestAuthorize(t *testing.T) {
+	tests := []struct {
+		name          string
+		setting       *domain.VisibilitySetting
+		token         string
+		expectedError error
+	}{
+		{
+			name: "no setting is public",
+		},
+		{
+			name:    "unlisted is visible by name",
+			setting: &domain.VisibilitySetting{Character: "nokka", Visibility: domain.VisibilityUnlisted},
+		},
+		{
+			name:          "private without token",
+			setting:       &domain.VisibilitySetting{Character: "nokka", Visibility: domain.VisibilityPrivate, ShareToken: "secret"},
+			expectedError: domain.ErrNotFound,
+		},
+		{
+			name:          "private with wrong token",
+			setting:       &domain.VisibilitySetting{Character: "nokka", Visibility: domain.VisibilityPrivate, ShareToken: "secret"},
+			token:         "guess",
+			expectedError: domain.ErrNotFound,
+		},
+		{
+			name:    "private with share token",
+			setting: &domain.VisibilitySetting{Character: "nokka", Visibility: domain.VisibilityPrivate, ShareToken: "secret"},
+			token:   "secret",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repository := &visibilityRepositoryMock{
+				FindFunc: func(ctx context.Context, character string) (*domain.VisibilitySetting, error) {
+					if tt.setting == nil {
+						return nil, domain.ErrNotFound
+					}
+					return tt.setting, nil
+				},
+			}
+
+			s := NewService(repository)
+
+			err := s.Authorize(context.TODO(), "nokka", tt.token)
+			if !errors.Is(err, tt.expectedError) {
+				t.Errorf("expected error to be = %v, got = %v", tt.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestSet(t *testing.T) {
+	tests := []struct {
+		name          string
+		current       *domain.VisibilitySetting
+		visibility    string
+		rotate        bool
+		expectToken   bool
+		keepToken     bool
+		expectedError error
+	}{
+		{
+			name:        "private gets a share token",
+			visibility:  domain.VisibilityPrivate,
+			expectToken: true,
+		},
+		{
+			name:        "share token is kept",
+			current:     &domain.VisibilitySetting{Character: "nokka", Visibility: domain.VisibilityPrivate, ShareToken: "secret"},
+			visibility:  domain.VisibilityPrivate,
+			expectToken: true,
+			keepToken:   true,
+		},
+		{
+			name:        "share token is rotated",
+			current:     &domain.VisibilitySetting{Character: "nokka", Visibility: domain.VisibilityPrivate, ShareToken: "secret"},
+			visibility:  domain.VisibilityPrivate,
+			rotate:      true,
+			expectToken: true,
+		},
+		{
+			name:       "public",
+			visibility: domain.VisibilityPublic,
+		},
+		{
+			name:          "unknown visibility",
+			visibility:    "friends",
+			expectedError: domain.ErrRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repository := &visibilityRepositoryMock{
+				FindFunc: func(ctx context.Context, character string) (*domain.VisibilitySetting, error) {
+					if tt.current == nil {
+						return nil, domain.ErrNotFound
+					}
+					return tt.current, nil
+				},
+				UpsertFunc: func(ctx context.Context, setting domain.VisibilitySetting) error {
+					return nil
+				},
+			}
+
+			s := NewService(repository)
+
+			setting, err := s.Set(context.TODO(), "Nokka", tt.visibility, tt.rotate)
+			if !errors.Is(err, tt.expectedError) {
+				t.Fatalf("expected error to be = %v, got = %v", tt.expectedError, err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if setting.Character != "nokka" {
+				t.Errorf("expected character to be normalized, got = %s", setting.Character)
+			}
+
+			if tt.expectToken != (setting.ShareToken != "") {
+				t.Errorf("expected share token = %v, got = %q", tt.expectToken, setting.ShareToken)
+			}
+
+			if tt.current != nil && tt.keepToken != (setting.ShareToken == tt.current.ShareToken) {
+				t.Errorf("expected share token to be kept = %v, got = %q", tt.keepToken, setting.ShareToken)
+			}
+		})
+	}
+}
+
+func TestOwnerSecret(t *testing.T) {
+	var stored *domain.VisibilitySetting
+
+	repository := &visibilityRepositoryMock{
+		FindFunc: func(ctx context.Context, character string) (*domain.VisibilitySetting, error) {
+			if stored == nil {
+				return nil, domain.ErrNotFound
+			}
+			setting := *stored
+			return &setting, nil
+		},
+		UpsertFunc: func(ctx context.Context, setting domain.VisibilitySetting) error {
+			stored = &setting
+			return nil
+		},
+	}
+
+	s := NewService(repository)
+
+	if owner, err := s.IsOwner(context.TODO(), "nokka", ""); err != nil || owner {
+		t.Fatalf("expected nobody to own a character without a secret, got = %v, %v", owner, err)
+	}
+
+	secret, err := s.IssueOwnerSecret(context.TODO(), "Nokka")
+	if err != nil {
+		t.Fatalf("didn't expect an error, got = %v", err)
+	}
+
+	if stored.OwnerSecretHash == "" || stored.OwnerSecretHash == secret {
+		t.Fatalf("expected only the hash of the secret to be stored, got = %q", stored.OwnerSecretHash)
+	}
+
+	for _, tt := range []struct {
+		secret string
+		owner  bool
+	}{
+		{secret: secret, owner: true},
+		{secret: "guess", owner: false},
+		{secret: "", owner: false},
+	} {
+		owner, err := s.IsOwner(context.TODO(), "nokka", tt.secret)
+		if err != nil || owner != tt.owner {
+			t.Errorf("IsOwner(%q) = %v, %v, want %v", tt.secret, owner, err, tt.owner)
+		}
+	}
+
+	// The secret is kept when the owner changes the visibility.
+	if _, err := s.Set(context.TODO(), "nokka", domain.VisibilityPrivate, false); err != nil {
+		t.Fatalf("didn't expect an error, got = %v", err)
+	}
+
+	if owner, _ := s.IsOwner(context.TODO(), "nokka", secret); !owner {
+		t.Error("expected the owner secret to be kept between changes")
+	}
+}
@@ -0,0 +1,167 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Headers sent with every delivery.
+const (
+	HeaderEvent     = "X-Armory-Event"
+	HeaderDelivery  = "X-Armory-Delivery"
+	HeaderTimestamp = "X-Armory-Timestamp"
+	HeaderSignature = "X-Armory-Signature"
+)
+
+// errShuttingDown is the cause of deliveries buried when shutting down.
+var errShuttingDown = errors.New("delivery was queued when shutting down")
+
+// Sign returns the signature of the body, the hex encoded HMAC-SHA256 of the
+// timestamp, a dot and the body, keyed with the secret of the webhook.
+func Sign(secret string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Run delivers the queued events until the context is cancelled, failed
+// deliveries are retried with an exponential backoff. The deliveries still
+// queued once the context is cancelled are put on the dead letter list.
+func (s *Service) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case d := <-s.queue:
+					if ctx.Err() != nil {
+						s.bury(context.Background(), d, errShuttingDown)
+						continue
+					}
+
+					s.deliver(ctx, d)
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	// Wait for the retries to be buried, or queued to be buried below.
+	s.retries.Wait()
+
+	// Keep the deliveries around to be redelivered after a restart.
+	for {
+		select {
+		case d := <-s.queue:
+			s.bury(context.Background(), d, errShuttingDown)
+		default:
+			return
+		}
+	}
+}
+
+// deliver sends the event to the webhook, scheduling a retry when it fails
+// and putting it on the dead letter list once it runs out of attempts.
+func (s *Service) deliver(ctx context.Context, d delivery) {
+	err := s.send(ctx, d)
+	if err == nil {
+		return
+	}
+
+	d.attempts++
+	if d.attempts >= maxAttempts {
+		s.bury(ctx, d, err)
+		return
+	}
+
+	s.retries.Add(1)
+	go func() {
+		defer s.retries.Done()
+
+		timer := time.NewTimer(s.delay(d.attempts))
+		defer timer.Stop()
+
+		select {
+		case <-ctx.Done():
+			// Keep the delivery around to be redelivered after a restart.
+			s.bury(context.Background(), d, err)
+		case <-timer.C:
+			s.enqueue(ctx, d)
+		}
+	}()
+}
+
+// delay returns the time to wait before the attempt, doubled for every attempt made.
+func (s *Service) delay(attempts int) time.Duration {
+	delay := s.backoff
+	for i := 1; i < attempts && delay < maxBackoff; i++ {
+		delay *= 2
+	}
+
+	if delay > maxBackoff {
+		delay = maxBackoff
+	}
+
+	return delay
+}
+
+// send makes a single attempt to deliver the event, any response but 2xx is a failure.
+func (s *Service) send(ctx context.Context, d delivery) error {
+	body, err := json.Marshal(d.event)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deliveryTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	timestamp := strconv.FormatInt(s.now().Unix(), 10)
+
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	req.Header.Set("User-Agent", "d2-armory-api")
+	req.Header.Set(HeaderEvent, d.event.Type)
+	req.Header.Set(HeaderDelivery, d.event.ID)
+	req.Header.Set(HeaderTimestamp, timestamp)
+	req.Header.Set(HeaderSignature, Sign(d.webhook.Secret, timestamp, body))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// Drain the body to reuse the connection.
+	_, _ = io.Copy(ioutil.Discard, io.LimitReader(resp.Body, 1<<16))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook responded with %d", resp.StatusCode)
+	}
+
+	return nil
+}
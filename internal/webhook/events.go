@@ -0,0 +1,142 @@
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+	"github.com/nokka/d2-armory-api/internal/grail"
+	"github.com/nokka/d2s"
+)
+
+// firstMilestone is the lowest number of total kills announced, every power of ten from it is a milestone.
+const firstMilestone = 1000
+
+// CharacterPersisted detects the events by comparing the character to how it was
+// cached before, nothing is sent the first time a character is persisted.
+func (s *Service) CharacterPersisted(ctx context.Context, previous *domain.Character, current *domain.Character) error {
+	if previous == nil || previous.D2s == nil || current == nil || current.D2s == nil {
+		return nil
+	}
+
+	events, err := s.detect(current.ID, previous.D2s, current.D2s)
+	if err != nil {
+		return err
+	}
+
+	return s.publish(ctx, events)
+}
+
+func (s *Service) detect(name string, previous *d2s.Character, current *d2s.Character) ([]domain.Event, error) {
+	var events []domain.Event
+
+	add := func(eventType string, data map[string]interface{}) error {
+		e, err := s.newEvent(eventType, name, data)
+		if err != nil {
+			return err
+		}
+
+		events = append(events, e)
+
+		return nil
+	}
+
+	class := current.Header.Class.String()
+
+	if current.Header.Level > previous.Header.Level {
+		err := add(domain.EventLevelUp, map[string]interface{}{
+			"class":          class,
+			"previous_level": int(previous.Header.Level),
+			"level":          int(current.Header.Level),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	status := current.Header.Status.Readable()
+	if status.Hardcore && status.Died && !previous.Header.Status.Readable().Died {
+		err := add(domain.EventDeath, map[string]interface{}{
+			"class": class,
+			"level": int(current.Header.Level),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if found := newUniques(previous, current); len(found) > 0 {
+		if err := add(domain.EventUniqueFound, map[string]interface{}{"items": found}); err != nil {
+			return nil, err
+		}
+	}
+
+	return events, nil
+}
+
+// newUniques returns the uniques the character has that it didn't have before.
+func newUniques(previous *d2s.Character, current *d2s.Character) []string {
+	had := make(map[string]struct{})
+	for _, i := range grail.Found(previous) {
+		if i.Kind == domain.GrailUnique {
+			had[i.Name] = struct{}{}
+		}
+	}
+
+	var found []string
+	for _, i := range grail.Found(current) {
+		if _, ok := had[i.Name]; !ok && i.Kind == domain.GrailUnique {
+			found = append(found, i.Name)
+		}
+	}
+
+	return found
+}
+
+// StatisticsSubmitted detects when the total kills of a character, of all
+// difficulties, passes a milestone with the submitted statistics.
+func (s *Service) StatisticsSubmitted(ctx context.Context, previous *domain.CharacterStatistics, submitted domain.StatisticsRequest) error {
+	var before int
+	if previous != nil {
+		before = previous.Normal.TotalKills + previous.Nightmare.TotalKills + previous.Hell.TotalKills
+	}
+
+	after := before + submitted.TotalKills
+
+	milestone := 0
+	for m := firstMilestone; m <= after; m *= 10 {
+		if m > before {
+			milestone = m
+		}
+	}
+
+	if milestone == 0 {
+		return nil
+	}
+
+	e, err := s.newEvent(domain.EventStatisticsMilestone, submitted.Character, map[string]interface{}{
+		"milestone":   milestone,
+		"total_kills": after,
+		"difficulty":  submitted.Difficulty,
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.publish(ctx, []domain.Event{e})
+}
+
+func (s *Service) newEvent(eventType string, character string, data map[string]interface{}) (domain.Event, error) {
+	id, err := newID(idBytes)
+	if err != nil {
+		return domain.Event{}, err
+	}
+
+	return domain.Event{
+		ID:         id,
+		Type:       eventType,
+		Character:  domain.NormalizeName(character),
+		OccurredAt: s.now().UTC().Truncate(time.Millisecond),
+		Data:       data,
+	}, nil
+}
@@ -0,0 +1,260 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+)
+
+//go:generate moq -out ./service_mocks.go . webhookRepository deadLetterRepository doer hiddenCharacters
+
+// webhookRepository is the interface representation of the webhooks
+// the service depend on.
+type webhookRepository interface {
+	Insert(ctx context.Context, webhook domain.Webhook) error
+	Find(ctx context.Context, id string) (*domain.Webhook, error)
+	FindAll(ctx context.Context) ([]domain.Webhook, error)
+	FindByEvent(ctx context.Context, event string) ([]domain.Webhook, error)
+	Delete(ctx context.Context, id string) error
+}
+
+// deadLetterRepository is the interface representation of the deliveries
+// that failed for good.
+type deadLetterRepository interface {
+	Insert(ctx context.Context, letter domain.DeadLetter) error
+	Find(ctx context.Context, id string) (*domain.DeadLetter, error)
+	FindAll(ctx context.Context) ([]domain.DeadLetter, error)
+	Delete(ctx context.Context, id string) error
+}
+
+// doer is the interface representation of the HTTP client delivering events.
+type doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// hiddenCharacters is the interface representation of the characters
+// events mustn't be sent for.
+type hiddenCharacters interface {
+	Hidden(ctx context.Context) (map[string]struct{}, error)
+}
+
+var validEvents = map[string]struct{}{
+	domain.EventLevelUp:             {},
+	domain.EventDeath:               {},
+	domain.EventUniqueFound:         {},
+	domain.EventStatisticsMilestone: {},
+}
+
+// Delivery settings.
+const (
+	idBytes         = 16
+	secretBytes     = 32
+	queueSize       = 1000
+	workers         = 4
+	maxAttempts     = 6
+	deliveryTimeout = 10 * time.Second
+	defaultBackoff  = 5 * time.Second
+	maxBackoff      = 10 * time.Minute
+)
+
+// delivery is a single event on its way to a webhook.
+type delivery struct {
+	webhook  domain.Webhook
+	event    domain.Event
+	attempts int
+}
+
+// Service manages webhooks and delivers character events to them.
+type Service struct {
+	webhooks    webhookRepository
+	deadLetters deadLetterRepository
+	client      doer
+	hidden      hiddenCharacters
+	queue       chan delivery
+	retries     sync.WaitGroup
+	backoff     time.Duration
+	now         func() time.Time
+}
+
+// Register will subscribe the URL to the events, the secret deliveries are
+// signed with is only returned here.
+func (s *Service) Register(ctx context.Context, rawURL string, events []string) (*domain.Webhook, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("url must be an absolute http or https url: %w", domain.ErrRequest)
+	}
+
+	if len(events) == 0 {
+		return nil, fmt.Errorf("at least one event is required: %w", domain.ErrRequest)
+	}
+
+	for _, e := range events {
+		if _, ok := validEvents[e]; !ok {
+			return nil, fmt.Errorf("unknown event %s: %w", e, domain.ErrRequest)
+		}
+	}
+
+	id, err := newID(idBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := newID(secretBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	webhook := domain.Webhook{
+		ID:        id,
+		URL:       u.String(),
+		Events:    events,
+		Secret:    secret,
+		CreatedAt: s.now().UTC().Truncate(time.Millisecond),
+	}
+
+	if err := s.webhooks.Insert(ctx, webhook); err != nil {
+		return nil, err
+	}
+
+	return &webhook, nil
+}
+
+// List will list the webhooks, without their secrets.
+func (s *Service) List(ctx context.Context) ([]domain.Webhook, error) {
+	webhooks, err := s.webhooks.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range webhooks {
+		webhooks[i].Secret = ""
+	}
+
+	return webhooks, nil
+}
+
+// Delete will unsubscribe the webhook.
+func (s *Service) Delete(ctx context.Context, id string) error {
+	return s.webhooks.Delete(ctx, id)
+}
+
+// DeadLetters will list the deliveries that failed for good.
+func (s *Service) DeadLetters(ctx context.Context) ([]domain.DeadLetter, error) {
+	return s.deadLetters.FindAll(ctx)
+}
+
+// Redeliver will try to deliver a dead letter again, the webhook must still exist.
+func (s *Service) Redeliver(ctx context.Context, id string) error {
+	letter, err := s.deadLetters.Find(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	webhook, err := s.webhooks.Find(ctx, letter.WebhookID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return fmt.Errorf("webhook %s was deleted: %w", letter.WebhookID, domain.ErrGone)
+		}
+		return err
+	}
+
+	if err := s.deadLetters.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	s.enqueue(ctx, delivery{webhook: *webhook, event: letter.Event})
+
+	return nil
+}
+
+// publish sends the events to the webhooks subscribed to them, events of
+// characters that aren't public are left out.
+func (s *Service) publish(ctx context.Context, events []domain.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	if s.hidden != nil {
+		hidden, err := s.hidden.Hidden(ctx)
+		if err != nil {
+			return err
+		}
+
+		if _, ok := hidden[events[0].Character]; ok {
+			return nil
+		}
+	}
+
+	for _, e := range events {
+		webhooks, err := s.webhooks.FindByEvent(ctx, e.Type)
+		if err != nil {
+			return err
+		}
+
+		for _, w := range webhooks {
+			s.enqueue(ctx, delivery{webhook: w, event: e})
+		}
+	}
+
+	return nil
+}
+
+// enqueue queues the delivery, it's put on the dead letter list right away
+// if the queue is full.
+func (s *Service) enqueue(ctx context.Context, d delivery) {
+	select {
+	case s.queue <- d:
+	default:
+		s.bury(ctx, d, errors.New("delivery queue is full"))
+	}
+}
+
+// bury puts the delivery on the dead letter list.
+func (s *Service) bury(ctx context.Context, d delivery, cause error) {
+	id, err := newID(idBytes)
+	if err != nil {
+		return
+	}
+
+	_ = s.deadLetters.Insert(ctx, domain.DeadLetter{
+		ID:        id,
+		WebhookID: d.webhook.ID,
+		URL:       d.webhook.URL,
+		Event:     d.event,
+		Attempts:  d.attempts,
+		LastError: cause.Error(),
+		FailedAt:  s.now().UTC().Truncate(time.Millisecond),
+	})
+}
+
+// newID returns a random hex encoded identifier of n bytes.
+func newID(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+// NewService constructs a new webhook service with all the dependencies, events
+// of the hidden characters aren't delivered, hidden may be nil.
+func NewService(webhooks webhookRepository, deadLetters deadLetterRepository, client doer, hidden hiddenCharacters) *Service {
+	return &Service{
+		webhooks:    webhooks,
+		deadLetters: deadLetters,
+		client:      client,
+		hidden:      hidden,
+		queue:       make(chan delivery, queueSize),
+		backoff:     defaultBackoff,
+		now:         time.Now,
+	}
+}
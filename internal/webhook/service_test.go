@@ -0,0 +1,499 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nokka/d2-armory-api/internal/domain"
+	"github.com/nokka/d2s"
+)
+
+func TestRegister(t *testing.T) {
+	tests := []struct {
+		name          string
+		url           string
+		events        []string
+		expectedError bool
+	}{
+		{
+			name:   "register successful",
+			url:    "https://example.com/hooks/armory",
+			events: []string{domain.EventLevelUp, domain.EventDeath},
+		},
+		{
+			name:          "relative url",
+			url:           "/hooks/armory",
+			events:        []string{domain.EventLevelUp},
+			expectedError: true,
+		},
+		{
+			name:          "unsupported scheme",
+			url:           "ftp://example.com/hooks",
+			events:        []string{domain.EventLevelUp},
+			expectedError: true,
+		},
+		{
+			name:          "no events",
+			url:           "https://example.com/hooks/armory",
+			expectedError: true,
+		},
+		{
+			name:          "unknown event",
+			url:           "https://example.com/hooks/armory",
+			events:        []string{"rune_found"},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			webhooks := &webhookRepositoryMock{
+				InsertFunc: func(ctx context.Context, webhook domain.Webhook) error {
+					return nil
+				},
+			}
+
+			s := NewService(webhooks, &deadLetterRepositoryMock{}, http.DefaultClient, nil)
+
+			webhook, err := s.Register(context.Background(), tt.url, tt.events)
+			if (err != nil) != tt.expectedError {
+				t.Fatalf("got error = %v, expectedError %v", err, tt.expectedError)
+			}
+
+			if tt.expectedError {
+				if !errors.Is(err, domain.ErrRequest) {
+					t.Errorf("expected ErrRequest, got = %v", err)
+				}
+				if len(webhooks.InsertCalls()) != 0 {
+					t.Errorf("didn't expect the webhook to be stored")
+				}
+				return
+			}
+
+			if webhook.ID == "" || len(webhook.Secret) != secretBytes*2 {
+				t.Errorf("expected an id and a secret, got = %+v", webhook)
+			}
+
+			if len(webhooks.InsertCalls()) != 1 {
+				t.Errorf("expected the webhook to be stored once, got = %d", len(webhooks.InsertCalls()))
+			}
+		})
+	}
+}
+
+func TestList(t *testing.T) {
+	webhooks := &webhookRepositoryMock{
+		FindAllFunc: func(ctx context.Context) ([]domain.Webhook, error) {
+			return []domain.Webhook{{ID: "a", URL: "https://example.com", Secret: "secret"}}, nil
+		},
+	}
+
+	s := NewService(webhooks, &deadLetterRepositoryMock{}, http.DefaultClient, nil)
+
+	list, err := s.List(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(list) != 1 || list[0].Secret != "" {
+		t.Errorf("expected the secret to be left out, got = %+v", list)
+	}
+}
+
+func TestCharacterPersisted(t *testing.T) {
+	hardcore := d2s.Header{Level: 40, Class: 4}
+	hardcore.Status = 0x24
+
+	died := hardcore
+	died.Status = 0x2c
+
+	leveled := hardcore
+	leveled.Level = 42
+
+	shako := []d2s.Item{{Type: "uap", UniqueName: "Harlequin Crest"}}
+
+	tests := []struct {
+		name     string
+		previous *d2s.Character
+		current  *d2s.Character
+		hidden   map[string]struct{}
+		expected []string
+	}{
+		{
+			name:     "nothing changed",
+			previous: &d2s.Character{Header: hardcore},
+			current:  &d2s.Character{Header: hardcore},
+		},
+		{
+			name:     "level up",
+			previous: &d2s.Character{Header: hardcore},
+			current:  &d2s.Character{Header: leveled},
+			expected: []string{domain.EventLevelUp},
+		},
+		{
+			name:     "hardcore death",
+			previous: &d2s.Character{Header: hardcore},
+			current:  &d2s.Character{Header: died},
+			expected: []string{domain.EventDeath},
+		},
+		{
+			name:     "already dead",
+			previous: &d2s.Character{Header: died},
+			current:  &d2s.Character{Header: died},
+		},
+		{
+			name:     "unique found",
+			previous: &d2s.Character{Header: hardcore},
+			current:  &d2s.Character{Header: hardcore, Items: shako},
+			expected: []string{domain.EventUniqueFound},
+		},
+		{
+			name:     "unique already found",
+			previous: &d2s.Character{Header: hardcore, Items: shako},
+			current:  &d2s.Character{Header: hardcore, Items: shako},
+		},
+		{
+			name:     "hidden character",
+			previous: &d2s.Character{Header: hardcore},
+			current:  &d2s.Character{Header: leveled},
+			hidden:   map[string]struct{}{"nokka": {}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			webhooks := &webhookRepositoryMock{
+				FindByEventFunc: func(ctx context.Context, event string) ([]domain.Webhook, error) {
+					return []domain.Webhook{{ID: "a", URL: "https://example.com"}}, nil
+				},
+			}
+
+			hidden := &hiddenCharactersMock{
+				HiddenFunc: func(ctx context.Context) (map[string]struct{}, error) {
+					return tt.hidden, nil
+				},
+			}
+
+			s := NewService(webhooks, &deadLetterRepositoryMock{}, http.DefaultClient, hidden)
+
+			err := s.CharacterPersisted(context.Background(),
+				&domain.Character{ID: "nokka", D2s: tt.previous},
+				&domain.Character{ID: "nokka", D2s: tt.current},
+			)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var queued []string
+			for len(s.queue) > 0 {
+				d := <-s.queue
+				if d.event.Character != "nokka" {
+					t.Errorf("expected the event to be of nokka, got = %s", d.event.Character)
+				}
+				queued = append(queued, d.event.Type)
+			}
+
+			if !reflect.DeepEqual(queued, tt.expected) {
+				t.Errorf("expected events %v, got %v", tt.expected, queued)
+			}
+		})
+	}
+}
+
+func TestStatisticsSubmitted(t *testing.T) {
+	tests := []struct {
+		name      string
+		previous  *domain.CharacterStatistics
+		kills     int
+		milestone int
+	}{
+		{
+			name:  "below the first milestone",
+			kills: 999,
+		},
+		{
+			name:      "first submission passes a milestone",
+			kills:     1200,
+			milestone: 1000,
+		},
+		{
+			name:      "passes a milestone",
+			previous:  &domain.CharacterStatistics{Normal: domain.Stats{TotalKills: 9000}, Hell: domain.Stats{TotalKills: 500}},
+			kills:     600,
+			milestone: 10000,
+		},
+		{
+			name:     "milestone already passed",
+			previous: &domain.CharacterStatistics{Hell: domain.Stats{TotalKills: 1500}},
+			kills:    600,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			webhooks := &webhookRepositoryMock{
+				FindByEventFunc: func(ctx context.Context, event string) ([]domain.Webhook, error) {
+					return []domain.Webhook{{ID: "a", URL: "https://example.com"}}, nil
+				},
+			}
+
+			s := NewService(webhooks, &deadLetterRepositoryMock{}, http.DefaultClient, nil)
+
+			err := s.StatisticsSubmitted(context.Background(), tt.previous, domain.StatisticsRequest{
+				Character:  "Nokka",
+				Difficulty: domain.DifficultyHell,
+				TotalKills: tt.kills,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if tt.milestone == 0 {
+				if len(s.queue) != 0 {
+					t.Errorf("didn't expect a milestone to be announced")
+				}
+				return
+			}
+
+			if len(s.queue) != 1 {
+				t.Fatalf("expected one milestone to be announced, got = %d", len(s.queue))
+			}
+
+			d := <-s.queue
+			if d.event.Type != domain.EventStatisticsMilestone || d.event.Data["milestone"] != tt.milestone {
+				t.Errorf("expected milestone %d, got = %+v", tt.milestone, d.event)
+			}
+		})
+	}
+}
+
+func TestDelivery(t *testing.T) {
+	received := make(chan *http.Request, 1)
+	bodies := make(chan []byte, 1)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		received <- r
+		bodies <- body
+	}))
+	defer server.Close()
+
+	webhooks := &webhookRepositoryMock{
+		FindByEventFunc: func(ctx context.Context, event string) ([]domain.Webhook, error) {
+			return []domain.Webhook{{ID: "a", URL: server.URL, Secret: "secret"}}, nil
+		},
+	}
+
+	// Shutting down mid delivery puts it on the dead letter list.
+	deadLetters := &deadLetterRepositoryMock{
+		InsertFunc: func(ctx context.Context, letter domain.DeadLetter) error {
+			return nil
+		},
+	}
+
+	s := NewService(webhooks, deadLetters, server.Client(), nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go s.Run(ctx)
+
+	if err := s.StatisticsSubmitted(ctx, nil, domain.StatisticsRequest{Character: "nokka", TotalKills: 1000}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	select {
+	case r := <-received:
+		body := <-bodies
+
+		if r.Header.Get(HeaderEvent) != domain.EventStatisticsMilestone {
+			t.Errorf("unexpected event header %s", r.Header.Get(HeaderEvent))
+		}
+
+		signature := Sign("secret", r.Header.Get(HeaderTimestamp), body)
+		if r.Header.Get(HeaderSignature) != signature {
+			t.Errorf("expected signature %s, got %s", signature, r.Header.Get(HeaderSignature))
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("the event wasn't delivered")
+	}
+}
+
+func TestDeliveryDeadLetter(t *testing.T) {
+	var mu sync.Mutex
+	attempts := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		attempts++
+		mu.Unlock()
+
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	buried := make(chan domain.DeadLetter, 1)
+	deadLetters := &deadLetterRepositoryMock{
+		InsertFunc: func(ctx context.Context, letter domain.DeadLetter) error {
+			buried <- letter
+			return nil
+		},
+	}
+
+	webhooks := &webhookRepositoryMock{
+		FindByEventFunc: func(ctx context.Context, event string) ([]domain.Webhook, error) {
+			return []domain.Webhook{{ID: "a", URL: server.URL, Secret: "secret"}}, nil
+		},
+	}
+
+	s := NewService(webhooks, deadLetters, server.Client(), nil)
+	s.backoff = time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go s.Run(ctx)
+
+	if err := s.StatisticsSubmitted(ctx, nil, domain.StatisticsRequest{Character: "nokka", TotalKills: 1000}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	select {
+	case letter := <-buried:
+		if letter.WebhookID != "a" || letter.Attempts != maxAttempts {
+			t.Errorf("unexpected dead letter %+v", letter)
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		if attempts != maxAttempts {
+			t.Errorf("expected %d attempts, got = %d", maxAttempts, attempts)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("the delivery wasn't put on the dead letter list")
+	}
+}
+
+func TestDeliveryShutdown(t *testing.T) {
+	var mu sync.Mutex
+	requests := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests++
+		mu.Unlock()
+	}))
+	defer server.Close()
+
+	var buried []domain.DeadLetter
+	deadLetters := &deadLetterRepositoryMock{
+		InsertFunc: func(ctx context.Context, letter domain.DeadLetter) error {
+			mu.Lock()
+			buried = append(buried, letter)
+			mu.Unlock()
+			return nil
+		},
+	}
+
+	s := NewService(&webhookRepositoryMock{}, deadLetters, server.Client(), nil)
+
+	for i := 0; i < 3; i++ {
+		s.enqueue(context.Background(), delivery{webhook: domain.Webhook{ID: "a", URL: server.URL}, event: domain.Event{ID: strconv.Itoa(i)}})
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	s.Run(ctx)
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if requests != 0 {
+		t.Errorf("expected nothing to be delivered after shutting down, got = %d", requests)
+	}
+
+	if len(buried) != 3 {
+		t.Fatalf("expected the queued deliveries to be put on the dead letter list, got = %d", len(buried))
+	}
+
+	if buried[0].WebhookID != "a" || buried[0].LastError != errShuttingDown.Error() {
+		t.Errorf("unexpected dead letter %+v", buried[0])
+	}
+}
+
+func TestRetryShutdown(t *testing.T) {
+	attempted := make(chan struct{}, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		select {
+		case attempted <- struct{}{}:
+		default:
+		}
+	}))
+	defer server.Close()
+
+	var mu sync.Mutex
+	var buried []domain.DeadLetter
+	deadLetters := &deadLetterRepositoryMock{
+		InsertFunc: func(ctx context.Context, letter domain.DeadLetter) error {
+			mu.Lock()
+			buried = append(buried, letter)
+			mu.Unlock()
+			return nil
+		},
+	}
+
+	s := NewService(&webhookRepositoryMock{}, deadLetters, server.Client(), nil)
+	s.backoff = time.Hour
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		s.Run(ctx)
+		close(done)
+	}()
+
+	s.enqueue(context.Background(), delivery{webhook: domain.Webhook{ID: "a", URL: server.URL}, event: domain.Event{ID: "1"}})
+
+	<-attempted
+	cancel()
+	<-done
+
+	// The retry waiting for its backoff must be buried before Run returns.
+	mu.Lock()
+	defer mu.Unlock()
+
+	if len(buried) != 1 || buried[0].Attempts != 1 {
+		t.Fatalf("expected the delivery waiting to be retried on the dead letter list, got = %+v", buried)
+	}
+}
+
+func TestRedeliverDeletedWebhook(t *testing.T) {
+	deadLetters := &deadLetterRepositoryMock{
+		FindFunc: func(ctx context.Context, id string) (*domain.DeadLetter, error) {
+			return &domain.DeadLetter{ID: id, WebhookID: "a"}, nil
+		},
+	}
+
+	webhooks := &webhookRepositoryMock{
+		FindFunc: func(ctx context.Context, id string) (*domain.Webhook, error) {
+			return nil, domain.ErrNotFound
+		},
+	}
+
+	s := NewService(webhooks, deadLetters, http.DefaultClient, nil)
+
+	if err := s.Redeliver(context.Background(), "b"); !errors.Is(err, domain.ErrGone) {
+		t.Errorf("expected ErrGone, got = %v", err)
+	}
+}
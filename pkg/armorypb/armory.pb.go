@@ -0,0 +1,2248 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.19.1
+// source: armory/v1/armory.proto
+
+package armorypb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Difficulty int32
+
+const (
+	Difficulty_DIFFICULTY_UNSPECIFIED Difficulty = 0
+	Difficulty_DIFFICULTY_NORMAL      Difficulty = 1
+	Difficulty_DIFFICULTY_NIGHTMARE   Difficulty = 2
+	Difficulty_DIFFICULTY_HELL        Difficulty = 3
+)
+
+// Enum value maps for Difficulty.
+var (
+	Difficulty_name = map[int32]string{
+		0: "DIFFICULTY_UNSPECIFIED",
+		1: "DIFFICULTY_NORMAL",
+		2: "DIFFICULTY_NIGHTMARE",
+		3: "DIFFICULTY_HELL",
+	}
+	Difficulty_value = map[string]int32{
+		"DIFFICULTY_UNSPECIFIED": 0,
+		"DIFFICULTY_NORMAL":      1,
+		"DIFFICULTY_NIGHTMARE":   2,
+		"DIFFICULTY_HELL":        3,
+	}
+)
+
+func (x Difficulty) Enum() *Difficulty {
+	p := new(Difficulty)
+	*p = x
+	return p
+}
+
+func (x Difficulty) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Difficulty) Descriptor() protoreflect.EnumDescriptor {
+	return file_armory_v1_armory_proto_enumTypes[0].Descriptor()
+}
+
+func (Difficulty) Type() protoreflect.EnumType {
+	return &file_armory_v1_armory_proto_enumTypes[0]
+}
+
+func (x Difficulty) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Difficulty.Descriptor instead.
+func (Difficulty) EnumDescriptor() ([]byte, []int) {
+	return file_armory_v1_armory_proto_rawDescGZIP(), []int{0}
+}
+
+type GetCharacterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The share token of a private character.
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *GetCharacterRequest) Reset() {
+	*x = GetCharacterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_armory_v1_armory_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCharacterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCharacterRequest) ProtoMessage() {}
+
+func (x *GetCharacterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_armory_v1_armory_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCharacterRequest.ProtoReflect.Descriptor instead.
+func (*GetCharacterRequest) Descriptor() ([]byte, []int) {
+	return file_armory_v1_armory_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetCharacterRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetCharacterRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type BatchGetCharactersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// At most 100 names.
+	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	// The share tokens of private characters, by name.
+	Tokens map[string]string `protobuf:"bytes,2,rep,name=tokens,proto3" json:"tokens,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *BatchGetCharactersRequest) Reset() {
+	*x = BatchGetCharactersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_armory_v1_armory_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetCharactersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetCharactersRequest) ProtoMessage() {}
+
+func (x *BatchGetCharactersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_armory_v1_armory_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetCharactersRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetCharactersRequest) Descriptor() ([]byte, []int) {
+	return file_armory_v1_armory_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BatchGetCharactersRequest) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *BatchGetCharactersRequest) GetTokens() map[string]string {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+type BatchGetCharactersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The results in the order of the requested names.
+	Results []*CharacterResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *BatchGetCharactersResponse) Reset() {
+	*x = BatchGetCharactersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_armory_v1_armory_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetCharactersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetCharactersResponse) ProtoMessage() {}
+
+func (x *BatchGetCharactersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_armory_v1_armory_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetCharactersResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetCharactersResponse) Descriptor() ([]byte, []int) {
+	return file_armory_v1_armory_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BatchGetCharactersResponse) GetResults() []*CharacterResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// CharacterResult is the outcome of fetching a single character of a batch.
+type CharacterResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Set when the character was fetched.
+	Character *Character `protobuf:"bytes,2,opt,name=character,proto3" json:"character,omitempty"`
+	// The gRPC status code of fetching the character, OK when it was fetched.
+	Code    int32  `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *CharacterResult) Reset() {
+	*x = CharacterResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_armory_v1_armory_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CharacterResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CharacterResult) ProtoMessage() {}
+
+func (x *CharacterResult) ProtoReflect() protoreflect.Message {
+	mi := &file_armory_v1_armory_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CharacterResult.ProtoReflect.Descriptor instead.
+func (*CharacterResult) Descriptor() ([]byte, []int) {
+	return file_armory_v1_armory_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CharacterResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CharacterResult) GetCharacter() *Character {
+	if x != nil {
+		return x.Character
+	}
+	return nil
+}
+
+func (x *CharacterResult) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *CharacterResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetStatisticsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Character string `protobuf:"bytes,1,opt,name=character,proto3" json:"character,omitempty"`
+	// The share token of a private character.
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *GetStatisticsRequest) Reset() {
+	*x = GetStatisticsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_armory_v1_armory_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatisticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatisticsRequest) ProtoMessage() {}
+
+func (x *GetStatisticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_armory_v1_armory_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatisticsRequest.ProtoReflect.Descriptor instead.
+func (*GetStatisticsRequest) Descriptor() ([]byte, []int) {
+	return file_armory_v1_armory_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetStatisticsRequest) GetCharacter() string {
+	if x != nil {
+		return x.Character
+	}
+	return ""
+}
+
+func (x *GetStatisticsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type PostStatisticsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Statistics []*StatisticsSubmission `protobuf:"bytes,1,rep,name=statistics,proto3" json:"statistics,omitempty"`
+}
+
+func (x *PostStatisticsRequest) Reset() {
+	*x = PostStatisticsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_armory_v1_armory_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostStatisticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostStatisticsRequest) ProtoMessage() {}
+
+func (x *PostStatisticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_armory_v1_armory_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostStatisticsRequest.ProtoReflect.Descriptor instead.
+func (*PostStatisticsRequest) Descriptor() ([]byte, []int) {
+	return file_armory_v1_armory_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PostStatisticsRequest) GetStatistics() []*StatisticsSubmission {
+	if x != nil {
+		return x.Statistics
+	}
+	return nil
+}
+
+type DeleteStatisticsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Character string `protobuf:"bytes,1,opt,name=character,proto3" json:"character,omitempty"`
+}
+
+func (x *DeleteStatisticsRequest) Reset() {
+	*x = DeleteStatisticsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_armory_v1_armory_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteStatisticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteStatisticsRequest) ProtoMessage() {}
+
+func (x *DeleteStatisticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_armory_v1_armory_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteStatisticsRequest.ProtoReflect.Descriptor instead.
+func (*DeleteStatisticsRequest) Descriptor() ([]byte, []int) {
+	return file_armory_v1_armory_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteStatisticsRequest) GetCharacter() string {
+	if x != nil {
+		return x.Character
+	}
+	return ""
+}
+
+type WatchCharacterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The share token of a private character.
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *WatchCharacterRequest) Reset() {
+	*x = WatchCharacterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_armory_v1_armory_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchCharacterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchCharacterRequest) ProtoMessage() {}
+
+func (x *WatchCharacterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_armory_v1_armory_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchCharacterRequest.ProtoReflect.Descriptor instead.
+func (*WatchCharacterRequest) Descriptor() ([]byte, []int) {
+	return file_armory_v1_armory_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *WatchCharacterRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WatchCharacterRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// CharacterUpdate is a change to a character, either the character as it was
+// reparsed or the statistics posted for it.
+type CharacterUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Update:
+	//	*CharacterUpdate_Character
+	//	*CharacterUpdate_Statistics
+	Update isCharacterUpdate_Update `protobuf_oneof:"update"`
+}
+
+func (x *CharacterUpdate) Reset() {
+	*x = CharacterUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_armory_v1_armory_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CharacterUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CharacterUpdate) ProtoMessage() {}
+
+func (x *CharacterUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_armory_v1_armory_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CharacterUpdate.ProtoReflect.Descriptor instead.
+func (*CharacterUpdate) Descriptor() ([]byte, []int) {
+	return file_armory_v1_armory_proto_rawDescGZIP(), []int{8}
+}
+
+func (m *CharacterUpdate) GetUpdate() isCharacterUpdate_Update {
+	if m != nil {
+		return m.Update
+	}
+	return nil
+}
+
+func (x *CharacterUpdate) GetCharacter() *Character {
+	if x, ok := x.GetUpdate().(*CharacterUpdate_Character); ok {
+		return x.Character
+	}
+	return nil
+}
+
+func (x *CharacterUpdate) GetStatistics() *StatisticsSubmission {
+	if x, ok := x.GetUpdate().(*CharacterUpdate_Statistics); ok {
+		return x.Statistics
+	}
+	return nil
+}
+
+type isCharacterUpdate_Update interface {
+	isCharacterUpdate_Update()
+}
+
+type CharacterUpdate_Character struct {
+	Character *Character `protobuf:"bytes,1,opt,name=character,proto3,oneof"`
+}
+
+type CharacterUpdate_Statistics struct {
+	Statistics *StatisticsSubmission `protobuf:"bytes,2,opt,name=statistics,proto3,oneof"`
+}
+
+func (*CharacterUpdate_Character) isCharacterUpdate_Update() {}
+
+func (*CharacterUpdate_Statistics) isCharacterUpdate_Update() {}
+
+type Character struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Class      string `protobuf:"bytes,2,opt,name=class,proto3" json:"class,omitempty"`
+	Level      int32  `protobuf:"varint,3,opt,name=level,proto3" json:"level,omitempty"`
+	Experience uint64 `protobuf:"varint,4,opt,name=experience,proto3" json:"experience,omitempty"`
+	Hardcore   bool   `protobuf:"varint,5,opt,name=hardcore,proto3" json:"hardcore,omitempty"`
+	// Whether the character has died, hardcore characters that died can't be played.
+	Dead       bool                   `protobuf:"varint,6,opt,name=dead,proto3" json:"dead,omitempty"`
+	Expansion  bool                   `protobuf:"varint,7,opt,name=expansion,proto3" json:"expansion,omitempty"`
+	Ladder     bool                   `protobuf:"varint,8,opt,name=ladder,proto3" json:"ladder,omitempty"`
+	LastPlayed *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_played,json=lastPlayed,proto3" json:"last_played,omitempty"`
+	LastParsed *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=last_parsed,json=lastParsed,proto3" json:"last_parsed,omitempty"`
+	// The account and realm are only known when the armory runs next to a PvPGN realm.
+	Account    string      `protobuf:"bytes,11,opt,name=account,proto3" json:"account,omitempty"`
+	Realm      string      `protobuf:"bytes,12,opt,name=realm,proto3" json:"realm,omitempty"`
+	Attributes *Attributes `protobuf:"bytes,13,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	Skills     []*Skill    `protobuf:"bytes,14,rep,name=skills,proto3" json:"skills,omitempty"`
+	Items      []*Item     `protobuf:"bytes,15,rep,name=items,proto3" json:"items,omitempty"`
+	// Not set when the character has no mercenary.
+	Mercenary *Mercenary `protobuf:"bytes,16,opt,name=mercenary,proto3" json:"mercenary,omitempty"`
+}
+
+func (x *Character) Reset() {
+	*x = Character{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_armory_v1_armory_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Character) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Character) ProtoMessage() {}
+
+func (x *Character) ProtoReflect() protoreflect.Message {
+	mi := &file_armory_v1_armory_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Character.ProtoReflect.Descriptor instead.
+func (*Character) Descriptor() ([]byte, []int) {
+	return file_armory_v1_armory_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Character) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Character) GetClass() string {
+	if x != nil {
+		return x.Class
+	}
+	return ""
+}
+
+func (x *Character) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *Character) GetExperience() uint64 {
+	if x != nil {
+		return x.Experience
+	}
+	return 0
+}
+
+func (x *Character) GetHardcore() bool {
+	if x != nil {
+		return x.Hardcore
+	}
+	return false
+}
+
+func (x *Character) GetDead() bool {
+	if x != nil {
+		return x.Dead
+	}
+	return false
+}
+
+func (x *Character) GetExpansion() bool {
+	if x != nil {
+		return x.Expansion
+	}
+	return false
+}
+
+func (x *Character) GetLadder() bool {
+	if x != nil {
+		return x.Ladder
+	}
+	return false
+}
+
+func (x *Character) GetLastPlayed() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastPlayed
+	}
+	return nil
+}
+
+func (x *Character) GetLastParsed() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastParsed
+	}
+	return nil
+}
+
+func (x *Character) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *Character) GetRealm() string {
+	if x != nil {
+		return x.Realm
+	}
+	return ""
+}
+
+func (x *Character) GetAttributes() *Attributes {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *Character) GetSkills() []*Skill {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
+func (x *Character) GetItems() []*Item {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Character) GetMercenary() *Mercenary {
+	if x != nil {
+		return x.Mercenary
+	}
+	return nil
+}
+
+// Attributes of a character, without the bonuses of items.
+type Attributes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Strength          uint32 `protobuf:"varint,1,opt,name=strength,proto3" json:"strength,omitempty"`
+	Dexterity         uint32 `protobuf:"varint,2,opt,name=dexterity,proto3" json:"dexterity,omitempty"`
+	Vitality          uint32 `protobuf:"varint,3,opt,name=vitality,proto3" json:"vitality,omitempty"`
+	Energy            uint32 `protobuf:"varint,4,opt,name=energy,proto3" json:"energy,omitempty"`
+	UnusedStats       uint32 `protobuf:"varint,5,opt,name=unused_stats,json=unusedStats,proto3" json:"unused_stats,omitempty"`
+	UnusedSkillPoints uint32 `protobuf:"varint,6,opt,name=unused_skill_points,json=unusedSkillPoints,proto3" json:"unused_skill_points,omitempty"`
+	CurrentHp         uint32 `protobuf:"varint,7,opt,name=current_hp,json=currentHp,proto3" json:"current_hp,omitempty"`
+	MaxHp             uint32 `protobuf:"varint,8,opt,name=max_hp,json=maxHp,proto3" json:"max_hp,omitempty"`
+	CurrentMana       uint32 `protobuf:"varint,9,opt,name=current_mana,json=currentMana,proto3" json:"current_mana,omitempty"`
+	MaxMana           uint32 `protobuf:"varint,10,opt,name=max_mana,json=maxMana,proto3" json:"max_mana,omitempty"`
+	CurrentStamina    uint32 `protobuf:"varint,11,opt,name=current_stamina,json=currentStamina,proto3" json:"current_stamina,omitempty"`
+	MaxStamina        uint32 `protobuf:"varint,12,opt,name=max_stamina,json=maxStamina,proto3" json:"max_stamina,omitempty"`
+	Gold              uint32 `protobuf:"varint,13,opt,name=gold,proto3" json:"gold,omitempty"`
+	StashedGold       uint32 `protobuf:"varint,14,opt,name=stashed_gold,json=stashedGold,proto3" json:"stashed_gold,omitempty"`
+}
+
+func (x *Attributes) Reset() {
+	*x = Attributes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_armory_v1_armory_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Attributes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attributes) ProtoMessage() {}
+
+func (x *Attributes) ProtoReflect() protoreflect.Message {
+	mi := &file_armory_v1_armory_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attributes.ProtoReflect.Descriptor instead.
+func (*Attributes) Descriptor() ([]byte, []int) {
+	return file_armory_v1_armory_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Attributes) GetStrength() uint32 {
+	if x != nil {
+		return x.Strength
+	}
+	return 0
+}
+
+func (x *Attributes) GetDexterity() uint32 {
+	if x != nil {
+		return x.Dexterity
+	}
+	return 0
+}
+
+func (x *Attributes) GetVitality() uint32 {
+	if x != nil {
+		return x.Vitality
+	}
+	return 0
+}
+
+func (x *Attributes) GetEnergy() uint32 {
+	if x != nil {
+		return x.Energy
+	}
+	return 0
+}
+
+func (x *Attributes) GetUnusedStats() uint32 {
+	if x != nil {
+		return x.UnusedStats
+	}
+	return 0
+}
+
+func (x *Attributes) GetUnusedSkillPoints() uint32 {
+	if x != nil {
+		return x.UnusedSkillPoints
+	}
+	return 0
+}
+
+func (x *Attributes) GetCurrentHp() uint32 {
+	if x != nil {
+		return x.CurrentHp
+	}
+	return 0
+}
+
+func (x *Attributes) GetMaxHp() uint32 {
+	if x != nil {
+		return x.MaxHp
+	}
+	return 0
+}
+
+func (x *Attributes) GetCurrentMana() uint32 {
+	if x != nil {
+		return x.CurrentMana
+	}
+	return 0
+}
+
+func (x *Attributes) GetMaxMana() uint32 {
+	if x != nil {
+		return x.MaxMana
+	}
+	return 0
+}
+
+func (x *Attributes) GetCurrentStamina() uint32 {
+	if x != nil {
+		return x.CurrentStamina
+	}
+	return 0
+}
+
+func (x *Attributes) GetMaxStamina() uint32 {
+	if x != nil {
+		return x.MaxStamina
+	}
+	return 0
+}
+
+func (x *Attributes) GetGold() uint32 {
+	if x != nil {
+		return x.Gold
+	}
+	return 0
+}
+
+func (x *Attributes) GetStashedGold() uint32 {
+	if x != nil {
+		return x.StashedGold
+	}
+	return 0
+}
+
+// Skill is the points spent in a skill, without the bonuses of items.
+type Skill struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name   string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Points int32  `protobuf:"varint,3,opt,name=points,proto3" json:"points,omitempty"`
+}
+
+func (x *Skill) Reset() {
+	*x = Skill{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_armory_v1_armory_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Skill) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Skill) ProtoMessage() {}
+
+func (x *Skill) ProtoReflect() protoreflect.Message {
+	mi := &file_armory_v1_armory_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Skill.ProtoReflect.Descriptor instead.
+func (*Skill) Descriptor() ([]byte, []int) {
+	return file_armory_v1_armory_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Skill) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Skill) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Skill) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+type Item struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name as shown in game.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The item code.
+	Type     string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	TypeName string `protobuf:"bytes,3,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
+	Quality  string `protobuf:"bytes,4,opt,name=quality,proto3" json:"quality,omitempty"`
+	// Where the item is, the equipped slot for equipped items.
+	Location string `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`
+	Level    int32  `protobuf:"varint,6,opt,name=level,proto3" json:"level,omitempty"`
+	Ethereal bool   `protobuf:"varint,7,opt,name=ethereal,proto3" json:"ethereal,omitempty"`
+	Sockets  int32  `protobuf:"varint,8,opt,name=sockets,proto3" json:"sockets,omitempty"`
+	Defense  int32  `protobuf:"varint,9,opt,name=defense,proto3" json:"defense,omitempty"`
+	Quantity int32  `protobuf:"varint,10,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// The magical properties as shown in game, including those of socketed items.
+	Properties []string `protobuf:"bytes,11,rep,name=properties,proto3" json:"properties,omitempty"`
+	Socketed   []*Item  `protobuf:"bytes,12,rep,name=socketed,proto3" json:"socketed,omitempty"`
+}
+
+func (x *Item) Reset() {
+	*x = Item{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_armory_v1_armory_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Item) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Item) ProtoMessage() {}
+
+func (x *Item) ProtoReflect() protoreflect.Message {
+	mi := &file_armory_v1_armory_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Item.ProtoReflect.Descriptor instead.
+func (*Item) Descriptor() ([]byte, []int) {
+	return file_armory_v1_armory_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Item) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Item) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Item) GetTypeName() string {
+	if x != nil {
+		return x.TypeName
+	}
+	return ""
+}
+
+func (x *Item) GetQuality() string {
+	if x != nil {
+		return x.Quality
+	}
+	return ""
+}
+
+func (x *Item) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *Item) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *Item) GetEthereal() bool {
+	if x != nil {
+		return x.Ethereal
+	}
+	return false
+}
+
+func (x *Item) GetSockets() int32 {
+	if x != nil {
+		return x.Sockets
+	}
+	return 0
+}
+
+func (x *Item) GetDefense() int32 {
+	if x != nil {
+		return x.Defense
+	}
+	return 0
+}
+
+func (x *Item) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *Item) GetProperties() []string {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+func (x *Item) GetSocketed() []*Item {
+	if x != nil {
+		return x.Socketed
+	}
+	return nil
+}
+
+type Mercenary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Class      string  `protobuf:"bytes,1,opt,name=class,proto3" json:"class,omitempty"`
+	Variant    string  `protobuf:"bytes,2,opt,name=variant,proto3" json:"variant,omitempty"`
+	Act        int32   `protobuf:"varint,3,opt,name=act,proto3" json:"act,omitempty"`
+	Difficulty string  `protobuf:"bytes,4,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	Level      int32   `protobuf:"varint,5,opt,name=level,proto3" json:"level,omitempty"`
+	Experience uint32  `protobuf:"varint,6,opt,name=experience,proto3" json:"experience,omitempty"`
+	Dead       bool    `protobuf:"varint,7,opt,name=dead,proto3" json:"dead,omitempty"`
+	Items      []*Item `protobuf:"bytes,8,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *Mercenary) Reset() {
+	*x = Mercenary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_armory_v1_armory_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Mercenary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Mercenary) ProtoMessage() {}
+
+func (x *Mercenary) ProtoReflect() protoreflect.Message {
+	mi := &file_armory_v1_armory_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Mercenary.ProtoReflect.Descriptor instead.
+func (*Mercenary) Descriptor() ([]byte, []int) {
+	return file_armory_v1_armory_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Mercenary) GetClass() string {
+	if x != nil {
+		return x.Class
+	}
+	return ""
+}
+
+func (x *Mercenary) GetVariant() string {
+	if x != nil {
+		return x.Variant
+	}
+	return ""
+}
+
+func (x *Mercenary) GetAct() int32 {
+	if x != nil {
+		return x.Act
+	}
+	return 0
+}
+
+func (x *Mercenary) GetDifficulty() string {
+	if x != nil {
+		return x.Difficulty
+	}
+	return ""
+}
+
+func (x *Mercenary) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *Mercenary) GetExperience() uint32 {
+	if x != nil {
+		return x.Experience
+	}
+	return 0
+}
+
+func (x *Mercenary) GetDead() bool {
+	if x != nil {
+		return x.Dead
+	}
+	return false
+}
+
+func (x *Mercenary) GetItems() []*Item {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type Statistics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Character string                `protobuf:"bytes,1,opt,name=character,proto3" json:"character,omitempty"`
+	Account   string                `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Normal    *DifficultyStatistics `protobuf:"bytes,3,opt,name=normal,proto3" json:"normal,omitempty"`
+	Nightmare *DifficultyStatistics `protobuf:"bytes,4,opt,name=nightmare,proto3" json:"nightmare,omitempty"`
+	Hell      *DifficultyStatistics `protobuf:"bytes,5,opt,name=hell,proto3" json:"hell,omitempty"`
+}
+
+func (x *Statistics) Reset() {
+	*x = Statistics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_armory_v1_armory_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Statistics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Statistics) ProtoMessage() {}
+
+func (x *Statistics) ProtoReflect() protoreflect.Message {
+	mi := &file_armory_v1_armory_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Statistics.ProtoReflect.Descriptor instead.
+func (*Statistics) Descriptor() ([]byte, []int) {
+	return file_armory_v1_armory_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Statistics) GetCharacter() string {
+	if x != nil {
+		return x.Character
+	}
+	return ""
+}
+
+func (x *Statistics) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *Statistics) GetNormal() *DifficultyStatistics {
+	if x != nil {
+		return x.Normal
+	}
+	return nil
+}
+
+func (x *Statistics) GetNightmare() *DifficultyStatistics {
+	if x != nil {
+		return x.Nightmare
+	}
+	return nil
+}
+
+func (x *Statistics) GetHell() *DifficultyStatistics {
+	if x != nil {
+		return x.Hell
+	}
+	return nil
+}
+
+type DifficultyStatistics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TotalKills       int32 `protobuf:"varint,1,opt,name=total_kills,json=totalKills,proto3" json:"total_kills,omitempty"`
+	TotalUniqueKills int32 `protobuf:"varint,2,opt,name=total_unique_kills,json=totalUniqueKills,proto3" json:"total_unique_kills,omitempty"`
+	TotalChampKills  int32 `protobuf:"varint,3,opt,name=total_champ_kills,json=totalChampKills,proto3" json:"total_champ_kills,omitempty"`
+	// The areas the most time was spent in, longest first.
+	Areas []*AreaStatistics `protobuf:"bytes,4,rep,name=areas,proto3" json:"areas,omitempty"`
+	// The special monsters killed the most, most kills first.
+	SpecialMonsters []*MonsterKills `protobuf:"bytes,5,rep,name=special_monsters,json=specialMonsters,proto3" json:"special_monsters,omitempty"`
+}
+
+func (x *DifficultyStatistics) Reset() {
+	*x = DifficultyStatistics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_armory_v1_armory_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DifficultyStatistics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DifficultyStatistics) ProtoMessage() {}
+
+func (x *DifficultyStatistics) ProtoReflect() protoreflect.Message {
+	mi := &file_armory_v1_armory_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DifficultyStatistics.ProtoReflect.Descriptor instead.
+func (*DifficultyStatistics) Descriptor() ([]byte, []int) {
+	return file_armory_v1_armory_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DifficultyStatistics) GetTotalKills() int32 {
+	if x != nil {
+		return x.TotalKills
+	}
+	return 0
+}
+
+func (x *DifficultyStatistics) GetTotalUniqueKills() int32 {
+	if x != nil {
+		return x.TotalUniqueKills
+	}
+	return 0
+}
+
+func (x *DifficultyStatistics) GetTotalChampKills() int32 {
+	if x != nil {
+		return x.TotalChampKills
+	}
+	return 0
+}
+
+func (x *DifficultyStatistics) GetAreas() []*AreaStatistics {
+	if x != nil {
+		return x.Areas
+	}
+	return nil
+}
+
+func (x *DifficultyStatistics) GetSpecialMonsters() []*MonsterKills {
+	if x != nil {
+		return x.SpecialMonsters
+	}
+	return nil
+}
+
+// StatisticsSubmission is the statistics of a single difficulty of a character.
+type StatisticsSubmission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account          string            `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Character        string            `protobuf:"bytes,2,opt,name=character,proto3" json:"character,omitempty"`
+	Difficulty       Difficulty        `protobuf:"varint,3,opt,name=difficulty,proto3,enum=armory.v1.Difficulty" json:"difficulty,omitempty"`
+	TotalKills       int32             `protobuf:"varint,4,opt,name=total_kills,json=totalKills,proto3" json:"total_kills,omitempty"`
+	TotalUniqueKills int32             `protobuf:"varint,5,opt,name=total_unique_kills,json=totalUniqueKills,proto3" json:"total_unique_kills,omitempty"`
+	TotalChampKills  int32             `protobuf:"varint,6,opt,name=total_champ_kills,json=totalChampKills,proto3" json:"total_champ_kills,omitempty"`
+	Areas            []*AreaStatistics `protobuf:"bytes,7,rep,name=areas,proto3" json:"areas,omitempty"`
+	SpecialMonsters  []*MonsterKills   `protobuf:"bytes,8,rep,name=special_monsters,json=specialMonsters,proto3" json:"special_monsters,omitempty"`
+}
+
+func (x *StatisticsSubmission) Reset() {
+	*x = StatisticsSubmission{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_armory_v1_armory_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatisticsSubmission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatisticsSubmission) ProtoMessage() {}
+
+func (x *StatisticsSubmission) ProtoReflect() protoreflect.Message {
+	mi := &file_armory_v1_armory_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatisticsSubmission.ProtoReflect.Descriptor instead.
+func (*StatisticsSubmission) Descriptor() ([]byte, []int) {
+	return file_armory_v1_armory_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *StatisticsSubmission) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *StatisticsSubmission) GetCharacter() string {
+	if x != nil {
+		return x.Character
+	}
+	return ""
+}
+
+func (x *StatisticsSubmission) GetDifficulty() Difficulty {
+	if x != nil {
+		return x.Difficulty
+	}
+	return Difficulty_DIFFICULTY_UNSPECIFIED
+}
+
+func (x *StatisticsSubmission) GetTotalKills() int32 {
+	if x != nil {
+		return x.TotalKills
+	}
+	return 0
+}
+
+func (x *StatisticsSubmission) GetTotalUniqueKills() int32 {
+	if x != nil {
+		return x.TotalUniqueKills
+	}
+	return 0
+}
+
+func (x *StatisticsSubmission) GetTotalChampKills() int32 {
+	if x != nil {
+		return x.TotalChampKills
+	}
+	return 0
+}
+
+func (x *StatisticsSubmission) GetAreas() []*AreaStatistics {
+	if x != nil {
+		return x.Areas
+	}
+	return nil
+}
+
+func (x *StatisticsSubmission) GetSpecialMonsters() []*MonsterKills {
+	if x != nil {
+		return x.SpecialMonsters
+	}
+	return nil
+}
+
+type AreaStatistics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Kills uint32 `protobuf:"varint,2,opt,name=kills,proto3" json:"kills,omitempty"`
+	// Seconds spent in the area.
+	Time        uint32 `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+	UniqueKills uint32 `protobuf:"varint,4,opt,name=unique_kills,json=uniqueKills,proto3" json:"unique_kills,omitempty"`
+	ChampKills  uint32 `protobuf:"varint,5,opt,name=champ_kills,json=champKills,proto3" json:"champ_kills,omitempty"`
+}
+
+func (x *AreaStatistics) Reset() {
+	*x = AreaStatistics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_armory_v1_armory_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AreaStatistics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AreaStatistics) ProtoMessage() {}
+
+func (x *AreaStatistics) ProtoReflect() protoreflect.Message {
+	mi := &file_armory_v1_armory_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AreaStatistics.ProtoReflect.Descriptor instead.
+func (*AreaStatistics) Descriptor() ([]byte, []int) {
+	return file_armory_v1_armory_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AreaStatistics) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AreaStatistics) GetKills() uint32 {
+	if x != nil {
+		return x.Kills
+	}
+	return 0
+}
+
+func (x *AreaStatistics) GetTime() uint32 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *AreaStatistics) GetUniqueKills() uint32 {
+	if x != nil {
+		return x.UniqueKills
+	}
+	return 0
+}
+
+func (x *AreaStatistics) GetChampKills() uint32 {
+	if x != nil {
+		return x.ChampKills
+	}
+	return 0
+}
+
+type MonsterKills struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Kills int32  `protobuf:"varint,2,opt,name=kills,proto3" json:"kills,omitempty"`
+}
+
+func (x *MonsterKills) Reset() {
+	*x = MonsterKills{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_armory_v1_armory_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MonsterKills) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonsterKills) ProtoMessage() {}
+
+func (x *MonsterKills) ProtoReflect() protoreflect.Message {
+	mi := &file_armory_v1_armory_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonsterKills.ProtoReflect.Descriptor instead.
+func (*MonsterKills) Descriptor() ([]byte, []int) {
+	return file_armory_v1_armory_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *MonsterKills) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MonsterKills) GetKills() int32 {
+	if x != nil {
+		return x.Kills
+	}
+	return 0
+}
+
+var File_armory_v1_armory_proto protoreflect.FileDescriptor
+
+var file_armory_v1_armory_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x72, 0x6d, 0x6f,
+	0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x79,
+	0x2e, 0x76, 0x31, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x3f, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0xb6, 0x01, 0x0a, 0x19, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x43,
+	0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x48, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x72, 0x61,
+	0x63, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x1a, 0x39, 0x0a, 0x0b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x52, 0x0a, 0x1a, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x72, 0x6d,
+	0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22,
+	0x87, 0x01, 0x0a, 0x0f, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x72, 0x61,
+	0x63, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x72, 0x6d,
+	0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72,
+	0x52, 0x09, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x4a, 0x0a, 0x14, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x58, 0x0a, 0x15, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3f,
+	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x22,
+	0x37, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74,
+	0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68,
+	0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x22, 0x41, 0x0a, 0x15, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x94, 0x01, 0x0a, 0x0f,
+	0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x34, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x09, 0x63, 0x68, 0x61, 0x72,
+	0x61, 0x63, 0x74, 0x65, 0x72, 0x12, 0x41, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74,
+	0x69, 0x63, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x72, 0x6d, 0x6f,
+	0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x74,
+	0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x42, 0x08, 0x0a, 0x06, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x22, 0xb7, 0x04, 0x0a, 0x09, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x68, 0x61, 0x72, 0x64, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x68, 0x61, 0x72, 0x64, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x65, 0x61, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x65, 0x61, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16,
+	0x0a, 0x06, 0x6c, 0x61, 0x64, 0x64, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x6c, 0x61, 0x64, 0x64, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x64, 0x12, 0x3b, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x73,
+	0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x61, 0x72, 0x73, 0x65, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65,
+	0x61, 0x6c, 0x6d, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d,
+	0x12, 0x35, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x0a, 0x61, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x6b, 0x69, 0x6c, 0x6c,
+	0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x52, 0x06, 0x73, 0x6b, 0x69, 0x6c, 0x6c,
+	0x73, 0x12, 0x25, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x74, 0x65,
+	0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x32, 0x0a, 0x09, 0x6d, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x72,
+	0x6d, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x61, 0x72,
+	0x79, 0x52, 0x09, 0x6d, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x79, 0x22, 0xc2, 0x03, 0x0a,
+	0x0a, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x74, 0x72, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x73,
+	0x74, 0x72, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x78, 0x74, 0x65,
+	0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x64, 0x65, 0x78, 0x74,
+	0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x69, 0x74, 0x61, 0x6c, 0x69, 0x74,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x76, 0x69, 0x74, 0x61, 0x6c, 0x69, 0x74,
+	0x79, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x06, 0x65, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x75,
+	0x73, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0b, 0x75, 0x6e, 0x75, 0x73, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x13,
+	0x75, 0x6e, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x5f, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x75, 0x6e, 0x75, 0x73, 0x65,
+	0x64, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x09, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x70, 0x12, 0x15, 0x0a, 0x06, 0x6d,
+	0x61, 0x78, 0x5f, 0x68, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6d, 0x61, 0x78,
+	0x48, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x61,
+	0x6e, 0x61, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x4d, 0x61, 0x6e, 0x61, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x61, 0x6e,
+	0x61, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x4d, 0x61, 0x6e, 0x61,
+	0x12, 0x27, 0x0a, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x6d,
+	0x69, 0x6e, 0x61, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x53, 0x74, 0x61, 0x6d, 0x69, 0x6e, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78,
+	0x5f, 0x73, 0x74, 0x61, 0x6d, 0x69, 0x6e, 0x61, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a,
+	0x6d, 0x61, 0x78, 0x53, 0x74, 0x61, 0x6d, 0x69, 0x6e, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x67, 0x6f,
+	0x6c, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x67, 0x6f, 0x6c, 0x64, 0x12, 0x21,
+	0x0a, 0x0c, 0x73, 0x74, 0x61, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x67, 0x6f, 0x6c, 0x64, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x73, 0x68, 0x65, 0x64, 0x47, 0x6f, 0x6c,
+	0x64, 0x22, 0x43, 0x0a, 0x05, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0xd0, 0x02, 0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x79, 0x70, 0x65, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x79, 0x70, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1a,
+	0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x12, 0x1a, 0x0a, 0x08, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x73,
+	0x6f, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x65, 0x6e, 0x73,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x64, 0x65, 0x66, 0x65, 0x6e, 0x73, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1e, 0x0a, 0x0a,
+	0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x08,
+	0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52,
+	0x08, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x64, 0x22, 0xde, 0x01, 0x0a, 0x09, 0x4d, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x63, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x61, 0x63, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x69, 0x66,
+	0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64,
+	0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12,
+	0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x65, 0x61, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64,
+	0x65, 0x61, 0x64, 0x12, 0x25, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xf1, 0x01, 0x0a, 0x0a, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61,
+	0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68,
+	0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x37, 0x0a, 0x06, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69,
+	0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x73, 0x52, 0x06, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x12, 0x3d, 0x0a, 0x09, 0x6e, 0x69,
+	0x67, 0x68, 0x74, 0x6d, 0x61, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x61, 0x72, 0x6d, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x69, 0x63,
+	0x75, 0x6c, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x09,
+	0x6e, 0x69, 0x67, 0x68, 0x74, 0x6d, 0x61, 0x72, 0x65, 0x12, 0x33, 0x0a, 0x04, 0x68, 0x65, 0x6c,
+	0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x04, 0x68, 0x65, 0x6c, 0x6c, 0x22, 0x86,
+	0x02, 0x0a, 0x14, 0x44, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x4b, 0x69, 0x6c, 0x6c, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x4b, 0x69, 0x6c, 0x6c, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x63, 0x68, 0x61, 0x6d, 0x70, 0x5f, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x68, 0x61, 0x6d, 0x70, 0x4b, 0x69, 0x6c,
+	0x6c, 0x73, 0x12, 0x2f, 0x0a, 0x05, 0x61, 0x72, 0x65, 0x61, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72,
+	0x65, 0x61, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x05, 0x61, 0x72,
+	0x65, 0x61, 0x73, 0x12, 0x42, 0x0a, 0x10, 0x73, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x6d,
+	0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x61, 0x72, 0x6d, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x73, 0x74, 0x65,
+	0x72, 0x4b, 0x69, 0x6c, 0x6c, 0x73, 0x52, 0x0f, 0x73, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x4d,
+	0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x73, 0x22, 0xf5, 0x02, 0x0a, 0x14, 0x53, 0x74, 0x61, 0x74,
+	0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68,
+	0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x0a, 0x64, 0x69, 0x66, 0x66,
+	0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x61,
+	0x72, 0x6d, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75,
+	0x6c, 0x74, 0x79, 0x52, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x12,
+	0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4b, 0x69, 0x6c, 0x6c, 0x73,
+	0x12, 0x2c, 0x0a, 0x12, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65,
+	0x5f, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x4b, 0x69, 0x6c, 0x6c, 0x73, 0x12, 0x2a,
+	0x0a, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x68, 0x61, 0x6d, 0x70, 0x5f, 0x6b, 0x69,
+	0x6c, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x43, 0x68, 0x61, 0x6d, 0x70, 0x4b, 0x69, 0x6c, 0x6c, 0x73, 0x12, 0x2f, 0x0a, 0x05, 0x61, 0x72,
+	0x65, 0x61, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x72, 0x6d, 0x6f,
+	0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72, 0x65, 0x61, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73,
+	0x74, 0x69, 0x63, 0x73, 0x52, 0x05, 0x61, 0x72, 0x65, 0x61, 0x73, 0x12, 0x42, 0x0a, 0x10, 0x73,
+	0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x4b, 0x69, 0x6c, 0x6c, 0x73, 0x52, 0x0f,
+	0x73, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x4d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x73, 0x22,
+	0x92, 0x01, 0x0a, 0x0e, 0x41, 0x72, 0x65, 0x61, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x6b, 0x69, 0x6c, 0x6c, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x4b, 0x69,
+	0x6c, 0x6c, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x6d, 0x70, 0x5f, 0x6b, 0x69, 0x6c,
+	0x6c, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6d, 0x70, 0x4b,
+	0x69, 0x6c, 0x6c, 0x73, 0x22, 0x38, 0x0a, 0x0c, 0x4d, 0x6f, 0x6e, 0x73, 0x74, 0x65, 0x72, 0x4b,
+	0x69, 0x6c, 0x6c, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6b, 0x69, 0x6c, 0x6c,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x2a, 0x6e,
+	0x0a, 0x0a, 0x44, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x16,
+	0x44, 0x49, 0x46, 0x46, 0x49, 0x43, 0x55, 0x4c, 0x54, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x44, 0x49, 0x46, 0x46,
+	0x49, 0x43, 0x55, 0x4c, 0x54, 0x59, 0x5f, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x10, 0x01, 0x12,
+	0x18, 0x0a, 0x14, 0x44, 0x49, 0x46, 0x46, 0x49, 0x43, 0x55, 0x4c, 0x54, 0x59, 0x5f, 0x4e, 0x49,
+	0x47, 0x48, 0x54, 0x4d, 0x41, 0x52, 0x45, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x44, 0x49, 0x46,
+	0x46, 0x49, 0x43, 0x55, 0x4c, 0x54, 0x59, 0x5f, 0x48, 0x45, 0x4c, 0x4c, 0x10, 0x03, 0x32, 0xe8,
+	0x03, 0x0a, 0x06, 0x41, 0x72, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x44, 0x0a, 0x0c, 0x47, 0x65, 0x74,
+	0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x61, 0x72, 0x6d, 0x6f,
+	0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x61, 0x72, 0x6d, 0x6f,
+	0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x12,
+	0x61, 0x0a, 0x12, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x72, 0x61,
+	0x63, 0x74, 0x65, 0x72, 0x73, 0x12, 0x24, 0x2e, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63,
+	0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61, 0x72,
+	0x6d, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74,
+	0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x47, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74,
+	0x69, 0x63, 0x73, 0x12, 0x1f, 0x2e, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x4a, 0x0a, 0x0e, 0x50,
+	0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x20, 0x2e,
+	0x61, 0x72, 0x6d, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4e, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x22, 0x2e, 0x61, 0x72,
+	0x6d, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x50, 0x0a, 0x0e, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x61, 0x72, 0x6d, 0x6f,
+	0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x61, 0x72, 0x61,
+	0x63, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x72,
+	0x6d, 0x6f, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65,
+	0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x30, 0x01, 0x42, 0x36, 0x5a, 0x34, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x6f, 0x6b, 0x6b, 0x61, 0x2f, 0x64, 0x32,
+	0x2d, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x79, 0x2d, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
+	0x61, 0x72, 0x6d, 0x6f, 0x72, 0x79, 0x70, 0x62, 0x3b, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x79, 0x70,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_armory_v1_armory_proto_rawDescOnce sync.Once
+	file_armory_v1_armory_proto_rawDescData = file_armory_v1_armory_proto_rawDesc
+)
+
+func file_armory_v1_armory_proto_rawDescGZIP() []byte {
+	file_armory_v1_armory_proto_rawDescOnce.Do(func() {
+		file_armory_v1_armory_proto_rawDescData = protoimpl.X.CompressGZIP(file_armory_v1_armory_proto_rawDescData)
+	})
+	return file_armory_v1_armory_proto_rawDescData
+}
+
+var file_armory_v1_armory_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_armory_v1_armory_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_armory_v1_armory_proto_goTypes = []interface{}{
+	(Difficulty)(0),                    // 0: armory.v1.Difficulty
+	(*GetCharacterRequest)(nil),        // 1: armory.v1.GetCharacterRequest
+	(*BatchGetCharactersRequest)(nil),  // 2: armory.v1.BatchGetCharactersRequest
+	(*BatchGetCharactersResponse)(nil), // 3: armory.v1.BatchGetCharactersResponse
+	(*CharacterResult)(nil),            // 4: armory.v1.CharacterResult
+	(*GetStatisticsRequest)(nil),       // 5: armory.v1.GetStatisticsRequest
+	(*PostStatisticsRequest)(nil),      // 6: armory.v1.PostStatisticsRequest
+	(*DeleteStatisticsRequest)(nil),    // 7: armory.v1.DeleteStatisticsRequest
+	(*WatchCharacterRequest)(nil),      // 8: armory.v1.WatchCharacterRequest
+	(*CharacterUpdate)(nil),            // 9: armory.v1.CharacterUpdate
+	(*Character)(nil),                  // 10: armory.v1.Character
+	(*Attributes)(nil),                 // 11: armory.v1.Attributes
+	(*Skill)(nil),                      // 12: armory.v1.Skill
+	(*Item)(nil),                       // 13: armory.v1.Item
+	(*Mercenary)(nil),                  // 14: armory.v1.Mercenary
+	(*Statistics)(nil),                 // 15: armory.v1.Statistics
+	(*DifficultyStatistics)(nil),       // 16: armory.v1.DifficultyStatistics
+	(*StatisticsSubmission)(nil),       // 17: armory.v1.StatisticsSubmission
+	(*AreaStatistics)(nil),             // 18: armory.v1.AreaStatistics
+	(*MonsterKills)(nil),               // 19: armory.v1.MonsterKills
+	nil,                                // 20: armory.v1.BatchGetCharactersRequest.TokensEntry
+	(*timestamppb.Timestamp)(nil),      // 21: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),              // 22: google.protobuf.Empty
+}
+var file_armory_v1_armory_proto_depIdxs = []int32{
+	20, // 0: armory.v1.BatchGetCharactersRequest.tokens:type_name -> armory.v1.BatchGetCharactersRequest.TokensEntry
+	4,  // 1: armory.v1.BatchGetCharactersResponse.results:type_name -> armory.v1.CharacterResult
+	10, // 2: armory.v1.CharacterResult.character:type_name -> armory.v1.Character
+	17, // 3: armory.v1.PostStatisticsRequest.statistics:type_name -> armory.v1.StatisticsSubmission
+	10, // 4: armory.v1.CharacterUpdate.character:type_name -> armory.v1.Character
+	17, // 5: armory.v1.CharacterUpdate.statistics:type_name -> armory.v1.StatisticsSubmission
+	21, // 6: armory.v1.Character.last_played:type_name -> google.protobuf.Timestamp
+	21, // 7: armory.v1.Character.last_parsed:type_name -> google.protobuf.Timestamp
+	11, // 8: armory.v1.Character.attributes:type_name -> armory.v1.Attributes
+	12, // 9: armory.v1.Character.skills:type_name -> armory.v1.Skill
+	13, // 10: armory.v1.Character.items:type_name -> armory.v1.Item
+	14, // 11: armory.v1.Character.mercenary:type_name -> armory.v1.Mercenary
+	13, // 12: armory.v1.Item.socketed:type_name -> armory.v1.Item
+	13, // 13: armory.v1.Mercenary.items:type_name -> armory.v1.Item
+	16, // 14: armory.v1.Statistics.normal:type_name -> armory.v1.DifficultyStatistics
+	16, // 15: armory.v1.Statistics.nightmare:type_name -> armory.v1.DifficultyStatistics
+	16, // 16: armory.v1.Statistics.hell:type_name -> armory.v1.DifficultyStatistics
+	18, // 17: armory.v1.DifficultyStatistics.areas:type_name -> armory.v1.AreaStatistics
+	19, // 18: armory.v1.DifficultyStatistics.special_monsters:type_name -> armory.v1.MonsterKills
+	0,  // 19: armory.v1.StatisticsSubmission.difficulty:type_name -> armory.v1.Difficulty
+	18, // 20: armory.v1.StatisticsSubmission.areas:type_name -> armory.v1.AreaStatistics
+	19, // 21: armory.v1.StatisticsSubmission.special_monsters:type_name -> armory.v1.MonsterKills
+	1,  // 22: armory.v1.Armory.GetCharacter:input_type -> armory.v1.GetCharacterRequest
+	2,  // 23: armory.v1.Armory.BatchGetCharacters:input_type -> armory.v1.BatchGetCharactersRequest
+	5,  // 24: armory.v1.Armory.GetStatistics:input_type -> armory.v1.GetStatisticsRequest
+	6,  // 25: armory.v1.Armory.PostStatistics:input_type -> armory.v1.PostStatisticsRequest
+	7,  // 26: armory.v1.Armory.DeleteStatistics:input_type -> armory.v1.DeleteStatisticsRequest
+	8,  // 27: armory.v1.Armory.WatchCharacter:input_type -> armory.v1.WatchCharacterRequest
+	10, // 28: armory.v1.Armory.GetCharacter:output_type -> armory.v1.Character
+	3,  // 29: armory.v1.Armory.BatchGetCharacters:output_type -> armory.v1.BatchGetCharactersResponse
+	15, // 30: armory.v1.Armory.GetStatistics:output_type -> armory.v1.Statistics
+	22, // 31: armory.v1.Armory.PostStatistics:output_type -> google.protobuf.Empty
+	22, // 32: armory.v1.Armory.DeleteStatistics:output_type -> google.protobuf.Empty
+	9,  // 33: armory.v1.Armory.WatchCharacter:output_type -> armory.v1.CharacterUpdate
+	28, // [28:34] is the sub-list for method output_type
+	22, // [22:28] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
+}
+
+func init() { file_armory_v1_armory_proto_init() }
+func file_armory_v1_armory_proto_init() {
+	if File_armory_v1_armory_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_armory_v1_armory_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCharacterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_armory_v1_armory_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchGetCharactersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_armory_v1_armory_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchGetCharactersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_armory_v1_armory_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CharacterResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_armory_v1_armory_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStatisticsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_armory_v1_armory_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostStatisticsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_armory_v1_armory_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteStatisticsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_armory_v1_armory_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchCharacterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_armory_v1_armory_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CharacterUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_armory_v1_armory_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Character); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_armory_v1_armory_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Attributes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_armory_v1_armory_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Skill); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_armory_v1_armory_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Item); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_armory_v1_armory_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Mercenary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_armory_v1_armory_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Statistics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_armory_v1_armory_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DifficultyStatistics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_armory_v1_armory_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatisticsSubmission); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_armory_v1_armory_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AreaStatistics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_armory_v1_armory_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MonsterKills); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_armory_v1_armory_proto_msgTypes[8].OneofWrappers = []interface{}{
+		(*CharacterUpdate_Character)(nil),
+		(*CharacterUpdate_Statistics)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_armory_v1_armory_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   20,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_armory_v1_armory_proto_goTypes,
+		DependencyIndexes: file_armory_v1_armory_proto_depIdxs,
+		EnumInfos:         file_armory_v1_armory_proto_enumTypes,
+		MessageInfos:      file_armory_v1_armory_proto_msgTypes,
+	}.Build()
+	File_armory_v1_armory_proto = out.File
+	file_armory_v1_armory_proto_rawDesc = nil
+	file_armory_v1_armory_proto_goTypes = nil
+	file_armory_v1_armory_proto_depIdxs = nil
+}
@@ -0,0 +1,330 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.19.1
+// source: armory/v1/armory.proto
+
+package armorypb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ArmoryClient is the client API for Armory service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ArmoryClient interface {
+	// GetCharacter gets a character, parsing it if it hasn't been parsed recently.
+	GetCharacter(ctx context.Context, in *GetCharacterRequest, opts ...grpc.CallOption) (*Character, error)
+	// BatchGetCharacters gets several characters at once, a character that can't
+	// be fetched doesn't fail the others.
+	BatchGetCharacters(ctx context.Context, in *BatchGetCharactersRequest, opts ...grpc.CallOption) (*BatchGetCharactersResponse, error)
+	// GetStatistics gets the statistics posted for a character.
+	GetStatistics(ctx context.Context, in *GetStatisticsRequest, opts ...grpc.CallOption) (*Statistics, error)
+	// PostStatistics posts the statistics of characters, one difficulty at a time.
+	PostStatistics(ctx context.Context, in *PostStatisticsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// DeleteStatistics deletes every statistic of a character.
+	DeleteStatistics(ctx context.Context, in *DeleteStatisticsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// WatchCharacter streams the updates of a character as they happen, the first
+	// update is the character as it is when the stream is opened.
+	WatchCharacter(ctx context.Context, in *WatchCharacterRequest, opts ...grpc.CallOption) (Armory_WatchCharacterClient, error)
+}
+
+type armoryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewArmoryClient(cc grpc.ClientConnInterface) ArmoryClient {
+	return &armoryClient{cc}
+}
+
+func (c *armoryClient) GetCharacter(ctx context.Context, in *GetCharacterRequest, opts ...grpc.CallOption) (*Character, error) {
+	out := new(Character)
+	err := c.cc.Invoke(ctx, "/armory.v1.Armory/GetCharacter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *armoryClient) BatchGetCharacters(ctx context.Context, in *BatchGetCharactersRequest, opts ...grpc.CallOption) (*BatchGetCharactersResponse, error) {
+	out := new(BatchGetCharactersResponse)
+	err := c.cc.Invoke(ctx, "/armory.v1.Armory/BatchGetCharacters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *armoryClient) GetStatistics(ctx context.Context, in *GetStatisticsRequest, opts ...grpc.CallOption) (*Statistics, error) {
+	out := new(Statistics)
+	err := c.cc.Invoke(ctx, "/armory.v1.Armory/GetStatistics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *armoryClient) PostStatistics(ctx context.Context, in *PostStatisticsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/armory.v1.Armory/PostStatistics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *armoryClient) DeleteStatistics(ctx context.Context, in *DeleteStatisticsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/armory.v1.Armory/DeleteStatistics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *armoryClient) WatchCharacter(ctx context.Context, in *WatchCharacterRequest, opts ...grpc.CallOption) (Armory_WatchCharacterClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Armory_ServiceDesc.Streams[0], "/armory.v1.Armory/WatchCharacter", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &armoryWatchCharacterClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Armory_WatchCharacterClient interface {
+	Recv() (*CharacterUpdate, error)
+	grpc.ClientStream
+}
+
+type armoryWatchCharacterClient struct {
+	grpc.ClientStream
+}
+
+func (x *armoryWatchCharacterClient) Recv() (*CharacterUpdate, error) {
+	m := new(CharacterUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ArmoryServer is the server API for Armory service.
+// All implementations must embed UnimplementedArmoryServer
+// for forward compatibility
+type ArmoryServer interface {
+	// GetCharacter gets a character, parsing it if it hasn't been parsed recently.
+	GetCharacter(context.Context, *GetCharacterRequest) (*Character, error)
+	// BatchGetCharacters gets several characters at once, a character that can't
+	// be fetched doesn't fail the others.
+	BatchGetCharacters(context.Context, *BatchGetCharactersRequest) (*BatchGetCharactersResponse, error)
+	// GetStatistics gets the statistics posted for a character.
+	GetStatistics(context.Context, *GetStatisticsRequest) (*Statistics, error)
+	// PostStatistics posts the statistics of characters, one difficulty at a time.
+	PostStatistics(context.Context, *PostStatisticsRequest) (*emptypb.Empty, error)
+	// DeleteStatistics deletes every statistic of a character.
+	DeleteStatistics(context.Context, *DeleteStatisticsRequest) (*emptypb.Empty, error)
+	// WatchCharacter streams the updates of a character as they happen, the first
+	// update is the character as it is when the stream is opened.
+	WatchCharacter(*WatchCharacterRequest, Armory_WatchCharacterServer) error
+	mustEmbedUnimplementedArmoryServer()
+}
+
+// UnimplementedArmoryServer must be embedded to have forward compatible implementations.
+type UnimplementedArmoryServer struct {
+}
+
+func (UnimplementedArmoryServer) GetCharacter(context.Context, *GetCharacterRequest) (*Character, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCharacter not implemented")
+}
+func (UnimplementedArmoryServer) BatchGetCharacters(context.Context, *BatchGetCharactersRequest) (*BatchGetCharactersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetCharacters not implemented")
+}
+func (UnimplementedArmoryServer) GetStatistics(context.Context, *GetStatisticsRequest) (*Statistics, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatistics not implemented")
+}
+func (UnimplementedArmoryServer) PostStatistics(context.Context, *PostStatisticsRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostStatistics not implemented")
+}
+func (UnimplementedArmoryServer) DeleteStatistics(context.Context, *DeleteStatisticsRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteStatistics not implemented")
+}
+func (UnimplementedArmoryServer) WatchCharacter(*WatchCharacterRequest, Armory_WatchCharacterServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchCharacter not implemented")
+}
+func (UnimplementedArmoryServer) mustEmbedUnimplementedArmoryServer() {}
+
+// UnsafeArmoryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ArmoryServer will
+// result in compilation errors.
+type UnsafeArmoryServer interface {
+	mustEmbedUnimplementedArmoryServer()
+}
+
+func RegisterArmoryServer(s grpc.ServiceRegistrar, srv ArmoryServer) {
+	s.RegisterService(&Armory_ServiceDesc, srv)
+}
+
+func _Armory_GetCharacter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCharacterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArmoryServer).GetCharacter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/armory.v1.Armory/GetCharacter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArmoryServer).GetCharacter(ctx, req.(*GetCharacterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Armory_BatchGetCharacters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetCharactersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArmoryServer).BatchGetCharacters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/armory.v1.Armory/BatchGetCharacters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArmoryServer).BatchGetCharacters(ctx, req.(*BatchGetCharactersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Armory_GetStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArmoryServer).GetStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/armory.v1.Armory/GetStatistics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArmoryServer).GetStatistics(ctx, req.(*GetStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Armory_PostStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArmoryServer).PostStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/armory.v1.Armory/PostStatistics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArmoryServer).PostStatistics(ctx, req.(*PostStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Armory_DeleteStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArmoryServer).DeleteStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/armory.v1.Armory/DeleteStatistics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArmoryServer).DeleteStatistics(ctx, req.(*DeleteStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Armory_WatchCharacter_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchCharacterRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArmoryServer).WatchCharacter(m, &armoryWatchCharacterServer{stream})
+}
+
+type Armory_WatchCharacterServer interface {
+	Send(*CharacterUpdate) error
+	grpc.ServerStream
+}
+
+type armoryWatchCharacterServer struct {
+	grpc.ServerStream
+}
+
+func (x *armoryWatchCharacterServer) Send(m *CharacterUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// Armory_ServiceDesc is the grpc.ServiceDesc for Armory service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Armory_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "armory.v1.Armory",
+	HandlerType: (*ArmoryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetCharacter",
+			Handler:    _Armory_GetCharacter_Handler,
+		},
+		{
+			MethodName: "BatchGetCharacters",
+			Handler:    _Armory_BatchGetCharacters_Handler,
+		},
+		{
+			MethodName: "GetStatistics",
+			Handler:    _Armory_GetStatistics_Handler,
+		},
+		{
+			MethodName: "PostStatistics",
+			Handler:    _Armory_PostStatistics_Handler,
+		},
+		{
+			MethodName: "DeleteStatistics",
+			Handler:    _Armory_DeleteStatistics_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchCharacter",
+			Handler:       _Armory_WatchCharacter_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "armory/v1/armory.proto",
+}